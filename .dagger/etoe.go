@@ -105,6 +105,7 @@ func (m *CCP) bootstrapCCP(mysql *dagger.Service) *dagger.Service {
 		WithEnvVariable("CCP_SHARED_DIR", sharedDir).
 		WithEnvVariable("CCP_DB_DRIVER", "mysql").
 		WithEnvVariable("CCP_DB_DSN", "root:12345@tcp(mysql:3306)/CCP").
+		WithEnvVariable("CCP_DB_MIGRATE", "true").
 		WithEnvVariable("CCP_API_ADMIN_ADDR", ":8000").
 		WithEnvVariable("CCP_WEBUI_ADDR", ":8001").
 		WithEnvVariable("CCP_METRICS_ADDR", ":7999").
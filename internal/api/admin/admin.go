@@ -2,13 +2,15 @@ package admin
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"math"
 	"net"
 	"net/http"
 	"os"
 	"regexp"
 	"slices"
 	"strings"
-	"sync"
 	"time"
 
 	"connectrpc.com/authn"
@@ -18,7 +20,6 @@ import (
 	"github.com/bufbuild/protovalidate-go"
 	"github.com/go-logr/logr"
 	"github.com/google/uuid"
-	"github.com/jellydator/ttlcache/v3"
 	"golang.org/x/net/http2"
 	"golang.org/x/net/http2/h2c"
 
@@ -27,6 +28,7 @@ import (
 	adminv1connect "github.com/artefactual-labs/ccp/internal/api/gen/archivematica/ccp/admin/v1beta1/adminv1beta1connect"
 	"github.com/artefactual-labs/ccp/internal/controller"
 	"github.com/artefactual-labs/ccp/internal/store"
+	"github.com/artefactual-labs/ccp/internal/tlsutil"
 	"github.com/artefactual-labs/ccp/internal/workflow"
 )
 
@@ -41,21 +43,22 @@ type Server struct {
 	server *http.Server
 	ln     net.Listener
 	v      *protovalidate.Validator
+	health grpchealth.Checker
 
-	// cache provides an in-memory cache with expiration to prevent concurrent
-	// clients from overloading the system.
-	cache *ttlcache.Cache[adminv1.PackageType, *adminv1.ListPackagesResponse]
-	wg    sync.WaitGroup
+	// leadership is nil unless multiple instances share the database.
+	leadership Leadership
 }
 
-func New(logger logr.Logger, config Config, ctrl *controller.Controller, store store.Store, wf *workflow.Document, form *workflow.ProcessingConfigForm) (*Server, error) {
+func New(logger logr.Logger, config Config, ctrl *controller.Controller, store store.Store, wf *workflow.Document, form *workflow.ProcessingConfigForm, health grpchealth.Checker, leadership Leadership) (*Server, error) {
 	srv := &Server{
-		logger: logger,
-		config: config,
-		ctrl:   ctrl,
-		store:  store,
-		wf:     wf,
-		form:   form,
+		logger:     logger,
+		config:     config,
+		ctrl:       ctrl,
+		store:      store,
+		wf:         wf,
+		form:       form,
+		health:     health,
+		leadership: leadership,
 	}
 
 	if v, err := protovalidate.New(); err != nil {
@@ -64,15 +67,6 @@ func New(logger logr.Logger, config Config, ctrl *controller.Controller, store s
 		srv.v = v
 	}
 
-	srv.cache = ttlcache.New(
-		ttlcache.WithTTL[adminv1.PackageType, *adminv1.ListPackagesResponse](1 * time.Second),
-	)
-	srv.wg.Add(1)
-	go func() {
-		defer srv.wg.Done()
-		srv.cache.Start()
-	}()
-
 	return srv, nil
 }
 
@@ -81,15 +75,21 @@ var _ adminv1connect.AdminServiceHandler = (*Server)(nil)
 func (s *Server) Run() error {
 	compress1KB := connect.WithCompressMinBytes(1024)
 
-	mux := http.NewServeMux()
-	mux.Handle(adminv1connect.NewAdminServiceHandler(
-		s,
+	// The auditor is the outermost interceptor so denied calls are recorded.
+	opts := []connect.HandlerOption{
 		compress1KB,
-	))
-	mux.Handle(grpchealth.NewHandler(
-		grpchealth.NewStaticChecker(adminv1connect.AdminServiceName),
-		compress1KB,
-	))
+		connect.WithInterceptors(newAuditor(s.logger, s.store)),
+	}
+	if s.config.RBAC.Enabled {
+		opts = append(opts, connect.WithInterceptors(newAuthorizer(s.logger, s.config.RBAC, s.store)))
+	}
+	if s.leadership != nil {
+		opts = append(opts, connect.WithInterceptors(newStandbyGuard(s.logger, s.leadership)))
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle(adminv1connect.NewAdminServiceHandler(s, opts...))
+	mux.Handle(grpchealth.NewHandler(s.health, compress1KB))
 	mux.Handle(grpcreflect.NewHandlerV1(
 		grpcreflect.NewStaticReflector(adminv1connect.AdminServiceName),
 		compress1KB,
@@ -99,25 +99,34 @@ func (s *Server) Run() error {
 		compress1KB,
 	))
 
-	auth := authenticate(s.logger, s.store)
+	auth, err := authenticate(s.logger, s.config, s.store)
+	if err != nil {
+		return fmt.Errorf("authentication: %v", err)
+	}
 	handler := authn.NewMiddleware(auth).Wrap(mux)
 
+	handler = corsutil.New(nil).Handler(handler)
+	if !s.config.TLS.Enabled() {
+		handler = h2c.NewHandler(handler, &http2.Server{})
+	}
+
 	s.server = &http.Server{
-		Addr: s.config.Addr,
-		Handler: h2c.NewHandler(
-			corsutil.New(nil).Handler(handler),
-			&http2.Server{},
-		),
+		Addr:              s.config.Addr,
+		Handler:           handler,
 		ReadHeaderTimeout: time.Second,
 		ReadTimeout:       5 * time.Minute,
 		WriteTimeout:      5 * time.Minute,
 		MaxHeaderBytes:    8 * 1024, // 8KiB
 	}
 
-	var err error
 	if s.ln, err = net.Listen("tcp", s.config.Addr); err != nil {
 		return err
 	}
+	if s.config.TLS.Enabled() {
+		if s.ln, err = tlsutil.NewListener(s.logger.WithName("tls"), s.ln, s.config.TLS, "h2", "http/1.1"); err != nil {
+			return fmt.Errorf("tls: %v", err)
+		}
+	}
 
 	go func() {
 		s.logger.Info("Listening...", "addr", s.ln.Addr())
@@ -139,13 +148,17 @@ func (s *Server) CreatePackage(ctx context.Context, req *connect.Request[adminv1
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
 
-	pkg, err := s.ctrl.Submit(ctx, req.Msg)
-	if err != nil {
+	id, err := s.ctrl.Submit(ctx, req.Msg)
+	if errors.Is(err, controller.ErrInvalidProcessingConfig) || errors.Is(err, controller.ErrInvalidTransferSource) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	} else if errors.Is(err, controller.ErrIdempotencyKeyReused) {
+		return nil, connect.NewError(connect.CodeAlreadyExists, err)
+	} else if err != nil {
 		return nil, connect.NewError(connect.CodeUnknown, nil)
 	}
 
 	return connect.NewResponse(&adminv1.CreatePackageResponse{
-		Id: pkg.ID().String(),
+		Id: id.String(),
 	}), nil
 }
 
@@ -172,7 +185,9 @@ func (s *Server) ReadPackage(ctx context.Context, req *connect.Request[adminv1.R
 		Status: t.Status,
 	}
 
-	if dir, jobs, err := s.listJobs(ctx, id, true); err != nil {
+	standby := s.standby()
+
+	if dir, jobs, err := s.listJobs(ctx, id, !standby); err != nil {
 		s.logger.Error(err, "Failed to read jobs.")
 		return nil, connect.NewError(connect.CodeUnknown, nil)
 	} else {
@@ -185,11 +200,23 @@ func (s *Server) ReadPackage(ctx context.Context, req *connect.Request[adminv1.R
 		Pkg: pkg,
 	}
 
+	if standby {
+		return connect.NewResponse(resp), nil
+	}
+
 	if decisions, ok := s.ctrl.PackageDecisions(id); ok {
 		resp.Pkg.Status = adminv1.PackageStatus_PACKAGE_STATUS_AWAITING_DECISION
 		resp.Decision = decisions
 	}
 
+	if progress, ok := s.ctrl.CopyProgress(id); ok {
+		resp.CopyProgress = progress
+	}
+
+	if msg, ok := s.ctrl.QueueMessage(id); ok {
+		resp.QueueMessage = msg
+	}
+
 	return connect.NewResponse(resp), nil
 }
 
@@ -199,10 +226,6 @@ func (s *Server) ListPackages(ctx context.Context, req *connect.Request[adminv1.
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
 
-	if resp := s.cache.Get(req.Msg.Type); resp != nil {
-		return connect.NewResponse(resp.Value()), nil
-	}
-
 	// ReadPackagesWithCreationTimestamps hides packages by default, i.e.
 	// req.Msg.ExcludeHidden not needed at this point.
 	pkgs, err := s.store.ReadPackagesWithCreationTimestamps(ctx, req.Msg.Type)
@@ -213,17 +236,21 @@ func (s *Server) ListPackages(ctx context.Context, req *connect.Request[adminv1.
 
 	// TODO: if we have a SIP, we should provide the access_system_id (transser).
 
-	// Populate directory and jobs for each package.
+	// Populate directory and jobs for each package, the jobs of all packages
+	// are read at once.
+	pkgJobs, err := s.store.ListPackageJobs(ctx, req.Msg.Type)
+	if err != nil {
+		s.logger.Error(err, "Failed to read jobs.")
+		return nil, connect.NewError(connect.CodeUnknown, nil)
+	}
+	standby := s.standby()
 	for _, pkg := range pkgs {
 		pkgID, _ := uuid.Parse(pkg.Id)
-		if dir, jobs, err := s.listJobs(ctx, pkgID, true); err != nil {
-			s.logger.Error(err, "Failed to read jobs.")
-			return nil, connect.NewError(connect.CodeUnknown, nil)
-		} else {
-			pkg.Name = packageName(pkgID, dir)
-			pkg.Directory = dir
-			pkg.Job = jobs
-		}
+		jobs := pkgJobs[pkgID]
+		dir := s.prepareJobs(pkgID, jobs, !standby)
+		pkg.Name = packageName(pkgID, dir)
+		pkg.Directory = dir
+		pkg.Job = jobs
 	}
 
 	return connect.NewResponse(&adminv1.ListPackagesResponse{
@@ -237,6 +264,12 @@ func (s *Server) ListDecisions(ctx context.Context, req *connect.Request[adminv1
 	}
 
 	decisions := []*adminv1.Decision{}
+	if s.standby() {
+		return connect.NewResponse(&adminv1.ListDecisionsResponse{
+			Decision: decisions,
+		}), nil
+	}
+
 	for _, item := range s.ctrl.Decisions() {
 		decisions = slices.Concat(decisions, item)
 	}
@@ -246,6 +279,51 @@ func (s *Server) ListDecisions(ctx context.Context, req *connect.Request[adminv1
 	}), nil
 }
 
+func (s *Server) ListQueue(ctx context.Context, req *connect.Request[adminv1.ListQueueRequest]) (*connect.Response[adminv1.ListQueueResponse], error) {
+	if err := s.v.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	if s.standby() {
+		pkgs, err := s.ctrl.PersistedQueue(ctx)
+		if err != nil {
+			s.logger.Error(err, "Failed to read the persisted queue.")
+			return nil, connect.NewError(connect.CodeUnknown, nil)
+		}
+		return connect.NewResponse(&adminv1.ListQueueResponse{
+			Package: pkgs,
+		}), nil
+	}
+
+	pkgs, msg := s.ctrl.Queue()
+
+	return connect.NewResponse(&adminv1.ListQueueResponse{
+		Package: pkgs,
+		Message: msg,
+	}), nil
+}
+
+func (s *Server) ReorderQueue(ctx context.Context, req *connect.Request[adminv1.ReorderQueueRequest]) (*connect.Response[adminv1.ReorderQueueResponse], error) {
+	if err := s.v.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	id := uuid.MustParse(req.Msg.PackageId)
+	pos := int(min(req.Msg.Position, math.MaxInt32))
+
+	pkgs, err := s.ctrl.ReorderQueue(ctx, id, pos)
+	if errors.Is(err, controller.ErrNotQueued) {
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	} else if err != nil {
+		s.logger.Error(err, "Failed to reorder queue.")
+		return nil, connect.NewError(connect.CodeUnknown, nil)
+	}
+
+	return connect.NewResponse(&adminv1.ReorderQueueResponse{
+		Package: pkgs,
+	}), nil
+}
+
 func (s *Server) ResolveDecision(ctx context.Context, req *connect.Request[adminv1.ResolveDecisionRequest]) (*connect.Response[adminv1.ResolveDecisionResponse], error) {
 	if err := s.v.Validate(req.Msg); err != nil {
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
@@ -283,18 +361,28 @@ func (s *Server) Close(ctx context.Context) error {
 		}
 	}
 
-	s.cache.Stop()
-	s.wg.Wait()
-
 	return nil
 }
 
+// standby reports whether this instance is on standby. The controller of an
+// instance on standby is idle, so the reads leave out the state it keeps, e.g.
+// the awaiting decisions, and answer from the database instead.
+func (s *Server) standby() bool {
+	return s.leadership != nil && !s.leadership.Leader()
+}
+
 func (s *Server) listJobs(ctx context.Context, pkgID uuid.UUID, withDecisions bool) (string, []*adminv1.Job, error) {
 	jobs, err := s.store.ListJobs(ctx, pkgID)
 	if err != nil {
 		return "", nil, err
 	}
 
+	return s.prepareJobs(pkgID, jobs, withDecisions), jobs, nil
+}
+
+// prepareJobs returns the current directory of a package given its jobs, most
+// recent first, and includes the pending decisions in the jobs when requested.
+func (s *Server) prepareJobs(pkgID uuid.UUID, jobs []*adminv1.Job, withDecisions bool) string {
 	// The first item in the list is the most recent, i.e. it contains the
 	// current directory.
 	dir := ""
@@ -307,7 +395,7 @@ func (s *Server) listJobs(ctx context.Context, pkgID uuid.UUID, withDecisions bo
 	if withDecisions {
 		decisions, ok := s.ctrl.PackageDecisions(pkgID)
 		if !ok {
-			return dir, jobs, nil
+			return dir
 		}
 		for _, j := range jobs {
 			for _, d := range decisions {
@@ -318,7 +406,7 @@ func (s *Server) listJobs(ctx context.Context, pkgID uuid.UUID, withDecisions bo
 		}
 	}
 
-	return dir, jobs, nil
+	return dir
 }
 
 var (
@@ -1,10 +1,20 @@
 package admin
 
 import (
+	"context"
 	"testing"
 
+	"connectrpc.com/connect"
+	"github.com/bufbuild/protovalidate-go"
+	"github.com/go-logr/logr"
 	"github.com/google/uuid"
+	"go.artefactual.dev/tools/mockutil"
+	"go.uber.org/mock/gomock"
 	"gotest.tools/v3/assert"
+
+	adminv1 "github.com/artefactual-labs/ccp/internal/api/gen/archivematica/ccp/admin/v1beta1"
+	"github.com/artefactual-labs/ccp/internal/controller"
+	"github.com/artefactual-labs/ccp/internal/store/storemock"
 )
 
 func TestPackageName(t *testing.T) {
@@ -16,3 +26,40 @@ func TestPackageName(t *testing.T) {
 	assert.Equal(t, packageName(id, "%sharedPath%watchedDirectories/activeTransfers/standardTransfer/tmp.mCCClmmx0f"), "tmp.mCCClmmx0f")
 	assert.Equal(t, packageName(id, "%sharedPath%watchedDirectories/activeTransfers/standardTransfer/tmp.mCCClmmx0f/"), "tmp.mCCClmmx0f")
 }
+
+func TestListPackages(t *testing.T) {
+	t.Parallel()
+
+	v, err := protovalidate.New()
+	assert.NilError(t, err)
+
+	t.Run("Reads the jobs of all packages at once", func(t *testing.T) {
+		t.Parallel()
+
+		first, second := uuid.New(), uuid.New()
+		s := storemock.NewMockStore(gomock.NewController(t))
+		s.EXPECT().ReadPackagesWithCreationTimestamps(mockutil.Context(), adminv1.PackageType_PACKAGE_TYPE_TRANSFER).Return(
+			[]*adminv1.Package{{Id: first.String()}, {Id: second.String()}}, nil,
+		).Times(1)
+		s.EXPECT().ListPackageJobs(mockutil.Context(), adminv1.PackageType_PACKAGE_TYPE_TRANSFER).Return(
+			map[uuid.UUID][]*adminv1.Job{
+				first: {
+					{Id: uuid.NewString(), Directory: "%sharedPath%currentlyProcessing/images-" + first.String() + "/"},
+					{Id: uuid.NewString(), Directory: "%sharedPath%watchedDirectories/activeTransfers/standardTransfer/images/"},
+				},
+			}, nil,
+		).Times(1)
+
+		ctrl := controller.New(logr.Discard(), controller.Config{}, nil, s, nil, nil, "", "")
+		srv := &Server{logger: logr.Discard(), store: s, ctrl: ctrl, v: v}
+		resp, err := srv.ListPackages(context.Background(), connect.NewRequest(&adminv1.ListPackagesRequest{
+			Type: adminv1.PackageType_PACKAGE_TYPE_TRANSFER,
+		}))
+		assert.NilError(t, err)
+		assert.Equal(t, len(resp.Msg.Package), 2)
+		assert.Equal(t, resp.Msg.Package[0].Name, "images")
+		assert.Equal(t, len(resp.Msg.Package[0].Job), 2)
+		assert.Equal(t, resp.Msg.Package[1].Name, second.String())
+		assert.Equal(t, len(resp.Msg.Package[1].Job), 0)
+	})
+}
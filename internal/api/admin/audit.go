@@ -0,0 +1,241 @@
+package admin
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"connectrpc.com/authn"
+	"connectrpc.com/connect"
+	"github.com/go-logr/logr"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	adminv1 "github.com/artefactual-labs/ccp/internal/api/gen/archivematica/ccp/admin/v1beta1"
+	adminv1connect "github.com/artefactual-labs/ccp/internal/api/gen/archivematica/ccp/admin/v1beta1/adminv1beta1connect"
+	"github.com/artefactual-labs/ccp/internal/store"
+)
+
+// mutatingProcedures lists the procedures that modify the state. They are
+// recorded in the audit trail and rejected by standby instances.
+var mutatingProcedures = map[string]struct{}{
+	adminv1connect.AdminServiceCreatePackageProcedure:          {},
+	adminv1connect.AdminServiceResolveDecisionProcedure:        {},
+	adminv1connect.AdminServiceReorderQueueProcedure:           {},
+	adminv1connect.AdminServiceApproveJobProcedure:             {},
+	adminv1connect.AdminServiceApproveTransferByPathProcedure:  {},
+	adminv1connect.AdminServiceApprovePartialReingestProcedure: {},
+}
+
+const (
+	auditOutcomeOK = "ok"
+
+	defaultAuditPageSize = 100
+)
+
+// auditor records the calls to the audited procedures, including those that
+// fail or are denied.
+type auditor struct {
+	logger logr.Logger
+	store  store.Store
+	now    func() time.Time
+}
+
+var _ connect.Interceptor = (*auditor)(nil)
+
+func newAuditor(logger logr.Logger, store store.Store) *auditor {
+	return &auditor{
+		logger: logger,
+		store:  store,
+		now:    time.Now,
+	}
+}
+
+func (a *auditor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if _, ok := mutatingProcedures[req.Spec().Procedure]; !ok {
+			return next(ctx, req)
+		}
+
+		resp, err := next(ctx, req)
+
+		// The event is recorded even if the client goes away.
+		a.record(context.WithoutCancel(ctx), req, resp, err)
+
+		return resp, err
+	}
+}
+
+func (a *auditor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (a *auditor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return next
+}
+
+func (a *auditor) record(ctx context.Context, req connect.AnyRequest, resp connect.AnyResponse, err error) {
+	event := &store.AuditEvent{
+		CreatedAt: a.now().UTC(),
+		Procedure: procedureName(req.Spec().Procedure),
+		Outcome:   auditOutcomeOK,
+	}
+
+	if user, ok := authn.GetInfo(ctx).(*store.User); ok && user != nil {
+		event.Username = user.Username
+		if user.ID > 0 {
+			event.UserID = sql.NullInt32{Int32: int32(user.ID), Valid: true}
+		}
+	}
+
+	if msg, ok := req.Any().(proto.Message); ok {
+		if blob, err := protojson.Marshal(msg); err == nil {
+			event.Summary = string(blob)
+		}
+	}
+
+	if err != nil {
+		event.Outcome = connect.CodeOf(err).String()
+		if connectErr := new(connect.Error); errors.As(err, &connectErr) {
+			event.Message = connectErr.Message()
+		} else {
+			event.Message = err.Error()
+		}
+	}
+
+	event.PackageID, event.DecisionID = auditRelated(req, resp, err)
+
+	if err := a.store.CreateAuditEvent(ctx, event); err != nil {
+		a.logger.Error(err, "Failed to record audit event.", "procedure", event.Procedure, "username", event.Username, "outcome", event.Outcome)
+	}
+}
+
+// auditRelated returns the identifiers of the package and the decision that
+// the call relates to, based on the request and the response messages.
+func auditRelated(req connect.AnyRequest, resp connect.AnyResponse, err error) (pkgID, decisionID uuid.NullUUID) {
+	parse := func(s string) uuid.NullUUID {
+		id, err := uuid.Parse(s)
+		return uuid.NullUUID{UUID: id, Valid: err == nil}
+	}
+
+	// On error, resp may be a typed nil that cannot be used.
+	var respMsg any
+	if err == nil {
+		respMsg = resp.Any()
+	}
+
+	switch msg := req.Any().(type) {
+	case *adminv1.CreatePackageRequest:
+		if r, ok := respMsg.(*adminv1.CreatePackageResponse); ok {
+			pkgID = parse(r.Id)
+		}
+	case *adminv1.ReorderQueueRequest:
+		pkgID = parse(msg.PackageId)
+	case *adminv1.ResolveDecisionRequest:
+		decisionID = parse(msg.Id)
+	case *adminv1.ApproveJobRequest:
+		decisionID = parse(msg.JobId)
+	case *adminv1.ApproveTransferByPathRequest:
+		if r, ok := respMsg.(*adminv1.ApproveTransferByPathResponse); ok {
+			pkgID = parse(r.Id)
+		}
+	case *adminv1.ApprovePartialReingestRequest:
+		pkgID = parse(msg.Id)
+	}
+
+	return pkgID, decisionID
+}
+
+// procedureName returns the name of the method in the procedure, e.g.
+// "CreatePackage" for "/archivematica.ccp.admin.v1beta1.AdminService/CreatePackage".
+func procedureName(procedure string) string {
+	if i := strings.LastIndexByte(procedure, '/'); i >= 0 {
+		return procedure[i+1:]
+	}
+	return procedure
+}
+
+func (s *Server) ListAuditEvents(ctx context.Context, req *connect.Request[adminv1.ListAuditEventsRequest]) (*connect.Response[adminv1.ListAuditEventsResponse], error) {
+	if err := s.v.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	pageSize := int(req.Msg.PageSize)
+	if pageSize == 0 {
+		pageSize = defaultAuditPageSize
+	}
+
+	// Fetch one more event to find out whether there is a next page.
+	params := &store.ListAuditEventsParams{Limit: uint(pageSize + 1)}
+	if v := req.Msg.Username; v != "" {
+		params.Username = &v
+	}
+	if v := req.Msg.Procedure; v != "" {
+		params.Procedure = &v
+	}
+	if v := req.Msg.PackageId; v != "" {
+		id := uuid.MustParse(v)
+		params.PackageID = &id
+	}
+	if v := req.Msg.DecisionId; v != "" {
+		id := uuid.MustParse(v)
+		params.DecisionID = &id
+	}
+	if v := req.Msg.Outcome; v != "" {
+		params.Outcome = &v
+	}
+	if v := req.Msg.StartTime; v != nil {
+		t := v.AsTime()
+		params.Since = &t
+	}
+	if v := req.Msg.EndTime; v != nil {
+		t := v.AsTime()
+		params.Until = &t
+	}
+	if v := req.Msg.PageToken; v != "" {
+		id, err := strconv.ParseInt(v, 10, 64)
+		if err != nil || id <= 0 {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("invalid page token"))
+		}
+		params.BeforeID = &id
+	}
+
+	events, err := s.store.ListAuditEvents(ctx, params)
+	if err != nil {
+		s.logger.Error(err, "Failed to list audit events.")
+		return nil, connect.NewError(connect.CodeUnknown, nil)
+	}
+
+	resp := &adminv1.ListAuditEventsResponse{}
+	if len(events) > pageSize {
+		events = events[:pageSize]
+		resp.NextPageToken = strconv.FormatInt(events[len(events)-1].ID, 10)
+	}
+
+	resp.Event = make([]*adminv1.AuditEvent, 0, len(events))
+	for _, e := range events {
+		item := &adminv1.AuditEvent{
+			Id:        e.ID,
+			CreatedAt: timestamppb.New(e.CreatedAt),
+			Username:  e.Username,
+			Procedure: e.Procedure,
+			Summary:   e.Summary,
+			Outcome:   e.Outcome,
+			Message:   e.Message,
+		}
+		if e.PackageID.Valid {
+			item.PackageId = e.PackageID.UUID.String()
+		}
+		if e.DecisionID.Valid {
+			item.DecisionId = e.DecisionID.UUID.String()
+		}
+		resp.Event = append(resp.Event, item)
+	}
+
+	return connect.NewResponse(resp), nil
+}
@@ -0,0 +1,224 @@
+package admin
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"connectrpc.com/authn"
+	"connectrpc.com/connect"
+	"github.com/bufbuild/protovalidate-go"
+	"github.com/go-logr/logr"
+	"github.com/google/uuid"
+	"go.artefactual.dev/tools/mockutil"
+	"go.artefactual.dev/tools/ref"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gotest.tools/v3/assert"
+
+	adminv1 "github.com/artefactual-labs/ccp/internal/api/gen/archivematica/ccp/admin/v1beta1"
+	adminv1connect "github.com/artefactual-labs/ccp/internal/api/gen/archivematica/ccp/admin/v1beta1/adminv1beta1connect"
+	"github.com/artefactual-labs/ccp/internal/store"
+	"github.com/artefactual-labs/ccp/internal/store/storemock"
+)
+
+var (
+	auditPkgID      = uuid.MustParse("a7a1d5c0-2b61-4a63-9d3a-3f4cfd2b0c45")
+	auditDecisionID = uuid.MustParse("0e0d5a36-3b4b-4a43-9d68-7c5c1a2f6b9e")
+	auditNow        = time.Date(2024, 10, 1, 12, 0, 0, 0, time.UTC)
+)
+
+// auditTestHandler resolves decisions and creates packages without side effects.
+type auditTestHandler struct {
+	adminv1connect.UnimplementedAdminServiceHandler
+}
+
+func (auditTestHandler) CreatePackage(ctx context.Context, req *connect.Request[adminv1.CreatePackageRequest]) (*connect.Response[adminv1.CreatePackageResponse], error) {
+	return connect.NewResponse(&adminv1.CreatePackageResponse{Id: auditPkgID.String()}), nil
+}
+
+func (auditTestHandler) ResolveDecision(ctx context.Context, req *connect.Request[adminv1.ResolveDecisionRequest]) (*connect.Response[adminv1.ResolveDecisionResponse], error) {
+	return nil, connect.NewError(connect.CodeNotFound, errors.New("decision not found"))
+}
+
+func (auditTestHandler) ListDecisions(ctx context.Context, req *connect.Request[adminv1.ListDecisionsRequest]) (*connect.Response[adminv1.ListDecisionsResponse], error) {
+	return connect.NewResponse(&adminv1.ListDecisionsResponse{}), nil
+}
+
+func newAuditTestClient(t *testing.T, s store.Store, opts ...connect.HandlerOption) adminv1connect.AdminServiceClient {
+	t.Helper()
+
+	a := newAuditor(logr.Discard(), s)
+	a.now = func() time.Time { return auditNow }
+
+	opts = append([]connect.HandlerOption{connect.WithInterceptors(a)}, opts...)
+	path, handler := adminv1connect.NewAdminServiceHandler(auditTestHandler{}, opts...)
+
+	mux := http.NewServeMux()
+	mux.Handle(path, handler)
+
+	auth := func(ctx context.Context, req *http.Request) (any, error) {
+		return &store.User{ID: 7, Username: "jdoe", Groups: []string{"readers"}}, nil
+	}
+	srv := httptest.NewServer(authn.NewMiddleware(auth).Wrap(mux))
+	t.Cleanup(srv.Close)
+
+	return adminv1connect.NewAdminServiceClient(srv.Client(), srv.URL)
+}
+
+func TestAuditor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Records successful calls", func(t *testing.T) {
+		t.Parallel()
+
+		s := storemock.NewMockStore(gomock.NewController(t))
+		s.EXPECT().CreateAuditEvent(mockutil.Context(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, event *store.AuditEvent) error {
+				assert.Equal(t, event.CreatedAt, auditNow)
+				assert.Equal(t, event.UserID, sql.NullInt32{Int32: 7, Valid: true})
+				assert.Equal(t, event.Username, "jdoe")
+				assert.Equal(t, event.Procedure, "CreatePackage")
+				assert.Assert(t, event.Summary != "")
+				assert.Equal(t, event.Outcome, "ok")
+				assert.Equal(t, event.Message, "")
+				assert.Equal(t, event.PackageID, uuid.NullUUID{UUID: auditPkgID, Valid: true})
+				assert.Equal(t, event.DecisionID.Valid, false)
+				return nil
+			},
+		)
+
+		client := newAuditTestClient(t, s)
+		_, err := client.CreatePackage(context.Background(), connect.NewRequest(&adminv1.CreatePackageRequest{
+			Name: "Images",
+			Path: []string{"/tmp/images"},
+		}))
+		assert.NilError(t, err)
+	})
+
+	t.Run("Records failed calls", func(t *testing.T) {
+		t.Parallel()
+
+		s := storemock.NewMockStore(gomock.NewController(t))
+		s.EXPECT().CreateAuditEvent(mockutil.Context(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, event *store.AuditEvent) error {
+				assert.Equal(t, event.Procedure, "ResolveDecision")
+				assert.Equal(t, event.Outcome, "not_found")
+				assert.Equal(t, event.Message, "decision not found")
+				assert.Equal(t, event.DecisionID, uuid.NullUUID{UUID: auditDecisionID, Valid: true})
+				return nil
+			},
+		)
+
+		client := newAuditTestClient(t, s)
+		_, err := client.ResolveDecision(context.Background(), connect.NewRequest(&adminv1.ResolveDecisionRequest{
+			Id: auditDecisionID.String(),
+		}))
+		assert.Equal(t, connect.CodeOf(err), connect.CodeNotFound)
+	})
+
+	t.Run("Records denied calls", func(t *testing.T) {
+		t.Parallel()
+
+		s := storemock.NewMockStore(gomock.NewController(t))
+		s.EXPECT().ReadUserGroups(mockutil.Context(), 7).Return([]string{}, nil)
+		s.EXPECT().CreateAuditEvent(mockutil.Context(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, event *store.AuditEvent) error {
+				assert.Equal(t, event.Procedure, "CreatePackage")
+				assert.Equal(t, event.Outcome, "permission_denied")
+				assert.Equal(t, event.PackageID.Valid, false)
+				return nil
+			},
+		)
+
+		authz := newAuthorizer(logr.Discard(), RBACConfig{Enabled: true, Viewer: []string{"readers"}}, s)
+		client := newAuditTestClient(t, s, connect.WithInterceptors(authz))
+		_, err := client.CreatePackage(context.Background(), connect.NewRequest(&adminv1.CreatePackageRequest{
+			Name: "Images",
+			Path: []string{"/tmp/images"},
+		}))
+		assert.Equal(t, connect.CodeOf(err), connect.CodePermissionDenied)
+	})
+
+	t.Run("Ignores read-only calls", func(t *testing.T) {
+		t.Parallel()
+
+		s := storemock.NewMockStore(gomock.NewController(t))
+
+		client := newAuditTestClient(t, s)
+		_, err := client.ListDecisions(context.Background(), connect.NewRequest(&adminv1.ListDecisionsRequest{}))
+		assert.NilError(t, err)
+	})
+
+	t.Run("Does not fail the call when the event cannot be recorded", func(t *testing.T) {
+		t.Parallel()
+
+		s := storemock.NewMockStore(gomock.NewController(t))
+		s.EXPECT().CreateAuditEvent(mockutil.Context(), gomock.Any()).Return(errors.New("database is gone"))
+
+		client := newAuditTestClient(t, s)
+		_, err := client.CreatePackage(context.Background(), connect.NewRequest(&adminv1.CreatePackageRequest{
+			Name: "Images",
+			Path: []string{"/tmp/images"},
+		}))
+		assert.NilError(t, err)
+	})
+}
+
+func TestListAuditEvents(t *testing.T) {
+	t.Parallel()
+
+	v, err := protovalidate.New()
+	assert.NilError(t, err)
+
+	t.Run("Lists events using filters and pagination", func(t *testing.T) {
+		t.Parallel()
+
+		s := storemock.NewMockStore(gomock.NewController(t))
+		s.EXPECT().ListAuditEvents(mockutil.Context(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, params *store.ListAuditEventsParams) ([]*store.AuditEvent, error) {
+				assert.DeepEqual(t, params, &store.ListAuditEventsParams{
+					Username:  ref.New("jdoe"),
+					PackageID: &auditPkgID,
+					Since:     &auditNow,
+					BeforeID:  ref.New(int64(10)),
+					Limit:     3,
+				})
+				return []*store.AuditEvent{
+					{ID: 9, CreatedAt: auditNow, Username: "jdoe", Procedure: "CreatePackage", Outcome: "ok", PackageID: uuid.NullUUID{UUID: auditPkgID, Valid: true}},
+					{ID: 8, CreatedAt: auditNow, Username: "jdoe", Procedure: "ApprovePartialReingest", Outcome: "ok", PackageID: uuid.NullUUID{UUID: auditPkgID, Valid: true}},
+					{ID: 5, CreatedAt: auditNow, Username: "jdoe", Procedure: "CreatePackage", Outcome: "unknown", PackageID: uuid.NullUUID{UUID: auditPkgID, Valid: true}},
+				}, nil
+			},
+		)
+
+		srv := &Server{logger: logr.Discard(), store: s, v: v}
+		resp, err := srv.ListAuditEvents(context.Background(), connect.NewRequest(&adminv1.ListAuditEventsRequest{
+			Username:  "jdoe",
+			PackageId: auditPkgID.String(),
+			StartTime: timestamppb.New(auditNow),
+			PageSize:  2,
+			PageToken: "10",
+		}))
+		assert.NilError(t, err)
+		assert.Equal(t, len(resp.Msg.Event), 2)
+		assert.Equal(t, resp.Msg.Event[0].Id, int64(9))
+		assert.Equal(t, resp.Msg.Event[0].PackageId, auditPkgID.String())
+		assert.Equal(t, resp.Msg.Event[0].DecisionId, "")
+		assert.Equal(t, resp.Msg.NextPageToken, "8")
+	})
+
+	t.Run("Rejects invalid page tokens", func(t *testing.T) {
+		t.Parallel()
+
+		srv := &Server{logger: logr.Discard(), v: v}
+		_, err := srv.ListAuditEvents(context.Background(), connect.NewRequest(&adminv1.ListAuditEventsRequest{
+			PageToken: "next",
+		}))
+		assert.Equal(t, connect.CodeOf(err), connect.CodeInvalidArgument)
+	})
+}
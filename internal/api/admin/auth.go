@@ -13,10 +13,20 @@ import (
 
 var errInvalidAuth = authn.Errorf("invalid authorization")
 
-func authenticate(logger logr.Logger, store store.Store) authn.AuthFunc {
-	return multiAuthenticate(
+func authenticate(logger logr.Logger, config Config, store store.Store) (authn.AuthFunc, error) {
+	methods := []authn.AuthFunc{
 		authApiKey(logger, store),
-	)
+	}
+
+	if config.OIDC.enabled() {
+		v, err := newTokenVerifier(config.OIDC, store)
+		if err != nil {
+			return nil, err
+		}
+		methods = append(methods, authBearer(logger, v))
+	}
+
+	return multiAuthenticate(methods...), nil
 }
 
 func multiAuthenticate(methods ...authn.AuthFunc) authn.AuthFunc {
@@ -0,0 +1,289 @@
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"connectrpc.com/authn"
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+	"github.com/go-logr/logr"
+
+	"github.com/artefactual-labs/ccp/internal/store"
+)
+
+// signatureAlgorithms is the list of algorithms accepted in bearer tokens.
+var signatureAlgorithms = []jose.SignatureAlgorithm{
+	jose.RS256, jose.RS384, jose.RS512,
+	jose.PS256, jose.PS384, jose.PS512,
+	jose.ES256, jose.ES384, jose.ES512,
+	jose.EdDSA,
+}
+
+// tokenLeeway is the clock skew tolerated when validating time-based claims.
+const tokenLeeway = time.Minute
+
+// tokenVerifier verifies bearer tokens and maps their claims to users.
+type tokenVerifier struct {
+	config OIDCConfig
+	keys   keySet
+	store  store.Store
+	now    func() time.Time
+}
+
+func newTokenVerifier(config OIDCConfig, store store.Store) (*tokenVerifier, error) {
+	if config.Issuer == "" || config.Audience == "" {
+		return nil, errors.New("oidc: issuer and audience are required")
+	}
+	if config.UsernameClaim == "" {
+		config.UsernameClaim = "preferred_username"
+	}
+	if config.GroupsClaim == "" {
+		config.GroupsClaim = "groups"
+	}
+
+	var keys keySet
+	switch {
+	case config.JWKSFile != "" && config.JWKSURL != "":
+		return nil, errors.New("oidc: JWKS file and URL are mutually exclusive")
+	case config.JWKSFile != "":
+		blob, err := os.ReadFile(config.JWKSFile)
+		if err != nil {
+			return nil, fmt.Errorf("oidc: read JWKS: %v", err)
+		}
+		var set jose.JSONWebKeySet
+		if err := json.Unmarshal(blob, &set); err != nil {
+			return nil, fmt.Errorf("oidc: parse JWKS: %v", err)
+		}
+		keys = &staticKeySet{set: set}
+	default:
+		keys = &remoteKeySet{
+			url:    config.JWKSURL,
+			client: &http.Client{Timeout: 10 * time.Second},
+			now:    time.Now,
+		}
+	}
+
+	return &tokenVerifier{
+		config: config,
+		keys:   keys,
+		store:  store,
+		now:    time.Now,
+	}, nil
+}
+
+// verify returns the user identified by a valid token.
+func (v *tokenVerifier) verify(ctx context.Context, token string) (*store.User, error) {
+	tok, err := jwt.ParseSigned(token, signatureAlgorithms)
+	if err != nil {
+		return nil, err
+	}
+	if len(tok.Headers) != 1 {
+		return nil, errors.New("unexpected number of signatures")
+	}
+
+	key, err := v.keys.key(ctx, tok.Headers[0].KeyID)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		claims jwt.Claims
+		extra  map[string]any
+	)
+	if err := tok.Claims(key.Key, &claims, &extra); err != nil {
+		return nil, err
+	}
+	if claims.Expiry == nil {
+		return nil, errors.New("missing expiration time")
+	}
+	if err := claims.ValidateWithLeeway(jwt.Expected{
+		Issuer:      v.config.Issuer,
+		AnyAudience: jwt.Audience{v.config.Audience},
+		Time:        v.now(),
+	}, tokenLeeway); err != nil {
+		return nil, err
+	}
+
+	username, _ := extra[v.config.UsernameClaim].(string)
+	if username == "" {
+		return nil, fmt.Errorf("missing claim %q", v.config.UsernameClaim)
+	}
+
+	user, err := v.store.ReadUserWithUsername(ctx, username)
+	if errors.Is(err, store.ErrNotFound) {
+		// The user is not known to Archivematica, actions cannot be attributed
+		// to an agent.
+		email, _ := extra["email"].(string)
+		user = &store.User{Username: username, Email: email, Active: true}
+	} else if err != nil {
+		return nil, err
+	} else if !user.Active {
+		return nil, fmt.Errorf("user %q is not active", username)
+	}
+	user.Groups = claimStrings(extra[v.config.GroupsClaim])
+
+	return user, nil
+}
+
+// claimStrings returns the values of a claim that can be either a string or a
+// list of strings.
+func claimStrings(claim any) []string {
+	switch v := claim.(type) {
+	case string:
+		return []string{v}
+	case []any:
+		ret := make([]string, 0, len(v))
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				ret = append(ret, s)
+			}
+		}
+		return ret
+	default:
+		return nil
+	}
+}
+
+// authBearer authenticates requests with bearer tokens.
+func authBearer(logger logr.Logger, v *tokenVerifier) authn.AuthFunc {
+	return func(ctx context.Context, req *http.Request) (any, error) {
+		token, ok := parseBearer(req.Header.Get("Authorization"))
+		if !ok {
+			return nil, errInvalidAuth
+		}
+
+		user, err := v.verify(ctx, token)
+		if err != nil {
+			logger.V(1).Info("Invalid bearer token.", "err", err)
+			return nil, errInvalidAuth
+		}
+
+		return user, nil
+	}
+}
+
+// parseBearer parses the Bearer string.
+// "Bearer abc" returns ("abc", true).
+func parseBearer(auth string) (token string, ok bool) {
+	const prefix = "Bearer "
+	// Case insensitive prefix match.
+	if len(auth) < len(prefix) || !equalFold(auth[:len(prefix)], prefix) {
+		return "", false
+	}
+	token = strings.TrimSpace(auth[len(prefix):])
+	return token, token != ""
+}
+
+// keySet provides the keys used to verify token signatures.
+type keySet interface {
+	key(ctx context.Context, kid string) (*jose.JSONWebKey, error)
+}
+
+// findKey returns the signing key with the given identifier. Tokens without an
+// identifier are accepted when there is only one key in the set.
+func findKey(set *jose.JSONWebKeySet, kid string) (*jose.JSONWebKey, bool) {
+	if kid == "" {
+		if len(set.Keys) == 1 && set.Keys[0].Use != "enc" {
+			return &set.Keys[0], true
+		}
+		return nil, false
+	}
+	for _, key := range set.Key(kid) {
+		if key.Use != "enc" {
+			return &key, true
+		}
+	}
+	return nil, false
+}
+
+// staticKeySet is a keySet loaded from a file.
+type staticKeySet struct {
+	set jose.JSONWebKeySet
+}
+
+func (s *staticKeySet) key(ctx context.Context, kid string) (*jose.JSONWebKey, error) {
+	if key, ok := findKey(&s.set, kid); ok {
+		return key, nil
+	}
+	return nil, fmt.Errorf("key %q not found", kid)
+}
+
+const (
+	// jwksMaxAge is the time after which the remote key set is refreshed.
+	jwksMaxAge = time.Hour
+
+	// jwksMinRefreshInterval limits how often the remote key set is refreshed
+	// when tokens are signed with unknown keys.
+	jwksMinRefreshInterval = time.Minute
+)
+
+// remoteKeySet is a keySet retrieved from a URL. It is cached and refreshed
+// when it expires or when a key is not found, e.g. after a key rotation.
+type remoteKeySet struct {
+	url    string
+	client *http.Client
+	now    func() time.Time
+
+	mu        sync.Mutex
+	set       *jose.JSONWebKeySet
+	fetchedAt time.Time
+}
+
+func (s *remoteKeySet) key(ctx context.Context, kid string) (*jose.JSONWebKey, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	if s.set != nil && now.Sub(s.fetchedAt) < jwksMaxAge {
+		if key, ok := findKey(s.set, kid); ok {
+			return key, nil
+		}
+		if now.Sub(s.fetchedAt) < jwksMinRefreshInterval {
+			return nil, fmt.Errorf("key %q not found", kid)
+		}
+	}
+
+	set, err := s.fetch(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fetch JWKS: %v", err)
+	}
+	s.set, s.fetchedAt = set, now
+
+	if key, ok := findKey(s.set, kid); ok {
+		return key, nil
+	}
+	return nil, fmt.Errorf("key %q not found", kid)
+}
+
+func (s *remoteKeySet) fetch(ctx context.Context) (*jose.JSONWebKeySet, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		return nil, fmt.Errorf("unexpected status %q", resp.Status)
+	}
+
+	var set jose.JSONWebKeySet
+	if err := json.NewDecoder(io.LimitReader(resp.Body, 1<<20)).Decode(&set); err != nil {
+		return nil, err
+	}
+
+	return &set, nil
+}
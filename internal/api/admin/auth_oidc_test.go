@@ -0,0 +1,300 @@
+package admin
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"connectrpc.com/authn"
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+	"github.com/go-logr/logr"
+	"go.artefactual.dev/tools/mockutil"
+	"go.artefactual.dev/tools/ref"
+	"go.uber.org/mock/gomock"
+	"gotest.tools/v3/assert"
+
+	"github.com/artefactual-labs/ccp/internal/store"
+	"github.com/artefactual-labs/ccp/internal/store/storemock"
+)
+
+// testSigningKey is a signing key and its public JSON Web Key Set.
+type testSigningKey struct {
+	signer jose.Signer
+	jwks   jose.JSONWebKeySet
+}
+
+func newTestSigningKey(t *testing.T, kid string) *testSigningKey {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NilError(t, err)
+
+	signer, err := jose.NewSigner(
+		jose.SigningKey{Algorithm: jose.ES256, Key: key},
+		(&jose.SignerOptions{}).WithType("JWT").WithHeader("kid", kid),
+	)
+	assert.NilError(t, err)
+
+	return &testSigningKey{
+		signer: signer,
+		jwks: jose.JSONWebKeySet{Keys: []jose.JSONWebKey{
+			{Key: key.Public(), KeyID: kid, Algorithm: string(jose.ES256), Use: "sig"},
+		}},
+	}
+}
+
+func (k *testSigningKey) token(t *testing.T, claims jwt.Claims, extra map[string]any) string {
+	t.Helper()
+
+	token, err := jwt.Signed(k.signer).Claims(claims).Claims(extra).Serialize()
+	assert.NilError(t, err)
+
+	return token
+}
+
+func (k *testSigningKey) writeJWKS(t *testing.T) string {
+	t.Helper()
+
+	blob, err := json.Marshal(k.jwks)
+	assert.NilError(t, err)
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	assert.NilError(t, os.WriteFile(path, blob, 0o600))
+
+	return path
+}
+
+func validClaims() jwt.Claims {
+	now := time.Now()
+	return jwt.Claims{
+		Issuer:   "https://idp.example.com",
+		Audience: jwt.Audience{"ccp"},
+		Subject:  "1234",
+		IssuedAt: jwt.NewNumericDate(now),
+		Expiry:   jwt.NewNumericDate(now.Add(time.Hour)),
+	}
+}
+
+func TestTokenVerifier(t *testing.T) {
+	t.Parallel()
+
+	key := newTestSigningKey(t, "key-1")
+	config := OIDCConfig{
+		Issuer:   "https://idp.example.com",
+		Audience: "ccp",
+		JWKSFile: key.writeJWKS(t),
+	}
+
+	t.Run("Maps claims to a known user", func(t *testing.T) {
+		t.Parallel()
+
+		s := storemock.NewMockStore(gomock.NewController(t))
+		s.EXPECT().ReadUserWithUsername(mockutil.Context(), "jdoe").Return(&store.User{
+			ID:       12,
+			Username: "jdoe",
+			Email:    "jdoe@example.com",
+			Active:   true,
+			AgentID:  ref.New(3),
+		}, nil)
+
+		v, err := newTokenVerifier(config, s)
+		assert.NilError(t, err)
+
+		user, err := v.verify(context.Background(), key.token(t, validClaims(), map[string]any{
+			"preferred_username": "jdoe",
+			"groups":             []string{"archivists", "admins"},
+		}))
+		assert.NilError(t, err)
+		assert.DeepEqual(t, user, &store.User{
+			ID:       12,
+			Username: "jdoe",
+			Email:    "jdoe@example.com",
+			Active:   true,
+			AgentID:  ref.New(3),
+			Groups:   []string{"archivists", "admins"},
+		})
+	})
+
+	t.Run("Maps claims to an unknown user", func(t *testing.T) {
+		t.Parallel()
+
+		s := storemock.NewMockStore(gomock.NewController(t))
+		s.EXPECT().ReadUserWithUsername(mockutil.Context(), "jdoe@example.com").Return(nil, store.ErrNotFound)
+
+		v, err := newTokenVerifier(OIDCConfig{
+			Issuer:        config.Issuer,
+			Audience:      config.Audience,
+			JWKSFile:      config.JWKSFile,
+			UsernameClaim: "email",
+			GroupsClaim:   "roles",
+		}, s)
+		assert.NilError(t, err)
+
+		user, err := v.verify(context.Background(), key.token(t, validClaims(), map[string]any{
+			"email": "jdoe@example.com",
+			"roles": "operators",
+		}))
+		assert.NilError(t, err)
+		assert.DeepEqual(t, user, &store.User{
+			Username: "jdoe@example.com",
+			Email:    "jdoe@example.com",
+			Active:   true,
+			Groups:   []string{"operators"},
+		})
+	})
+
+	t.Run("Rejects invalid tokens", func(t *testing.T) {
+		t.Parallel()
+
+		v, err := newTokenVerifier(config, storemock.NewMockStore(gomock.NewController(t)))
+		assert.NilError(t, err)
+
+		extra := map[string]any{"preferred_username": "jdoe"}
+
+		expired := validClaims()
+		expired.Expiry = jwt.NewNumericDate(time.Now().Add(-time.Hour))
+
+		noExpiry := validClaims()
+		noExpiry.Expiry = nil
+
+		wrongIssuer := validClaims()
+		wrongIssuer.Issuer = "https://evil.example.com"
+
+		wrongAudience := validClaims()
+		wrongAudience.Audience = jwt.Audience{"dashboard"}
+
+		for name, tc := range map[string]struct {
+			token string
+			err   string
+		}{
+			"Expired":        {key.token(t, expired, extra), "go-jose/go-jose/jwt: validation failed, token is expired (exp)"},
+			"No expiry":      {key.token(t, noExpiry, extra), "missing expiration time"},
+			"Wrong issuer":   {key.token(t, wrongIssuer, extra), "go-jose/go-jose/jwt: validation failed, invalid issuer claim (iss)"},
+			"Wrong audience": {key.token(t, wrongAudience, extra), "go-jose/go-jose/jwt: validation failed, invalid audience claim (aud)"},
+			"No username":    {key.token(t, validClaims(), nil), `missing claim "preferred_username"`},
+			"Unknown key":    {newTestSigningKey(t, "key-2").token(t, validClaims(), extra), `key "key-2" not found`},
+			"Wrong key":      {newTestSigningKey(t, "key-1").token(t, validClaims(), extra), "go-jose/go-jose: error in cryptographic primitive"},
+			"Malformed":      {"abc", "go-jose/go-jose: compact JWS format must have three parts"},
+		} {
+			_, err := v.verify(context.Background(), tc.token)
+			assert.Error(t, err, tc.err, name)
+		}
+	})
+
+	t.Run("Rejects inactive users", func(t *testing.T) {
+		t.Parallel()
+
+		s := storemock.NewMockStore(gomock.NewController(t))
+		s.EXPECT().ReadUserWithUsername(mockutil.Context(), "jdoe").Return(&store.User{Username: "jdoe"}, nil)
+
+		v, err := newTokenVerifier(config, s)
+		assert.NilError(t, err)
+
+		_, err = v.verify(context.Background(), key.token(t, validClaims(), map[string]any{"preferred_username": "jdoe"}))
+		assert.Error(t, err, `user "jdoe" is not active`)
+	})
+
+	t.Run("Requires issuer and audience", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := newTokenVerifier(OIDCConfig{JWKSFile: config.JWKSFile}, nil)
+		assert.Error(t, err, "oidc: issuer and audience are required")
+	})
+}
+
+func TestRemoteKeySet(t *testing.T) {
+	t.Parallel()
+
+	var (
+		key1     = newTestSigningKey(t, "key-1")
+		key2     = newTestSigningKey(t, "key-2")
+		current  = key1
+		requests = 0
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		json.NewEncoder(w).Encode(current.jwks) //nolint: errcheck
+	}))
+	t.Cleanup(srv.Close)
+
+	now := time.Now()
+	ks := &remoteKeySet{url: srv.URL, client: srv.Client(), now: func() time.Time { return now }}
+	ctx := context.Background()
+
+	key, err := ks.key(ctx, "key-1")
+	assert.NilError(t, err)
+	assert.Equal(t, key.KeyID, "key-1")
+	assert.Equal(t, requests, 1)
+
+	// Cached.
+	_, err = ks.key(ctx, "key-1")
+	assert.NilError(t, err)
+	assert.Equal(t, requests, 1)
+
+	// Keys are rotated but the cache is too recent to be refreshed.
+	current = key2
+	_, err = ks.key(ctx, "key-2")
+	assert.Error(t, err, `key "key-2" not found`)
+	assert.Equal(t, requests, 1)
+
+	// Refreshed after the minimum interval.
+	now = now.Add(jwksMinRefreshInterval)
+	key, err = ks.key(ctx, "key-2")
+	assert.NilError(t, err)
+	assert.Equal(t, key.KeyID, "key-2")
+	assert.Equal(t, requests, 2)
+}
+
+func TestBearerAuthentication(t *testing.T) {
+	t.Parallel()
+
+	key := newTestSigningKey(t, "key-1")
+	s := storemock.NewMockStore(gomock.NewController(t))
+	s.EXPECT().ValidateUserAPIKey(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	s.EXPECT().ReadUserWithUsername(mockutil.Context(), "jdoe").Return(&store.User{
+		ID:       12,
+		Username: "jdoe",
+		Active:   true,
+		AgentID:  ref.New(3),
+	}, nil).AnyTimes()
+
+	auth, err := authenticate(logr.Discard(), Config{
+		OIDC: OIDCConfig{
+			Issuer:   "https://idp.example.com",
+			Audience: "ccp",
+			JWKSFile: key.writeJWKS(t),
+		},
+	}, s)
+	assert.NilError(t, err)
+
+	handler := authn.NewMiddleware(auth).Wrap(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user := authn.GetInfo(r.Context()).(*store.User)
+		assert.Equal(t, user.Username, "jdoe")
+		assert.Equal(t, *user.AgentID, 3)
+	}))
+
+	for _, tc := range []struct {
+		auth string
+		code int
+	}{
+		{"Bearer " + key.token(t, validClaims(), map[string]any{"preferred_username": "jdoe"}), http.StatusOK},
+		{"bearer " + key.token(t, validClaims(), map[string]any{"preferred_username": "jdoe"}), http.StatusOK},
+		{"Bearer invalid", http.StatusUnauthorized},
+		{"ApiKey test:test", http.StatusUnauthorized},
+	} {
+		req := httptest.NewRequest("GET", "http://example.com/foo", nil)
+		req.Header.Set("Authorization", tc.auth)
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+
+		assert.Equal(t, w.Result().StatusCode, tc.code)
+	}
+}
@@ -0,0 +1,149 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"connectrpc.com/authn"
+	"connectrpc.com/connect"
+	"github.com/go-logr/logr"
+
+	adminv1connect "github.com/artefactual-labs/ccp/internal/api/gen/archivematica/ccp/admin/v1beta1/adminv1beta1connect"
+	"github.com/artefactual-labs/ccp/internal/store"
+)
+
+// Role is a set of permissions granted to the members of a group.
+type Role string
+
+const (
+	// RoleViewer can read packages, decisions and configuration.
+	RoleViewer Role = "viewer"
+	// RoleOperator can also create packages.
+	RoleOperator Role = "operator"
+	// RoleApprover can also resolve decisions.
+	RoleApprover Role = "approver"
+	// RoleAdmin can use every procedure.
+	RoleAdmin Role = "admin"
+)
+
+var (
+	readers   = []Role{RoleViewer, RoleOperator, RoleApprover, RoleAdmin}
+	operators = []Role{RoleOperator, RoleAdmin}
+	approvers = []Role{RoleApprover, RoleAdmin}
+	admins    = []Role{RoleAdmin}
+)
+
+// procedureRoles lists the roles allowed to call each procedure of the
+// AdminService. Procedures not listed here are denied.
+var procedureRoles = map[string][]Role{
+	adminv1connect.AdminServiceCreatePackageProcedure:                     operators,
+	adminv1connect.AdminServiceReadPackageProcedure:                       readers,
+	adminv1connect.AdminServiceListPackagesProcedure:                      readers,
+	adminv1connect.AdminServiceListDecisionsProcedure:                     readers,
+	adminv1connect.AdminServiceResolveDecisionProcedure:                   approvers,
+	adminv1connect.AdminServiceListProcessingConfigurationFieldsProcedure: readers,
+	adminv1connect.AdminServiceListAuditEventsProcedure:                   admins,
+	adminv1connect.AdminServiceListQueueProcedure:                         readers,
+	adminv1connect.AdminServiceReorderQueueProcedure:                      operators,
+	adminv1connect.AdminServiceApproveJobProcedure:                        approvers,
+	adminv1connect.AdminServiceApproveTransferByPathProcedure:             approvers,
+	adminv1connect.AdminServiceApprovePartialReingestProcedure:            approvers,
+}
+
+var errPermissionDenied = errors.New("permission denied")
+
+// authorizer maps the groups of the authenticated user to roles and checks
+// them against the roles required by the procedure.
+type authorizer struct {
+	logger logr.Logger
+	config RBACConfig
+	store  store.Store
+}
+
+var _ connect.Interceptor = (*authorizer)(nil)
+
+func newAuthorizer(logger logr.Logger, config RBACConfig, store store.Store) *authorizer {
+	return &authorizer{
+		logger: logger,
+		config: config,
+		store:  store,
+	}
+}
+
+func (a *authorizer) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if err := a.authorize(ctx, req.Spec().Procedure); err != nil {
+			return nil, err
+		}
+		return next(ctx, req)
+	}
+}
+
+func (a *authorizer) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (a *authorizer) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		if err := a.authorize(ctx, conn.Spec().Procedure); err != nil {
+			return err
+		}
+		return next(ctx, conn)
+	}
+}
+
+func (a *authorizer) authorize(ctx context.Context, procedure string) error {
+	user, ok := authn.GetInfo(ctx).(*store.User)
+	if !ok || user == nil {
+		return connect.NewError(connect.CodePermissionDenied, errPermissionDenied)
+	}
+
+	roles, err := a.roles(ctx, user)
+	if err != nil {
+		a.logger.Error(err, "Cannot look up user roles.", "username", user.Username)
+		return connect.NewError(connect.CodeUnknown, nil)
+	}
+
+	for _, role := range procedureRoles[procedure] {
+		if slices.Contains(roles, role) {
+			return nil
+		}
+	}
+
+	a.logger.V(1).Info("Permission denied.", "username", user.Username, "procedure", procedure, "roles", roles)
+
+	return connect.NewError(
+		connect.CodePermissionDenied,
+		fmt.Errorf("%w: user %q is not allowed to call %s", errPermissionDenied, user.Username, procedure),
+	)
+}
+
+// roles returns the roles of the user based on the groups in the token claims
+// and the groups the user belongs to in the database.
+func (a *authorizer) roles(ctx context.Context, user *store.User) ([]Role, error) {
+	groups := slices.Clone(user.Groups)
+
+	// Users authenticated with a token may not exist in the database.
+	if user.ID > 0 {
+		dbGroups, err := a.store.ReadUserGroups(ctx, user.ID)
+		if err != nil {
+			return nil, err
+		}
+		groups = append(groups, dbGroups...)
+	}
+
+	roles := []Role{}
+	for role, members := range a.config.members() {
+		for _, group := range groups {
+			if slices.Contains(members, group) {
+				roles = append(roles, role)
+				break
+			}
+		}
+	}
+	slices.Sort(roles)
+
+	return roles, nil
+}
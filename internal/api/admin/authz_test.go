@@ -0,0 +1,148 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"connectrpc.com/authn"
+	"connectrpc.com/connect"
+	"github.com/go-logr/logr"
+	"go.artefactual.dev/tools/mockutil"
+	"go.uber.org/mock/gomock"
+	"gotest.tools/v3/assert"
+
+	adminv1 "github.com/artefactual-labs/ccp/internal/api/gen/archivematica/ccp/admin/v1beta1"
+	adminv1connect "github.com/artefactual-labs/ccp/internal/api/gen/archivematica/ccp/admin/v1beta1/adminv1beta1connect"
+	"github.com/artefactual-labs/ccp/internal/store"
+	"github.com/artefactual-labs/ccp/internal/store/storemock"
+)
+
+func TestProcedureRoles(t *testing.T) {
+	t.Parallel()
+
+	svc := adminv1.File_archivematica_ccp_admin_v1beta1_service_proto.Services().ByName("AdminService")
+	assert.Assert(t, svc != nil)
+
+	methods := svc.Methods()
+	for i := range methods.Len() {
+		procedure := fmt.Sprintf("/%s/%s", svc.FullName(), methods.Get(i).Name())
+		roles, ok := procedureRoles[procedure]
+		assert.Assert(t, ok, "procedure %s is missing from procedureRoles", procedure)
+		assert.Assert(t, len(roles) > 0, "procedure %s has no roles", procedure)
+	}
+	assert.Equal(t, len(procedureRoles), methods.Len())
+}
+
+func TestAuthorizer(t *testing.T) {
+	t.Parallel()
+
+	config := RBACConfig{
+		Enabled:  true,
+		Viewer:   []string{"readers"},
+		Operator: []string{"operators"},
+		Approver: []string{"approvers"},
+		Admin:    []string{"admins"},
+	}
+
+	type test struct {
+		name      string
+		user      *store.User
+		dbGroups  []string
+		dbErr     error
+		procedure string
+		code      connect.Code // Zero when the call is allowed.
+	}
+	for _, tc := range []test{
+		{
+			name:      "Denies anonymous requests",
+			procedure: adminv1connect.AdminServiceListPackagesProcedure,
+			code:      connect.CodePermissionDenied,
+		},
+		{
+			name:      "Denies users without roles",
+			user:      &store.User{ID: 1, Username: "jdoe"},
+			dbGroups:  []string{"unrelated"},
+			procedure: adminv1connect.AdminServiceListPackagesProcedure,
+			code:      connect.CodePermissionDenied,
+		},
+		{
+			name:      "Allows viewers to read",
+			user:      &store.User{ID: 1, Username: "jdoe"},
+			dbGroups:  []string{"readers"},
+			procedure: adminv1connect.AdminServiceReadPackageProcedure,
+		},
+		{
+			name:      "Denies viewers to create packages",
+			user:      &store.User{ID: 1, Username: "jdoe"},
+			dbGroups:  []string{"readers"},
+			procedure: adminv1connect.AdminServiceCreatePackageProcedure,
+			code:      connect.CodePermissionDenied,
+		},
+		{
+			name:      "Allows operators to create packages",
+			user:      &store.User{ID: 1, Username: "jdoe"},
+			dbGroups:  []string{"operators"},
+			procedure: adminv1connect.AdminServiceCreatePackageProcedure,
+		},
+		{
+			name:      "Denies operators to resolve decisions",
+			user:      &store.User{ID: 1, Username: "jdoe"},
+			dbGroups:  []string{"operators"},
+			procedure: adminv1connect.AdminServiceResolveDecisionProcedure,
+			code:      connect.CodePermissionDenied,
+		},
+		{
+			name:      "Allows approvers to resolve decisions",
+			user:      &store.User{ID: 1, Username: "jdoe"},
+			dbGroups:  []string{"approvers"},
+			procedure: adminv1connect.AdminServiceApproveJobProcedure,
+		},
+		{
+			name:      "Allows admins to call any procedure",
+			user:      &store.User{ID: 1, Username: "jdoe"},
+			dbGroups:  []string{"readers", "admins"},
+			procedure: adminv1connect.AdminServiceCreatePackageProcedure,
+		},
+		{
+			name:      "Uses the groups in the token claims",
+			user:      &store.User{Username: "jdoe", Groups: []string{"operators"}},
+			procedure: adminv1connect.AdminServiceCreatePackageProcedure,
+		},
+		{
+			name:      "Denies unknown procedures",
+			user:      &store.User{Username: "jdoe", Groups: []string{"admins"}},
+			procedure: "/archivematica.ccp.admin.v1beta1.AdminService/Unknown",
+			code:      connect.CodePermissionDenied,
+		},
+		{
+			name:      "Fails when groups cannot be read",
+			user:      &store.User{ID: 1, Username: "jdoe"},
+			dbErr:     errors.New("database is gone"),
+			procedure: adminv1connect.AdminServiceListPackagesProcedure,
+			code:      connect.CodeUnknown,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := storemock.NewMockStore(gomock.NewController(t))
+			if tc.user != nil && tc.user.ID > 0 {
+				s.EXPECT().ReadUserGroups(mockutil.Context(), tc.user.ID).Return(tc.dbGroups, tc.dbErr)
+			}
+
+			ctx := context.Background()
+			if tc.user != nil {
+				ctx = authn.SetInfo(ctx, tc.user)
+			}
+
+			err := newAuthorizer(logr.Discard(), config, s).authorize(ctx, tc.procedure)
+			if tc.code == 0 {
+				assert.NilError(t, err)
+				return
+			}
+			assert.Equal(t, connect.CodeOf(err), tc.code)
+		})
+	}
+}
@@ -1,5 +1,62 @@
 package admin
 
+import "github.com/artefactual-labs/ccp/internal/tlsutil"
+
 type Config struct {
 	Addr string
+	TLS  tlsutil.Config
+	OIDC OIDCConfig
+	RBAC RBACConfig
+}
+
+// OIDCConfig configures the authentication of bearer tokens (JWT) issued by an
+// OpenID Connect provider. It is enabled when a JWKS source is configured.
+type OIDCConfig struct {
+	// Issuer is the expected value of the "iss" claim.
+	Issuer string
+
+	// Audience is the expected value of the "aud" claim.
+	Audience string
+
+	// JWKSFile is the path to a file with the JSON Web Key Set used to verify
+	// the tokens.
+	JWKSFile string
+
+	// JWKSURL is the URL of the JSON Web Key Set used to verify the tokens,
+	// e.g. the jwks_uri of the provider.
+	JWKSURL string
+
+	// UsernameClaim is the claim that identifies the user, defaults to
+	// "preferred_username".
+	UsernameClaim string
+
+	// GroupsClaim is the claim listing the groups of the user, defaults to
+	// "groups".
+	GroupsClaim string
+}
+
+func (c OIDCConfig) enabled() bool {
+	return c.JWKSFile != "" || c.JWKSURL != ""
+}
+
+// RBACConfig configures the authorization of the procedures. Each role lists
+// the groups whose members are granted the role, matching both the auth_group
+// memberships of the user and the groups claim of bearer tokens. When disabled,
+// every authenticated user can use every procedure.
+type RBACConfig struct {
+	Enabled bool
+
+	Viewer   []string
+	Operator []string
+	Approver []string
+	Admin    []string
+}
+
+func (c RBACConfig) members() map[Role][]string {
+	return map[Role][]string{
+		RoleViewer:   c.Viewer,
+		RoleOperator: c.Operator,
+		RoleApprover: c.Approver,
+		RoleAdmin:    c.Admin,
+	}
 }
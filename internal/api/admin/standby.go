@@ -0,0 +1,69 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/go-logr/logr"
+)
+
+// Leadership tells whether this instance is the leader when multiple instances
+// share the database, see the election package.
+type Leadership interface {
+	Leader() bool
+	LeaderID(ctx context.Context) (string, error)
+}
+
+// standbyGuard rejects the calls to mutating procedures when this instance is
+// on standby, since only the leader processes packages. Reads are accepted and
+// answered from the database, see Server.standby. The error uses the unavailable code so clients can retry, e.g.
+// after a failover.
+type standbyGuard struct {
+	logger     logr.Logger
+	leadership Leadership
+}
+
+var _ connect.Interceptor = (*standbyGuard)(nil)
+
+func newStandbyGuard(logger logr.Logger, leadership Leadership) *standbyGuard {
+	return &standbyGuard{
+		logger:     logger,
+		leadership: leadership,
+	}
+}
+
+func (g *standbyGuard) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if g.leadership.Leader() {
+			return next(ctx, req)
+		}
+		if _, ok := mutatingProcedures[req.Spec().Procedure]; ok {
+			return nil, g.reject(ctx, "does not accept changes")
+		}
+		return next(ctx, req)
+	}
+}
+
+func (g *standbyGuard) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (g *standbyGuard) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return next
+}
+
+func (g *standbyGuard) reject(ctx context.Context, reason string) error {
+	id, err := g.leadership.LeaderID(ctx)
+	if err != nil {
+		g.logger.Error(err, "Failed to read the leader.")
+	}
+
+	msg := "this instance is on standby and " + reason
+	if id != "" {
+		msg = fmt.Sprintf("%s, use the active instance (%s)", msg, id)
+	}
+
+	return connect.NewError(connect.CodeUnavailable, errors.New(msg))
+}
@@ -0,0 +1,264 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/bufbuild/protovalidate-go"
+	"github.com/go-logr/logr"
+	"github.com/google/uuid"
+	"go.artefactual.dev/tools/mockutil"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gotest.tools/v3/assert"
+
+	adminv1 "github.com/artefactual-labs/ccp/internal/api/gen/archivematica/ccp/admin/v1beta1"
+	adminv1connect "github.com/artefactual-labs/ccp/internal/api/gen/archivematica/ccp/admin/v1beta1/adminv1beta1connect"
+	"github.com/artefactual-labs/ccp/internal/controller"
+	"github.com/artefactual-labs/ccp/internal/store"
+	"github.com/artefactual-labs/ccp/internal/store/enums"
+	"github.com/artefactual-labs/ccp/internal/store/storemock"
+)
+
+type leadership struct {
+	leader bool
+	id     string
+	err    error
+}
+
+func (l leadership) Leader() bool {
+	return l.leader
+}
+
+func (l leadership) LeaderID(ctx context.Context) (string, error) {
+	return l.id, l.err
+}
+
+// standbyTestHandler answers the reads with empty responses.
+type standbyTestHandler struct {
+	auditTestHandler
+}
+
+func (standbyTestHandler) ListPackages(ctx context.Context, req *connect.Request[adminv1.ListPackagesRequest]) (*connect.Response[adminv1.ListPackagesResponse], error) {
+	return connect.NewResponse(&adminv1.ListPackagesResponse{}), nil
+}
+
+func (standbyTestHandler) ReadPackage(ctx context.Context, req *connect.Request[adminv1.ReadPackageRequest]) (*connect.Response[adminv1.ReadPackageResponse], error) {
+	return connect.NewResponse(&adminv1.ReadPackageResponse{}), nil
+}
+
+func (standbyTestHandler) ListQueue(ctx context.Context, req *connect.Request[adminv1.ListQueueRequest]) (*connect.Response[adminv1.ListQueueResponse], error) {
+	return connect.NewResponse(&adminv1.ListQueueResponse{}), nil
+}
+
+func newStandbyTestClient(t *testing.T, l Leadership) adminv1connect.AdminServiceClient {
+	t.Helper()
+
+	path, handler := adminv1connect.NewAdminServiceHandler(
+		standbyTestHandler{},
+		connect.WithInterceptors(newStandbyGuard(logr.Discard(), l)),
+	)
+
+	mux := http.NewServeMux()
+	mux.Handle(path, handler)
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+
+	return adminv1connect.NewAdminServiceClient(srv.Client(), srv.URL)
+}
+
+func TestStandbyGuard(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name       string
+		leadership leadership
+		err        string
+	}{
+		{
+			name:       "Accepts changes on the leader",
+			leadership: leadership{leader: true, id: "ccp-0"},
+		},
+		{
+			name:       "Rejects changes on standby",
+			leadership: leadership{id: "ccp-0"},
+			err:        "unavailable: this instance is on standby and does not accept changes, use the active instance (ccp-0)",
+		},
+		{
+			name:       "Rejects changes on standby when the leader is unknown",
+			leadership: leadership{err: errors.New("connection refused")},
+			err:        "unavailable: this instance is on standby and does not accept changes",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			client := newStandbyTestClient(t, tc.leadership)
+
+			_, err := client.CreatePackage(context.Background(), connect.NewRequest(&adminv1.CreatePackageRequest{}))
+			if tc.err == "" {
+				assert.NilError(t, err)
+			} else {
+				assert.Error(t, err, tc.err)
+				assert.Equal(t, connect.CodeOf(err), connect.CodeUnavailable)
+			}
+
+			// Reads answered from the database are always accepted.
+			_, err = client.ListPackages(context.Background(), connect.NewRequest(&adminv1.ListPackagesRequest{}))
+			assert.NilError(t, err)
+		})
+	}
+}
+
+func TestStandbyGuardReads(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	reads := map[string]func(client adminv1connect.AdminServiceClient) error{
+		"ReadPackage": func(client adminv1connect.AdminServiceClient) error {
+			_, err := client.ReadPackage(ctx, connect.NewRequest(&adminv1.ReadPackageRequest{}))
+			return err
+		},
+		"ListDecisions": func(client adminv1connect.AdminServiceClient) error {
+			_, err := client.ListDecisions(ctx, connect.NewRequest(&adminv1.ListDecisionsRequest{}))
+			return err
+		},
+		"ListQueue": func(client adminv1connect.AdminServiceClient) error {
+			_, err := client.ListQueue(ctx, connect.NewRequest(&adminv1.ListQueueRequest{}))
+			return err
+		},
+	}
+
+	for _, tc := range []struct {
+		name       string
+		leadership leadership
+	}{
+		{
+			name:       "Accepts reads on the leader",
+			leadership: leadership{leader: true, id: "ccp-0"},
+		},
+		{
+			name:       "Accepts reads on standby",
+			leadership: leadership{id: "ccp-0"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			client := newStandbyTestClient(t, tc.leadership)
+			for name, read := range reads {
+				assert.NilError(t, read(client), name)
+			}
+		})
+	}
+}
+
+func TestStandbyReads(t *testing.T) {
+	t.Parallel()
+
+	v, err := protovalidate.New()
+	assert.NilError(t, err)
+
+	// The servers below have no controller, so any read of its state panics.
+	newServer := func(s store.Store, ctrl *controller.Controller) *Server {
+		return &Server{logger: logr.Discard(), store: s, ctrl: ctrl, v: v, leadership: leadership{id: "ccp-0"}}
+	}
+
+	t.Run("Reads a package from the database", func(t *testing.T) {
+		t.Parallel()
+
+		id := uuid.New()
+		s := storemock.NewMockStore(gomock.NewController(t))
+		s.EXPECT().ReadTransfer(mockutil.Context(), id).Return(
+			store.Transfer{ID: id, Status: adminv1.PackageStatus_PACKAGE_STATUS_PROCESSING}, nil,
+		).Times(1)
+		s.EXPECT().ListJobs(mockutil.Context(), id).Return(
+			[]*adminv1.Job{{Id: uuid.NewString(), Directory: "%sharedPath%currentlyProcessing/images-" + id.String() + "/"}}, nil,
+		).Times(1)
+
+		resp, err := newServer(s, nil).ReadPackage(context.Background(), connect.NewRequest(&adminv1.ReadPackageRequest{
+			Id: id.String(),
+		}))
+		assert.NilError(t, err)
+		assert.Equal(t, resp.Msg.Pkg.Name, "images")
+		assert.Equal(t, resp.Msg.Pkg.Status, adminv1.PackageStatus_PACKAGE_STATUS_PROCESSING)
+		assert.Equal(t, len(resp.Msg.Pkg.Job), 1)
+		assert.Assert(t, resp.Msg.Decision == nil)
+		assert.Assert(t, resp.Msg.CopyProgress == nil)
+		assert.Equal(t, resp.Msg.QueueMessage, "")
+	})
+
+	t.Run("Lists packages from the database", func(t *testing.T) {
+		t.Parallel()
+
+		id := uuid.New()
+		s := storemock.NewMockStore(gomock.NewController(t))
+		s.EXPECT().ReadPackagesWithCreationTimestamps(mockutil.Context(), adminv1.PackageType_PACKAGE_TYPE_TRANSFER).Return(
+			[]*adminv1.Package{{Id: id.String()}}, nil,
+		).Times(1)
+		s.EXPECT().ListPackageJobs(mockutil.Context(), adminv1.PackageType_PACKAGE_TYPE_TRANSFER).Return(
+			map[uuid.UUID][]*adminv1.Job{
+				id: {{Id: uuid.NewString(), Directory: "%sharedPath%currentlyProcessing/images-" + id.String() + "/"}},
+			}, nil,
+		).Times(1)
+
+		resp, err := newServer(s, nil).ListPackages(context.Background(), connect.NewRequest(&adminv1.ListPackagesRequest{
+			Type: adminv1.PackageType_PACKAGE_TYPE_TRANSFER,
+		}))
+		assert.NilError(t, err)
+		assert.Equal(t, len(resp.Msg.Package), 1)
+		assert.Equal(t, resp.Msg.Package[0].Name, "images")
+		assert.Equal(t, len(resp.Msg.Package[0].Job), 1)
+	})
+
+	t.Run("Lists no decisions", func(t *testing.T) {
+		t.Parallel()
+
+		s := storemock.NewMockStore(gomock.NewController(t))
+
+		resp, err := newServer(s, nil).ListDecisions(context.Background(), connect.NewRequest(&adminv1.ListDecisionsRequest{}))
+		assert.NilError(t, err)
+		assert.Equal(t, len(resp.Msg.Decision), 0)
+	})
+
+	t.Run("Lists the persisted queue", func(t *testing.T) {
+		t.Parallel()
+
+		id := uuid.New()
+		queuedAt := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+		s := storemock.NewMockStore(gomock.NewController(t))
+		s.EXPECT().ListQueueEntries(mockutil.Context()).Return(
+			[]*store.QueueEntry{
+				{
+					PackageID:   id,
+					PackageType: enums.PackageTypeSIP,
+					Path:        "%sharedPath%watchedDirectories/workFlowDecisions/images-" + id.String() + "/",
+					Priority:    int32(adminv1.PackagePriority_PACKAGE_PRIORITY_HIGH),
+					Size:        1024,
+					QueuedAt:    queuedAt,
+				},
+			}, nil,
+		).Times(1)
+
+		ctrl := controller.New(logr.Discard(), controller.Config{}, nil, s, nil, nil, "", "")
+		resp, err := newServer(s, ctrl).ListQueue(context.Background(), connect.NewRequest(&adminv1.ListQueueRequest{}))
+		assert.NilError(t, err)
+		assert.DeepEqual(t, resp.Msg.Package, []*adminv1.QueuedPackage{
+			{
+				Id:       id.String(),
+				Name:     "images",
+				Type:     adminv1.PackageType_PACKAGE_TYPE_SIP,
+				Priority: adminv1.PackagePriority_PACKAGE_PRIORITY_HIGH,
+				QueuedAt: timestamppb.New(queuedAt),
+				Size:     1024,
+			},
+		}, protocmp.Transform())
+		assert.Equal(t, resp.Msg.Message, "")
+	})
+}
@@ -45,4 +45,26 @@ func TestValidation(t *testing.T) {
  - path: value must contain at least 1 item(s) [repeated.min_items]
  - metadata_set_id: value must be a valid UUID [string.uuid]`)
 	})
+
+	t.Run("Reports processing configuration override issues", func(t *testing.T) {
+		t.Parallel()
+
+		v, err := protovalidate.New()
+		assert.NilError(t, err)
+
+		req := &adminv1.CreatePackageRequest{
+			Name: "asdf",
+			Path: []string{"/tmp"},
+			ProcessingConfigOverride: &adminv1.ProcessingConfigOverride{
+				Choice: []*adminv1.ProcessingConfigChoice{
+					{AppliesTo: "store-dip"},
+				},
+			},
+		}
+		err = v.Validate(req)
+
+		assert.Error(t, err, `validation error:
+ - processing_config_override.choice[0].applies_to: value must be a valid UUID [string.uuid]
+ - processing_config_override.choice[0].go_to_chain: value length must be at least 1 characters [string.min_len]`)
+	})
 }
@@ -51,6 +51,14 @@ const (
 	// AdminServiceListProcessingConfigurationFieldsProcedure is the fully-qualified name of the
 	// AdminService's ListProcessingConfigurationFields RPC.
 	AdminServiceListProcessingConfigurationFieldsProcedure = "/archivematica.ccp.admin.v1beta1.AdminService/ListProcessingConfigurationFields"
+	// AdminServiceListAuditEventsProcedure is the fully-qualified name of the AdminService's
+	// ListAuditEvents RPC.
+	AdminServiceListAuditEventsProcedure = "/archivematica.ccp.admin.v1beta1.AdminService/ListAuditEvents"
+	// AdminServiceListQueueProcedure is the fully-qualified name of the AdminService's ListQueue RPC.
+	AdminServiceListQueueProcedure = "/archivematica.ccp.admin.v1beta1.AdminService/ListQueue"
+	// AdminServiceReorderQueueProcedure is the fully-qualified name of the AdminService's ReorderQueue
+	// RPC.
+	AdminServiceReorderQueueProcedure = "/archivematica.ccp.admin.v1beta1.AdminService/ReorderQueue"
 	// AdminServiceApproveJobProcedure is the fully-qualified name of the AdminService's ApproveJob RPC.
 	AdminServiceApproveJobProcedure = "/archivematica.ccp.admin.v1beta1.AdminService/ApproveJob"
 	// AdminServiceApproveTransferByPathProcedure is the fully-qualified name of the AdminService's
@@ -70,6 +78,9 @@ var (
 	adminServiceListDecisionsMethodDescriptor                     = adminServiceServiceDescriptor.Methods().ByName("ListDecisions")
 	adminServiceResolveDecisionMethodDescriptor                   = adminServiceServiceDescriptor.Methods().ByName("ResolveDecision")
 	adminServiceListProcessingConfigurationFieldsMethodDescriptor = adminServiceServiceDescriptor.Methods().ByName("ListProcessingConfigurationFields")
+	adminServiceListAuditEventsMethodDescriptor                   = adminServiceServiceDescriptor.Methods().ByName("ListAuditEvents")
+	adminServiceListQueueMethodDescriptor                         = adminServiceServiceDescriptor.Methods().ByName("ListQueue")
+	adminServiceReorderQueueMethodDescriptor                      = adminServiceServiceDescriptor.Methods().ByName("ReorderQueue")
 	adminServiceApproveJobMethodDescriptor                        = adminServiceServiceDescriptor.Methods().ByName("ApproveJob")
 	adminServiceApproveTransferByPathMethodDescriptor             = adminServiceServiceDescriptor.Methods().ByName("ApproveTransferByPath")
 	adminServiceApprovePartialReingestMethodDescriptor            = adminServiceServiceDescriptor.Methods().ByName("ApprovePartialReingest")
@@ -99,6 +110,14 @@ type AdminServiceClient interface {
 	//
 	// It replaces `getProcessingConfigFields` (_get_processing_config_fields_handler).
 	ListProcessingConfigurationFields(context.Context, *connect.Request[v1beta1.ListProcessingConfigurationFieldsRequest]) (*connect.Response[v1beta1.ListProcessingConfigurationFieldsResponse], error)
+	// ListAuditEvents lists the audit trail of the mutating procedures, most
+	// recent first.
+	ListAuditEvents(context.Context, *connect.Request[v1beta1.ListAuditEventsRequest]) (*connect.Response[v1beta1.ListAuditEventsResponse], error)
+	// ListQueue lists the packages waiting to be processed, in the order they
+	// are expected to start.
+	ListQueue(context.Context, *connect.Request[v1beta1.ListQueueRequest]) (*connect.Response[v1beta1.ListQueueResponse], error)
+	// ReorderQueue moves a queued package to a new position in the queue.
+	ReorderQueue(context.Context, *connect.Request[v1beta1.ReorderQueueRequest]) (*connect.Response[v1beta1.ReorderQueueResponse], error)
 	// ApproveJob ...
 	//
 	// It replaces `approveJob` (_job_approve_handler).
@@ -165,6 +184,24 @@ func NewAdminServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			connect.WithSchema(adminServiceListProcessingConfigurationFieldsMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		listAuditEvents: connect.NewClient[v1beta1.ListAuditEventsRequest, v1beta1.ListAuditEventsResponse](
+			httpClient,
+			baseURL+AdminServiceListAuditEventsProcedure,
+			connect.WithSchema(adminServiceListAuditEventsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listQueue: connect.NewClient[v1beta1.ListQueueRequest, v1beta1.ListQueueResponse](
+			httpClient,
+			baseURL+AdminServiceListQueueProcedure,
+			connect.WithSchema(adminServiceListQueueMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		reorderQueue: connect.NewClient[v1beta1.ReorderQueueRequest, v1beta1.ReorderQueueResponse](
+			httpClient,
+			baseURL+AdminServiceReorderQueueProcedure,
+			connect.WithSchema(adminServiceReorderQueueMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		approveJob: connect.NewClient[v1beta1.ApproveJobRequest, v1beta1.ApproveJobResponse](
 			httpClient,
 			baseURL+AdminServiceApproveJobProcedure,
@@ -194,6 +231,9 @@ type adminServiceClient struct {
 	listDecisions                     *connect.Client[v1beta1.ListDecisionsRequest, v1beta1.ListDecisionsResponse]
 	resolveDecision                   *connect.Client[v1beta1.ResolveDecisionRequest, v1beta1.ResolveDecisionResponse]
 	listProcessingConfigurationFields *connect.Client[v1beta1.ListProcessingConfigurationFieldsRequest, v1beta1.ListProcessingConfigurationFieldsResponse]
+	listAuditEvents                   *connect.Client[v1beta1.ListAuditEventsRequest, v1beta1.ListAuditEventsResponse]
+	listQueue                         *connect.Client[v1beta1.ListQueueRequest, v1beta1.ListQueueResponse]
+	reorderQueue                      *connect.Client[v1beta1.ReorderQueueRequest, v1beta1.ReorderQueueResponse]
 	approveJob                        *connect.Client[v1beta1.ApproveJobRequest, v1beta1.ApproveJobResponse]
 	approveTransferByPath             *connect.Client[v1beta1.ApproveTransferByPathRequest, v1beta1.ApproveTransferByPathResponse]
 	approvePartialReingest            *connect.Client[v1beta1.ApprovePartialReingestRequest, v1beta1.ApprovePartialReingestResponse]
@@ -230,6 +270,21 @@ func (c *adminServiceClient) ListProcessingConfigurationFields(ctx context.Conte
 	return c.listProcessingConfigurationFields.CallUnary(ctx, req)
 }
 
+// ListAuditEvents calls archivematica.ccp.admin.v1beta1.AdminService.ListAuditEvents.
+func (c *adminServiceClient) ListAuditEvents(ctx context.Context, req *connect.Request[v1beta1.ListAuditEventsRequest]) (*connect.Response[v1beta1.ListAuditEventsResponse], error) {
+	return c.listAuditEvents.CallUnary(ctx, req)
+}
+
+// ListQueue calls archivematica.ccp.admin.v1beta1.AdminService.ListQueue.
+func (c *adminServiceClient) ListQueue(ctx context.Context, req *connect.Request[v1beta1.ListQueueRequest]) (*connect.Response[v1beta1.ListQueueResponse], error) {
+	return c.listQueue.CallUnary(ctx, req)
+}
+
+// ReorderQueue calls archivematica.ccp.admin.v1beta1.AdminService.ReorderQueue.
+func (c *adminServiceClient) ReorderQueue(ctx context.Context, req *connect.Request[v1beta1.ReorderQueueRequest]) (*connect.Response[v1beta1.ReorderQueueResponse], error) {
+	return c.reorderQueue.CallUnary(ctx, req)
+}
+
 // ApproveJob calls archivematica.ccp.admin.v1beta1.AdminService.ApproveJob.
 //
 // Deprecated: do not use.
@@ -276,6 +331,14 @@ type AdminServiceHandler interface {
 	//
 	// It replaces `getProcessingConfigFields` (_get_processing_config_fields_handler).
 	ListProcessingConfigurationFields(context.Context, *connect.Request[v1beta1.ListProcessingConfigurationFieldsRequest]) (*connect.Response[v1beta1.ListProcessingConfigurationFieldsResponse], error)
+	// ListAuditEvents lists the audit trail of the mutating procedures, most
+	// recent first.
+	ListAuditEvents(context.Context, *connect.Request[v1beta1.ListAuditEventsRequest]) (*connect.Response[v1beta1.ListAuditEventsResponse], error)
+	// ListQueue lists the packages waiting to be processed, in the order they
+	// are expected to start.
+	ListQueue(context.Context, *connect.Request[v1beta1.ListQueueRequest]) (*connect.Response[v1beta1.ListQueueResponse], error)
+	// ReorderQueue moves a queued package to a new position in the queue.
+	ReorderQueue(context.Context, *connect.Request[v1beta1.ReorderQueueRequest]) (*connect.Response[v1beta1.ReorderQueueResponse], error)
 	// ApproveJob ...
 	//
 	// It replaces `approveJob` (_job_approve_handler).
@@ -338,6 +401,24 @@ func NewAdminServiceHandler(svc AdminServiceHandler, opts ...connect.HandlerOpti
 		connect.WithSchema(adminServiceListProcessingConfigurationFieldsMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	adminServiceListAuditEventsHandler := connect.NewUnaryHandler(
+		AdminServiceListAuditEventsProcedure,
+		svc.ListAuditEvents,
+		connect.WithSchema(adminServiceListAuditEventsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceListQueueHandler := connect.NewUnaryHandler(
+		AdminServiceListQueueProcedure,
+		svc.ListQueue,
+		connect.WithSchema(adminServiceListQueueMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceReorderQueueHandler := connect.NewUnaryHandler(
+		AdminServiceReorderQueueProcedure,
+		svc.ReorderQueue,
+		connect.WithSchema(adminServiceReorderQueueMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	adminServiceApproveJobHandler := connect.NewUnaryHandler(
 		AdminServiceApproveJobProcedure,
 		svc.ApproveJob,
@@ -370,6 +451,12 @@ func NewAdminServiceHandler(svc AdminServiceHandler, opts ...connect.HandlerOpti
 			adminServiceResolveDecisionHandler.ServeHTTP(w, r)
 		case AdminServiceListProcessingConfigurationFieldsProcedure:
 			adminServiceListProcessingConfigurationFieldsHandler.ServeHTTP(w, r)
+		case AdminServiceListAuditEventsProcedure:
+			adminServiceListAuditEventsHandler.ServeHTTP(w, r)
+		case AdminServiceListQueueProcedure:
+			adminServiceListQueueHandler.ServeHTTP(w, r)
+		case AdminServiceReorderQueueProcedure:
+			adminServiceReorderQueueHandler.ServeHTTP(w, r)
 		case AdminServiceApproveJobProcedure:
 			adminServiceApproveJobHandler.ServeHTTP(w, r)
 		case AdminServiceApproveTransferByPathProcedure:
@@ -409,6 +496,18 @@ func (UnimplementedAdminServiceHandler) ListProcessingConfigurationFields(contex
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("archivematica.ccp.admin.v1beta1.AdminService.ListProcessingConfigurationFields is not implemented"))
 }
 
+func (UnimplementedAdminServiceHandler) ListAuditEvents(context.Context, *connect.Request[v1beta1.ListAuditEventsRequest]) (*connect.Response[v1beta1.ListAuditEventsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("archivematica.ccp.admin.v1beta1.AdminService.ListAuditEvents is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) ListQueue(context.Context, *connect.Request[v1beta1.ListQueueRequest]) (*connect.Response[v1beta1.ListQueueResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("archivematica.ccp.admin.v1beta1.AdminService.ListQueue is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) ReorderQueue(context.Context, *connect.Request[v1beta1.ReorderQueueRequest]) (*connect.Response[v1beta1.ReorderQueueResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("archivematica.ccp.admin.v1beta1.AdminService.ReorderQueue is not implemented"))
+}
+
 func (UnimplementedAdminServiceHandler) ApproveJob(context.Context, *connect.Request[v1beta1.ApproveJobRequest]) (*connect.Response[v1beta1.ApproveJobResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("archivematica.ccp.admin.v1beta1.AdminService.ApproveJob is not implemented"))
 }
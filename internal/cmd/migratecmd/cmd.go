@@ -0,0 +1,189 @@
+package migratecmd
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/peterbourgon/ff/v3"
+	"github.com/peterbourgon/ff/v3/ffcli"
+	"github.com/peterbourgon/ff/v3/fftoml"
+	"go.artefactual.dev/tools/log"
+
+	"github.com/artefactual-labs/ccp/internal/cmd/rootcmd"
+	"github.com/artefactual-labs/ccp/internal/store"
+)
+
+func New(rootConfig *rootcmd.Config, out io.Writer) *ffcli.Command {
+	fs := flag.NewFlagSet("ccp migrate", flag.ExitOnError)
+	rootConfig.RegisterFlags(fs)
+
+	return &ffcli.Command{
+		Name:       "migrate",
+		ShortUsage: "ccp migrate <subcommand> [flags]",
+		ShortHelp:  "Manage the database schema.",
+		FlagSet:    fs,
+		Subcommands: []*ffcli.Command{
+			newUpCommand(rootConfig, out),
+			newDownCommand(rootConfig, out),
+			newStatusCommand(rootConfig, out),
+		},
+		Exec: func(context.Context, []string) error {
+			return flag.ErrHelp
+		},
+	}
+}
+
+// config is shared by the subcommands, the database flags are the same used
+// by the server so they can be read from the same configuration file or
+// environment variables, e.g. CCP_DB_DSN.
+type config struct {
+	rootConfig *rootcmd.Config
+	out        io.Writer
+	driver     string
+	dsn        string
+	steps      int
+}
+
+func newFlagSet(name string, cfg *config) *flag.FlagSet {
+	fs := flag.NewFlagSet("ccp migrate "+name, flag.ExitOnError)
+	fs.String("config", "", "Configuration file in the TOML file format")
+	fs.StringVar(&cfg.driver, "db.driver", "", "Database driver (mysql, postgres, sqlite)")
+	fs.StringVar(&cfg.dsn, "db.dsn", "", "Database DSN")
+	cfg.rootConfig.RegisterFlags(fs)
+
+	return fs
+}
+
+func newCommand(name, shortHelp string, fs *flag.FlagSet, exec func(context.Context, []string) error) *ffcli.Command {
+	return &ffcli.Command{
+		Name:       name,
+		ShortUsage: "ccp migrate " + name + " [flags]",
+		ShortHelp:  shortHelp,
+		FlagSet:    fs,
+		Options: []ff.Option{
+			ff.WithEnvVarPrefix("CCP"),
+			ff.WithEnvVarSplit("_"),
+			ff.WithConfigFileFlag("config"),
+			ff.WithConfigFileParser(fftoml.Parser),
+			ff.WithIgnoreUndefined(true),
+		},
+		Exec: exec,
+	}
+}
+
+func newUpCommand(rootConfig *rootcmd.Config, out io.Writer) *ffcli.Command {
+	cfg := &config{rootConfig: rootConfig, out: out}
+	fs := newFlagSet("up", cfg)
+
+	return newCommand("up", "Apply the pending migrations.", fs, cfg.up)
+}
+
+func newDownCommand(rootConfig *rootcmd.Config, out io.Writer) *ffcli.Command {
+	cfg := &config{rootConfig: rootConfig, out: out}
+	fs := newFlagSet("down", cfg)
+	fs.IntVar(&cfg.steps, "steps", 1, "Number of migrations to revert")
+
+	return newCommand("down", "Revert the most recent migrations.", fs, cfg.down)
+}
+
+func newStatusCommand(rootConfig *rootcmd.Config, out io.Writer) *ffcli.Command {
+	cfg := &config{rootConfig: rootConfig, out: out}
+	fs := newFlagSet("status", cfg)
+
+	return newCommand("status", "List the migrations and whether they are applied.", fs, cfg.status)
+}
+
+func (c *config) migrator() (*store.Migrator, logr.Logger, error) {
+	logger := log.New(c.out, log.WithDebug(c.rootConfig.Debug), log.WithLevel(c.rootConfig.Verbosity))
+	logger = logger.WithName("migrate")
+
+	m, err := store.NewMigrator(logger, c.driver, c.dsn)
+	if err != nil {
+		log.Sync(logger)
+		return nil, logger, fmt.Errorf("error creating migrator: %v", err)
+	}
+
+	return m, logger, nil
+}
+
+func (c *config) up(ctx context.Context, args []string) error {
+	m, logger, err := c.migrator()
+	if err != nil {
+		return err
+	}
+	defer log.Sync(logger)
+	defer m.Close()
+
+	applied, err := m.Up(ctx)
+	for _, item := range applied {
+		fmt.Fprintf(c.out, "Applied %s.\n", item)
+	}
+	if err != nil {
+		return err
+	}
+	if len(applied) == 0 {
+		fmt.Fprintln(c.out, "No pending migrations.")
+	}
+
+	return nil
+}
+
+func (c *config) down(ctx context.Context, args []string) error {
+	if c.steps < 1 {
+		return flag.ErrHelp
+	}
+
+	m, logger, err := c.migrator()
+	if err != nil {
+		return err
+	}
+	defer log.Sync(logger)
+	defer m.Close()
+
+	reverted, err := m.Down(ctx, c.steps)
+	for _, item := range reverted {
+		fmt.Fprintf(c.out, "Reverted %s.\n", item)
+	}
+	if err != nil {
+		return err
+	}
+	if len(reverted) == 0 {
+		fmt.Fprintln(c.out, "No migrations to revert.")
+	}
+
+	return nil
+}
+
+func (c *config) status(ctx context.Context, args []string) error {
+	m, logger, err := c.migrator()
+	if err != nil {
+		return err
+	}
+	defer log.Sync(logger)
+	defer m.Close()
+
+	migrations, err := m.Status(ctx)
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(c.out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "MIGRATION\tAPPLIED")
+	for _, item := range migrations {
+		applied := "pending"
+		if item.Applied() {
+			applied = item.AppliedAt.UTC().Format(time.RFC3339)
+		}
+		if !item.Known() {
+			applied += " (unknown)"
+		}
+		fmt.Fprintf(w, "%s\t%s\n", item, applied)
+	}
+
+	return w.Flush()
+}
@@ -0,0 +1,143 @@
+package processingconfigcmd
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/artefactual-labs/ccp/internal/cmd/rootcmd"
+	"github.com/artefactual-labs/ccp/internal/workflow"
+)
+
+func New(rootConfig *rootcmd.Config, out io.Writer) *ffcli.Command {
+	fs := flag.NewFlagSet("ccp processing-config", flag.ExitOnError)
+	rootConfig.RegisterFlags(fs)
+
+	return &ffcli.Command{
+		Name:       "processing-config",
+		ShortUsage: "ccp processing-config <subcommand> [flags] [<arg>...]",
+		ShortHelp:  "Manage processing configurations.",
+		FlagSet:    fs,
+		Subcommands: []*ffcli.Command{
+			newValidateCommand(out),
+			newConvertCommand(out),
+		},
+		Exec: func(context.Context, []string) error {
+			return flag.ErrHelp
+		},
+	}
+}
+
+type validateConfig struct {
+	out      io.Writer
+	workflow string
+}
+
+func newValidateCommand(out io.Writer) *ffcli.Command {
+	cfg := validateConfig{out: out}
+
+	fs := flag.NewFlagSet("ccp processing-config validate", flag.ExitOnError)
+	fs.StringVar(&cfg.workflow, "workflow", "", "Workflow document")
+
+	return &ffcli.Command{
+		Name:       "validate",
+		ShortUsage: "ccp processing-config validate [flags] <path>...",
+		ShortHelp:  "Validate processing configuration files against the workflow.",
+		FlagSet:    fs,
+		Exec:       cfg.exec,
+	}
+}
+
+var errInvalid = errors.New("invalid processing configuration")
+
+func (c *validateConfig) exec(ctx context.Context, args []string) error {
+	if len(args) == 0 {
+		return flag.ErrHelp
+	}
+
+	var (
+		wf  *workflow.Document
+		err error
+	)
+	if c.workflow != "" {
+		wf, err = workflow.LoadFromFile(c.workflow)
+	} else {
+		wf, err = workflow.Default()
+	}
+	if err != nil {
+		return fmt.Errorf("error loading workflow: %v", err)
+	}
+
+	valid := true
+	for _, path := range args {
+		if !c.validate(wf, path) {
+			valid = false
+		}
+	}
+	if !valid {
+		return errInvalid
+	}
+
+	return nil
+}
+
+// validate reports the issues found in the processing configuration file.
+func (c *validateConfig) validate(wf *workflow.Document, path string) bool {
+	choices, err := workflow.ParseConfigFile(path)
+	if err != nil {
+		fmt.Fprintf(c.out, "%s: %v\n", path, err)
+		return false
+	}
+
+	err = workflow.ValidateConfig(wf, choices)
+	if err == nil {
+		fmt.Fprintf(c.out, "%s: OK\n", path)
+		return true
+	}
+
+	var errs interface{ Unwrap() []error }
+	if errors.As(err, &errs) {
+		for _, err := range errs.Unwrap() {
+			fmt.Fprintf(c.out, "%s: %v\n", path, err)
+		}
+	}
+
+	return false
+}
+
+type convertConfig struct {
+	out    io.Writer
+	format string
+}
+
+func newConvertCommand(out io.Writer) *ffcli.Command {
+	cfg := convertConfig{out: out}
+
+	fs := flag.NewFlagSet("ccp processing-config convert", flag.ExitOnError)
+	fs.StringVar(&cfg.format, "format", string(workflow.ConfigFormatJSON), "Output format (xml, json or yaml)")
+
+	return &ffcli.Command{
+		Name:       "convert",
+		ShortUsage: "ccp processing-config convert [flags] <path>",
+		ShortHelp:  "Convert a processing configuration file to a different format.",
+		FlagSet:    fs,
+		Exec:       cfg.exec,
+	}
+}
+
+func (c *convertConfig) exec(ctx context.Context, args []string) error {
+	if len(args) != 1 {
+		return flag.ErrHelp
+	}
+
+	choices, err := workflow.ParseConfigFile(args[0])
+	if err != nil {
+		return fmt.Errorf("error parsing %s: %v", args[0], err)
+	}
+
+	return workflow.WriteConfigFormat(c.out, choices, workflow.ConfigFormat(c.format))
+}
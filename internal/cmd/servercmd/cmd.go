@@ -11,6 +11,7 @@ import (
 	"path/filepath"
 	"runtime"
 	"syscall"
+	"time"
 
 	"github.com/peterbourgon/ff/v3"
 	"github.com/peterbourgon/ff/v3/ffcli"
@@ -19,6 +20,7 @@ import (
 
 	"github.com/artefactual-labs/ccp/internal/cmd/rootcmd"
 	"github.com/artefactual-labs/ccp/internal/version"
+	"github.com/artefactual-labs/ccp/internal/webui"
 )
 
 func New(rootConfig *rootcmd.Config, out io.Writer) *ffcli.Command {
@@ -31,12 +33,57 @@ func New(rootConfig *rootcmd.Config, out io.Writer) *ffcli.Command {
 	fs.String("config", "", "Configuration file in the TOML file format")
 	fs.StringVar(&cfg.sharedDir, "shared-dir", "", "Shared directory")
 	fs.StringVar(&cfg.workflow, "workflow", "", "Workflow document")
-	fs.StringVar(&cfg.db.driver, "db.driver", "", "Database driver")
+	fs.StringVar(&cfg.db.driver, "db.driver", "", "Database driver (mysql, postgres, sqlite, memory)")
 	fs.StringVar(&cfg.db.dsn, "db.dsn", "", "Database DSN")
+	fs.BoolVar(&cfg.db.migrate, "db.migrate", false, "Apply pending schema migrations before starting (see ccp migrate), SQLite databases are always migrated")
 	fs.StringVar(&cfg.api.admin.Addr, "api.admin.addr", ":8000", "Admin API listen address")
+	fs.StringVar(&cfg.api.admin.TLS.CertFile, "api.admin.tls.cert-file", "", "Admin API TLS certificate file")
+	fs.StringVar(&cfg.api.admin.TLS.KeyFile, "api.admin.tls.key-file", "", "Admin API TLS private key file")
+	fs.StringVar(&cfg.api.admin.TLS.ClientCAFile, "api.admin.tls.client-ca-file", "", "Admin API CA file used to verify client certificates (mTLS)")
+	fs.StringVar(&cfg.api.admin.OIDC.Issuer, "api.admin.oidc.issuer", "", "Admin API OIDC token issuer")
+	fs.StringVar(&cfg.api.admin.OIDC.Audience, "api.admin.oidc.audience", "", "Admin API OIDC token audience")
+	fs.StringVar(&cfg.api.admin.OIDC.JWKSFile, "api.admin.oidc.jwks-file", "", "Admin API OIDC JSON Web Key Set file")
+	fs.StringVar(&cfg.api.admin.OIDC.JWKSURL, "api.admin.oidc.jwks-url", "", "Admin API OIDC JSON Web Key Set URL")
+	fs.StringVar(&cfg.api.admin.OIDC.UsernameClaim, "api.admin.oidc.username-claim", "preferred_username", "Admin API OIDC claim with the username")
+	fs.StringVar(&cfg.api.admin.OIDC.GroupsClaim, "api.admin.oidc.groups-claim", "groups", "Admin API OIDC claim with the groups of the user")
+	fs.BoolVar(&cfg.api.admin.RBAC.Enabled, "api.admin.rbac.enabled", false, "Admin API role-based access control")
+	fs.Var((*stringList)(&cfg.api.admin.RBAC.Viewer), "api.admin.rbac.viewer", "Admin API group granted the viewer role (repeatable)")
+	fs.Var((*stringList)(&cfg.api.admin.RBAC.Operator), "api.admin.rbac.operator", "Admin API group granted the operator role (repeatable)")
+	fs.Var((*stringList)(&cfg.api.admin.RBAC.Approver), "api.admin.rbac.approver", "Admin API group granted the approver role (repeatable)")
+	fs.Var((*stringList)(&cfg.api.admin.RBAC.Admin), "api.admin.rbac.admin", "Admin API group granted the admin role (repeatable)")
 	fs.StringVar(&cfg.webui.Addr, "webui.addr", ":8001", "Web UI listen address")
+	fs.StringVar(&cfg.webui.CSP, "webui.csp", webui.DefaultCSP, "Web UI Content-Security-Policy header")
+	fs.DurationVar(&cfg.webui.SessionTTL, "webui.session-ttl", 12*time.Hour, "Web UI idle session expiration")
+	fs.StringVar(&cfg.webui.TLS.CertFile, "webui.tls.cert-file", "", "Web UI TLS certificate file")
+	fs.StringVar(&cfg.webui.TLS.KeyFile, "webui.tls.key-file", "", "Web UI TLS private key file")
+	fs.StringVar(&cfg.webui.TLS.ClientCAFile, "webui.tls.client-ca-file", "", "Web UI CA file used to verify client certificates (mTLS)")
+	fs.StringVar(&cfg.webui.AdminTLS.CAFile, "webui.admin-tls.ca-file", "", "CA file used by the Web UI to verify the Admin API certificate")
+	fs.StringVar(&cfg.webui.AdminTLS.CertFile, "webui.admin-tls.cert-file", "", "Client certificate file presented by the Web UI to the Admin API")
+	fs.StringVar(&cfg.webui.AdminTLS.KeyFile, "webui.admin-tls.key-file", "", "Client private key file presented by the Web UI to the Admin API")
+	fs.StringVar(&cfg.webui.AdminTLS.ServerName, "webui.admin-tls.server-name", "", "Server name used by the Web UI to verify the Admin API certificate")
 	fs.StringVar(&cfg.gearmin.addr, "gearmin.addr", ":4730", "Gearmin job server listen address")
+	fs.StringVar(&cfg.gearmin.tls.CertFile, "gearmin.tls.cert-file", "", "Gearmin job server TLS certificate file")
+	fs.StringVar(&cfg.gearmin.tls.KeyFile, "gearmin.tls.key-file", "", "Gearmin job server TLS private key file")
+	fs.StringVar(&cfg.gearmin.tls.ClientCAFile, "gearmin.tls.client-ca-file", "", "Gearmin job server CA file used to verify worker certificates (mTLS)")
+	fs.IntVar(&cfg.controller.MaxActivePackages, "controller.max-active-packages", 2, "Maximum number of packages processed concurrently")
+	fs.IntVar(&cfg.controller.MaxActiveTransfers, "controller.max-active-transfers", 0, "Maximum number of transfers processed concurrently (0 means no limit)")
+	fs.IntVar(&cfg.controller.MaxActiveSIPs, "controller.max-active-sips", 0, "Maximum number of SIPs processed concurrently (0 means no limit)")
+	fs.IntVar(&cfg.controller.MaxActiveDIPs, "controller.max-active-dips", 0, "Maximum number of DIPs processed concurrently (0 means no limit)")
+	fs.Float64Var(&cfg.controller.SafetyFactor, "controller.disk-safety-factor", 2, "Multiplier applied to the size of a package to estimate the space it needs in the shared directory")
+	fs.Uint64Var(&cfg.controller.MinFreeSpace, "controller.min-free-space", 1<<30, "Free space in bytes that must remain in the shared directory after a package is started")
+	fs.BoolVar(&cfg.controller.SkipSuccessfulTaskOutput, "controller.skip-successful-task-output", false, "Do not persist the output of tasks that succeed")
+	fs.DurationVar(&cfg.health.timeout, "health.timeout", 5*time.Second, "Maximum duration of the health probes")
+	fs.DurationVar(&cfg.health.maxTickAge, "health.max-tick-age", 30*time.Second, "Time without progress after which the controller loop is considered stuck")
+	fs.Int64Var(&cfg.health.minWorkers, "health.min-workers", 0, "Number of connected workers required to report ready")
+	fs.Uint64Var(&cfg.health.minFreeSpace, "health.min-free-space", 1<<30, "Free space in bytes required in the shared directory to report ready")
+	fs.BoolVar(&cfg.ha.enabled, "ha.enabled", false, "Elect a leader among the instances sharing the database, the others stay on standby")
+	fs.StringVar(&cfg.ha.id, "ha.id", "", "Identifier of the instance in the leader election (defaults to the hostname)")
+	fs.DurationVar(&cfg.ha.leaseTTL, "ha.lease-ttl", 15*time.Second, "Time after which a standby instance takes over if the leader stops renewing its lease")
 	fs.StringVar(&cfg.metrics.Addr, "metrics.addr", "", "Prometheus HTTP API listen address")
+	fs.Var(&cfg.storage.locations, "storage.locations", "Transfer source location as <uuid>:<path> (repeatable)")
+	fs.StringVar(&cfg.storage.url, "storage.url", "", "Storage Service URL used to resolve transfer source locations")
+	fs.StringVar(&cfg.storage.user, "storage.user", "", "Storage Service username")
+	fs.StringVar(&cfg.storage.key, "storage.key", "", "Storage Service API key")
 
 	rootConfig.RegisterFlags(fs)
 
@@ -76,6 +123,15 @@ func (c *Config) Exec(ctx context.Context, args []string) error {
 		c.sharedDir = filepath.Join(configDir, "ccp", "shared")
 	}
 
+	if c.ha.enabled && c.ha.id == "" {
+		hostname, err := os.Hostname()
+		if err != nil {
+			logger.Error(err, "Failed to determine the hostname.")
+			return err
+		}
+		c.ha.id = hostname
+	}
+
 	ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
 	defer stop()
 
@@ -86,7 +142,13 @@ func (c *Config) Exec(ctx context.Context, args []string) error {
 		return err
 	}
 
-	<-ctx.Done()
+	select {
+	case <-ctx.Done():
+	case err := <-s.Err():
+		logger.Error(err, "Server stopped.")
+		s.Close()
+		return err
+	}
 
 	if err := s.Close(); err != nil {
 		if !errors.Is(err, context.Canceled) {
@@ -2,10 +2,14 @@ package servercmd
 
 import (
 	"io"
+	"strings"
+	"time"
 
 	"github.com/artefactual-labs/ccp/internal/api/admin"
 	"github.com/artefactual-labs/ccp/internal/cmd/rootcmd"
 	"github.com/artefactual-labs/ccp/internal/cmd/servercmd/metrics"
+	"github.com/artefactual-labs/ccp/internal/controller"
+	"github.com/artefactual-labs/ccp/internal/tlsutil"
 	"github.com/artefactual-labs/ccp/internal/webui"
 )
 
@@ -17,13 +21,18 @@ type Config struct {
 	db         databaseConfig
 	api        apiConfig
 	gearmin    gearminConfig
+	storage    storageConfig
 	webui      webui.Config
 	metrics    metrics.Config
+	controller controller.Config
+	health     healthConfig
+	ha         haConfig
 }
 
 type databaseConfig struct {
-	driver string
-	dsn    string
+	driver  string
+	dsn     string
+	migrate bool
 }
 
 type apiConfig struct {
@@ -32,4 +41,58 @@ type apiConfig struct {
 
 type gearminConfig struct {
 	addr string
+	tls  tlsutil.Config
+}
+
+type healthConfig struct {
+	// timeout is the maximum duration of a probe.
+	timeout time.Duration
+
+	// maxTickAge is how long the controller loop can go without iterating
+	// before it is considered stuck.
+	maxTickAge time.Duration
+
+	// minWorkers is the number of workers that must be connected to the job
+	// server for the server to be ready.
+	minWorkers int64
+
+	// minFreeSpace is the space in bytes that must be available in the shared
+	// directory for the server to be ready.
+	minFreeSpace uint64
+}
+
+type haConfig struct {
+	// enabled turns on leader election, so only one of the instances sharing
+	// the database processes packages while the others stay on standby.
+	enabled bool
+
+	// id identifies the instance in the election, defaults to the hostname.
+	id string
+
+	// leaseTTL is how long the leader keeps the lease without renewing it,
+	// i.e. how long it takes for a standby instance to take over after the
+	// leader fails.
+	leaseTTL time.Duration
+}
+
+type storageConfig struct {
+	// locations is a list of locations using the "<uuid>:<path>" format.
+	locations stringList
+
+	// Storage Service API.
+	url  string
+	user string
+	key  string
+}
+
+// stringList is a flag.Value that accumulates the values of a repeated flag.
+type stringList []string
+
+func (l *stringList) String() string {
+	return strings.Join(*l, ",")
+}
+
+func (l *stringList) Set(value string) error {
+	*l = append(*l, value)
+	return nil
 }
@@ -0,0 +1,85 @@
+package servercmd
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sync"
+	"sync/atomic"
+)
+
+// gearminListener wraps the listener of the job server to keep track of the
+// connections open by workers, since gearmin does not report them.
+type gearminListener struct {
+	net.Listener
+
+	conns atomic.Int64
+
+	mu  sync.Mutex
+	err error
+}
+
+func newGearminListener(ln net.Listener) *gearminListener {
+	return &gearminListener{Listener: ln}
+}
+
+func (l *gearminListener) Accept() (net.Conn, error) {
+	conn, err := l.Listener.Accept()
+	if err != nil {
+		// gearmin stops accepting connections after the first error.
+		l.mu.Lock()
+		if l.err == nil {
+			l.err = err
+		}
+		l.mu.Unlock()
+		return nil, err
+	}
+
+	l.conns.Add(1)
+
+	return &gearminConn{Conn: conn, ln: l}, nil
+}
+
+// Workers returns the number of connected workers.
+func (l *gearminListener) Workers() int64 {
+	return l.conns.Load()
+}
+
+// Check implements health.CheckFunc. It fails once the listener has stopped
+// accepting connections.
+func (l *gearminListener) Check(context.Context) (string, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.err != nil {
+		return "", fmt.Errorf("not accepting connections: %v", l.err)
+	}
+
+	return l.Addr().String(), nil
+}
+
+// WorkersCheck returns a check that reports the number of connected workers,
+// failing when there are fewer than minWorkers.
+func (l *gearminListener) WorkersCheck(minWorkers int64) func(context.Context) (string, error) {
+	return func(context.Context) (string, error) {
+		n := l.Workers()
+		if n < minWorkers {
+			return "", fmt.Errorf("%d connected, want at least %d", n, minWorkers)
+		}
+		return fmt.Sprintf("%d connected", n), nil
+	}
+}
+
+type gearminConn struct {
+	net.Conn
+
+	ln        *gearminListener
+	closeOnce sync.Once
+}
+
+func (c *gearminConn) Close() error {
+	err := c.Conn.Close()
+	c.closeOnce.Do(func() { c.ln.conns.Add(-1) })
+
+	return err
+}
@@ -81,6 +81,22 @@ type Metrics struct {
 	// PackageQueueLengthGauge tracks the length of the package queue, segmented
 	// by package type (DIP, SIP, Transfer).
 	PackageQueueLengthGauge *prometheus.GaugeVec
+
+	// SharedDirectorySizeGauge tracks the size of the filesystem of the shared
+	// directory in bytes.
+	SharedDirectorySizeGauge prometheus.Gauge
+
+	// SharedDirectoryFreeGauge tracks the space available in the filesystem of
+	// the shared directory in bytes.
+	SharedDirectoryFreeGauge prometheus.Gauge
+
+	// SharedDirectoryUsageGauge tracks the fraction (0-1) of the filesystem of
+	// the shared directory in use, convenient for alerting.
+	SharedDirectoryUsageGauge prometheus.Gauge
+
+	// PackagesWaitingForSpaceGauge tracks the number of queued packages held
+	// back because there is not enough space in the shared directory.
+	PackagesWaitingForSpaceGauge prometheus.Gauge
 }
 
 func NewMetrics(wf *workflow.Document) *Metrics {
@@ -131,6 +147,22 @@ func NewMetrics(wf *workflow.Document) *Metrics {
 			Name: "mcpserver_package_queue_length",
 			Help: "Number of queued packages",
 		}, []string{"package_type"}),
+		SharedDirectorySizeGauge: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "mcpserver_shared_directory_size_bytes",
+			Help: "Size of the filesystem of the shared directory in bytes",
+		}),
+		SharedDirectoryFreeGauge: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "mcpserver_shared_directory_free_bytes",
+			Help: "Space available in the filesystem of the shared directory in bytes",
+		}),
+		SharedDirectoryUsageGauge: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "mcpserver_shared_directory_usage_ratio",
+			Help: "Fraction of the filesystem of the shared directory in use",
+		}),
+		PackagesWaitingForSpaceGauge: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "mcpserver_packages_waiting_for_space",
+			Help: "Number of queued packages held back by the lack of space in the shared directory",
+		}),
 	}
 
 	m.initLabels(wf)
@@ -147,6 +179,10 @@ func NewMetrics(wf *workflow.Document) *Metrics {
 		m.ActiveJobsGauge,
 		m.JobQueueLengthGauge,
 		m.PackageQueueLengthGauge,
+		m.SharedDirectorySizeGauge,
+		m.SharedDirectoryFreeGauge,
+		m.SharedDirectoryUsageGauge,
+		m.PackagesWaitingForSpaceGauge,
 		collectors.NewBuildInfoCollector(),
 	)
 
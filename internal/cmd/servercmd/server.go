@@ -5,16 +5,23 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"net/http"
 	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/artefactual-labs/gearmin"
 	"github.com/go-logr/logr"
-	"github.com/gohugoio/hugo/watcher"
 
 	"github.com/artefactual-labs/ccp/internal/api/admin"
 	"github.com/artefactual-labs/ccp/internal/controller"
+	"github.com/artefactual-labs/ccp/internal/election"
+	"github.com/artefactual-labs/ccp/internal/health"
+	"github.com/artefactual-labs/ccp/internal/storage"
 	"github.com/artefactual-labs/ccp/internal/store"
+	"github.com/artefactual-labs/ccp/internal/tlsutil"
 	"github.com/artefactual-labs/ccp/internal/webui"
 	"github.com/artefactual-labs/ccp/internal/workflow"
 )
@@ -34,8 +41,14 @@ type Server struct {
 	// Embedded job server compatible with Gearman.
 	gearman *gearmin.Server
 
+	// Listener of the job server, tracks the connected workers.
+	gearmanLn *gearminListener
+
 	// Filesystem watcher.
-	watcher *watcher.Batcher
+	watcher *fsWatcher
+
+	// Health checks.
+	health *health.Checker
 
 	// Workflow processor.
 	controller *controller.Controller
@@ -45,12 +58,29 @@ type Server struct {
 
 	// Web UI.
 	webui *webui.Server
+
+	// Leader election, nil unless enabled.
+	elector      *election.Elector
+	stopElection context.CancelFunc
+	electionDone chan struct{}
+
+	// active is set once the components that only run on the leader are
+	// started: the job server, the controller and the filesystem watchers.
+	active atomic.Bool
+
+	// mu serializes the activation and Close.
+	mu sync.Mutex
+
+	// errc receives the error that stops the server after it has started,
+	// e.g. the loss of the leadership.
+	errc chan error
 }
 
 func NewServer(logger logr.Logger, config *Config) *Server {
 	s := &Server{
 		logger: logger,
 		config: config,
+		errc:   make(chan error, 1),
 	}
 
 	s.ctx, s.cancel = context.WithCancel(context.Background())
@@ -79,23 +109,19 @@ func (s *Server) Run() error {
 		return fmt.Errorf("error creating metrics server: %v", err)
 	}
 
+	if s.config.db.migrate {
+		s.logger.V(1).Info("Applying database migrations.")
+		if err := migrate(s.ctx, s.logger.WithName("migrate"), s.config.db.driver, s.config.db.dsn); err != nil {
+			return fmt.Errorf("error applying database migrations: %v", err)
+		}
+	}
+
 	s.logger.V(1).Info("Creating database store.")
 	s.store, err = store.New(s.logger.WithName("store"), s.config.db.driver, s.config.db.dsn)
 	if err != nil {
 		return fmt.Errorf("error creating database store: %v", err)
 	}
 
-	s.logger.V(1).Info("Cleaning up database.")
-	{
-		ctx, cancel := context.WithTimeout(s.ctx, time.Second*10)
-		defer cancel()
-
-		err = s.store.RemoveTransientData(ctx)
-	}
-	if err != nil {
-		return fmt.Errorf("error cleaning up database: %v", err)
-	}
-
 	s.logger.V(1).Info("Creating shared directories.", "path", s.config.sharedDir)
 	if err := createSharedDirs(s.config.sharedDir); err != nil {
 		return fmt.Errorf("error creating shared directories: %v", err)
@@ -111,28 +137,42 @@ func (s *Server) Run() error {
 		return fmt.Errorf("error creating built-in processing configurations: %v", err)
 	}
 
-	s.logger.V(1).Info("Creating Gearman job server.")
-	ln, err := net.Listen("tcp", s.config.gearmin.addr)
+	s.logger.V(1).Info("Creating location resolver.")
+	locations, err := newLocationResolver(s.config.storage)
 	if err != nil {
-		return fmt.Errorf("error creating gearmin listener: %v", err)
-	} else {
-		s.gearman = gearmin.NewServer(ln)
+		return fmt.Errorf("error creating location resolver: %v", err)
+	} else if locations == nil {
+		s.logger.Info("No storage locations configured, transfer source paths will be used as is.")
 	}
 
 	s.logger.V(1).Info("Creating controller.")
-	s.controller = controller.New(s.logger.WithName("controller"), s.metrics.metrics, s.store, s.gearman, wf, s.config.sharedDir, watchedDir)
-	if err := s.controller.Run(); err != nil {
-		return fmt.Errorf("error creating controller: %v", err)
-	}
+	s.controller = controller.New(s.logger.WithName("controller"), s.config.controller, s.metrics.metrics, s.store, wf, locations, s.config.sharedDir, watchedDir)
 
-	s.logger.V(1).Info("Creating filesystem watchers.", "path", watchedDir)
-	if s.watcher, err = watch(s.logger.WithName("watcher"), s.controller, wf, watchedDir); err != nil {
-		return fmt.Errorf("error creating filesystem watchers: %v", err)
+	var leadership admin.Leadership
+	if s.config.ha.enabled {
+		s.elector = election.New(s.logger.WithName("election"), s.store, "ccp", s.config.ha.id, s.config.ha.leaseTTL)
+		leadership = s.elector
 	}
 
+	s.logger.V(1).Info("Creating health checks.")
+	s.health = health.NewChecker(s.config.health.timeout)
+	s.health.Add("role", health.Readiness, s.roleCheck)
+	s.health.Add("controller", health.Liveness, s.whenActive(health.HeartbeatCheck(s.controller.LastTick, s.config.health.maxTickAge)))
+	s.health.Add("watcher", health.Liveness, s.whenActive(func(ctx context.Context) (string, error) {
+		return s.watcher.Check(ctx)
+	}))
+	s.health.Add("gearmin", health.Liveness, s.whenActive(func(ctx context.Context) (string, error) {
+		return s.gearmanLn.Check(ctx)
+	}))
+	s.health.Add("workers", health.Readiness, s.whenActive(func(ctx context.Context) (string, error) {
+		return s.gearmanLn.WorkersCheck(s.config.health.minWorkers)(ctx)
+	}))
+	s.health.Add("database", health.Readiness, health.PingCheck(s.store))
+	s.health.Add("shared-dir", health.Readiness, health.DirCheck(s.config.sharedDir, s.config.health.minFreeSpace))
+
 	s.logger.V(1).Info("Creating admin API.")
 	processingConfigForm := workflow.NewProcessingConfigForm(wf)
-	if s.admin, err = admin.New(s.logger.WithName("api.admin"), s.config.api.admin, s.controller, s.store, wf, processingConfigForm); err != nil {
+	if s.admin, err = admin.New(s.logger.WithName("api.admin"), s.config.api.admin, s.controller, s.store, wf, processingConfigForm, s.health, leadership); err != nil {
 		return fmt.Errorf("error creating admin API: %v", err)
 	}
 	if err := s.admin.Run(); err != nil {
@@ -140,16 +180,138 @@ func (s *Server) Run() error {
 	}
 
 	s.logger.V(1).Info("Creating web UI.")
-	s.webui = webui.New(s.logger.WithName("webui"), s.config.webui, s.admin.Addr())
+	webuiConfig := s.config.webui
+	webuiConfig.AdminTLS.Enabled = s.config.api.admin.TLS.Enabled()
+	s.webui = webui.New(s.logger.WithName("webui"), webuiConfig, s.admin.Addr(), s.store, s.health)
 	if err := s.webui.Run(); err != nil {
 		return fmt.Errorf("error creating web UI: %v", err)
 	}
 
-	s.logger.V(1).Info("Ready.")
+	if s.elector == nil {
+		if err := s.activate(wf, watchedDir); err != nil {
+			return err
+		}
+		s.logger.V(1).Info("Ready.")
+		return nil
+	}
+
+	s.campaign(wf, watchedDir)
+	s.logger.Info("Ready, waiting to be elected as leader.", "id", s.config.ha.id)
+
+	return nil
+}
+
+// campaign runs the leader election in the background. The server activates
+// when it is elected, and fails if it loses the leadership later: its
+// components cannot be stopped safely while processing packages, so the
+// process must exit and rejoin as a standby instance.
+func (s *Server) campaign(wf *workflow.Document, watchedDir string) {
+	var ctx context.Context
+	ctx, s.stopElection = context.WithCancel(context.Background())
+	s.electionDone = make(chan struct{})
+
+	go func() {
+		defer close(s.electionDone)
+		if err := s.elector.Run(ctx); errors.Is(err, election.ErrLost) {
+			s.fail(err)
+		}
+	}()
+
+	go func() {
+		select {
+		case <-s.elector.Elected():
+			if err := s.activate(wf, watchedDir); err != nil {
+				s.fail(err)
+			}
+		case <-s.ctx.Done():
+		}
+	}()
+}
+
+// activate starts the components that only run on the leader.
+func (s *Server) activate(wf *workflow.Document, watchedDir string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.ctx.Err() != nil {
+		return nil // Closing.
+	}
+
+	var err error
+
+	s.logger.V(1).Info("Cleaning up database.")
+	{
+		ctx, cancel := context.WithTimeout(s.ctx, time.Second*10)
+		defer cancel()
+
+		err = s.store.RemoveTransientData(ctx)
+	}
+	if err != nil {
+		return fmt.Errorf("error cleaning up database: %v", err)
+	}
+
+	s.logger.V(1).Info("Creating Gearman job server.")
+	ln, err := net.Listen("tcp", s.config.gearmin.addr)
+	if err != nil {
+		return fmt.Errorf("error creating gearmin listener: %v", err)
+	}
+	if s.config.gearmin.tls.Enabled() {
+		if ln, err = tlsutil.NewListener(s.logger.WithName("gearmin.tls"), ln, s.config.gearmin.tls); err != nil {
+			return fmt.Errorf("error creating gearmin TLS listener: %v", err)
+		}
+	}
+	s.gearmanLn = newGearminListener(ln)
+	s.gearman = gearmin.NewServer(s.gearmanLn)
+
+	s.logger.V(1).Info("Starting controller.")
+	if err := s.controller.Run(s.gearman); err != nil {
+		return fmt.Errorf("error starting controller: %v", err)
+	}
+
+	s.logger.V(1).Info("Creating filesystem watchers.", "path", watchedDir)
+	if s.watcher, err = watch(s.logger.WithName("watcher"), s.controller, wf, watchedDir); err != nil {
+		return fmt.Errorf("error creating filesystem watchers: %v", err)
+	}
+
+	s.active.Store(true)
+	s.logger.Info("Processing packages.")
 
 	return nil
 }
 
+// whenActive returns a check of the components that only run on the leader,
+// it passes on standby instances.
+func (s *Server) whenActive(check health.CheckFunc) health.CheckFunc {
+	return func(ctx context.Context) (string, error) {
+		if !s.active.Load() {
+			return "standby", nil
+		}
+		return check(ctx)
+	}
+}
+
+// roleCheck reports whether the instance is active or on standby.
+func (s *Server) roleCheck(context.Context) (string, error) {
+	if s.active.Load() {
+		return "active", nil
+	}
+	return "standby", nil
+}
+
+// fail reports an error that stops the server, see Err.
+func (s *Server) fail(err error) {
+	select {
+	case s.errc <- err:
+	default:
+	}
+}
+
+// Err returns a channel that receives the error that stops the server after
+// it has started.
+func (s *Server) Err() <-chan error {
+	return s.errc
+}
+
 func (s *Server) Close() error {
 	var errs error
 
@@ -160,9 +322,8 @@ func (s *Server) Close() error {
 
 	s.cancel() // Cancel the root context.
 
-	if s.store != nil && s.store.Running() {
-		errs = errors.Join(errs, s.store.Close())
-	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
 
 	if s.controller != nil {
 		errs = errors.Join(errs, s.controller.Close())
@@ -172,6 +333,20 @@ func (s *Server) Close() error {
 		s.watcher.Close()
 	}
 
+	if s.gearman != nil {
+		s.gearman.Stop()
+	}
+
+	// The lease is released once the processing has stopped.
+	if s.stopElection != nil {
+		s.stopElection()
+		<-s.electionDone
+	}
+
+	if s.store != nil && s.store.Running() {
+		errs = errors.Join(errs, s.store.Close())
+	}
+
 	if s.admin != nil {
 		errs = errors.Join(errs, s.admin.Close(ctx))
 	}
@@ -184,7 +359,41 @@ func (s *Server) Close() error {
 		errs = errors.Join(errs, s.metrics.Close(ctx))
 	}
 
-	s.gearman.Stop()
-
 	return errs
 }
+
+// migrate applies the pending migrations of the database schema. The memory
+// store has no schema.
+func migrate(ctx context.Context, logger logr.Logger, driver, dsn string) error {
+	if strings.EqualFold(driver, "memory") {
+		return nil
+	}
+
+	m, err := store.NewMigrator(logger, driver, dsn)
+	if err != nil {
+		return err
+	}
+	defer m.Close()
+
+	applied, err := m.Up(ctx)
+	for _, item := range applied {
+		logger.Info("Applied migration.", "migration", item.String())
+	}
+
+	return err
+}
+
+// newLocationResolver returns the location resolver configured, or nil when
+// none is configured.
+func newLocationResolver(config storageConfig) (storage.LocationResolver, error) {
+	switch {
+	case config.url != "" && len(config.locations) > 0:
+		return nil, errors.New("storage.url and storage.locations are mutually exclusive")
+	case config.url != "":
+		return storage.NewClient(config.url, config.user, config.key, &http.Client{Timeout: time.Second * 30})
+	case len(config.locations) > 0:
+		return storage.ParseLocations(config.locations)
+	default:
+		return nil, nil
+	}
+}
@@ -1,9 +1,12 @@
 package servercmd
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"os"
 	"path/filepath"
+	"sync"
 	"time"
 
 	"github.com/fsnotify/fsnotify"
@@ -17,11 +20,37 @@ type observer interface {
 	Notify(path string) error
 }
 
-func watch(logger logr.Logger, o observer, wf *workflow.Document, path string) (*watcher.Batcher, error) {
-	w, err := watcher.New(500*time.Millisecond, 700*time.Millisecond, false)
+// fsWatcher is a filesystem watcher that remembers why it stopped.
+type fsWatcher struct {
+	*watcher.Batcher
+
+	mu  sync.Mutex
+	err error
+}
+
+func (w *fsWatcher) stop(err error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.err == nil {
+		w.err = err
+	}
+}
+
+// Check implements health.CheckFunc. It fails once the watcher has stopped.
+func (w *fsWatcher) Check(context.Context) (string, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	return "", w.err
+}
+
+func watch(logger logr.Logger, o observer, wf *workflow.Document, path string) (*fsWatcher, error) {
+	b, err := watcher.New(500*time.Millisecond, 700*time.Millisecond, false)
 	if err != nil {
 		return nil, err
 	}
+	w := &fsWatcher{Batcher: b}
 
 	var errs error
 	for _, wd := range wf.WatchedDirectories {
@@ -53,6 +82,9 @@ func watch(logger logr.Logger, o observer, wf *workflow.Document, path string) (
 			case err := <-w.Errors():
 				if err != nil {
 					logger.V(1).Info("Error while watching.", "err", err)
+					w.stop(fmt.Errorf("stopped: %v", err))
+				} else {
+					w.stop(errors.New("stopped"))
 				}
 				return
 			}
@@ -0,0 +1,140 @@
+package controller
+
+import (
+	"fmt"
+	"io/fs"
+	"math"
+	"path/filepath"
+	"time"
+
+	"github.com/artefactual-labs/ccp/internal/diskusage"
+)
+
+// admit decides whether there is enough space in the shared directory to start
+// processing the package given the current usage. When the package must wait,
+// it returns a message explaining why.
+func (c *Controller) admit(pkg *Package, usage diskusage.Usage, err error) (bool, string) {
+	if err != nil {
+		// Don't block processing because we can't tell the free space.
+		return true, ""
+	}
+
+	available := usage.Free - min(usage.Free, c.reserved)
+	required := requiredSpace(pkg.size, c.config.SafetyFactor, c.config.MinFreeSpace)
+	if available >= required {
+		return true, ""
+	}
+
+	var reserved string
+	if c.reserved > 0 {
+		reserved = fmt.Sprintf(" (%s reserved by active packages)", diskusage.Format(c.reserved))
+	}
+
+	return false, fmt.Sprintf(
+		"Waiting for space in the shared directory: %s available%s, %s required to start %q (%s package × %.1f + %s reserve).",
+		diskusage.Format(available),
+		reserved,
+		diskusage.Format(required),
+		pkg.Name(),
+		diskusage.Format(pkg.size),
+		c.config.SafetyFactor,
+		diskusage.Format(c.config.MinFreeSpace),
+	)
+}
+
+// requiredSpace returns the space needed to start a package of the given size,
+// saturating instead of overflowing.
+func requiredSpace(size uint64, factor float64, reserve uint64) uint64 {
+	if factor < 1 {
+		factor = 1
+	}
+
+	estimate := float64(size) * factor
+	if estimate >= float64(math.MaxUint64-reserve) {
+		return math.MaxUint64
+	}
+
+	return uint64(estimate) + reserve
+}
+
+// reserve reserves the space estimated for processing the package, so the
+// packages admitted before the usage reflects it are not counted on to fit in
+// the same space. The reservation shrinks as the package grows, see
+// shrinkReservation. It is called with c.mu held.
+func (c *Controller) reserve(pkg *Package) {
+	pkg.reserved = requiredSpace(pkg.size, c.config.SafetyFactor, 0)
+	c.reserved += min(pkg.reserved, math.MaxUint64-c.reserved)
+}
+
+// reservationInterval is the minimum time between two measurements of an
+// active package, see shrinkReservation.
+const reservationInterval = 10 * time.Second
+
+// shrinkReservation reduces the space reserved for the package by the space it
+// has taken since it was started, which the usage of the shared directory
+// already reflects. The package is measured without holding c.mu.
+func (c *Controller) shrinkReservation(pkg *Package) {
+	size, err := packageSize(pkg.Path())
+	if err != nil {
+		// The package may have been moved while it was measured.
+		c.logger.V(2).Info("Failed to compute package size.", "package", pkg, "err", err)
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	grown := size - min(size, pkg.size)
+	estimate := requiredSpace(pkg.size, c.config.SafetyFactor, 0)
+	reserved := estimate - min(estimate, grown)
+	if reserved < pkg.reserved {
+		c.reserved -= min(pkg.reserved-reserved, c.reserved)
+		pkg.reserved = reserved
+	}
+}
+
+// release releases the space reserved for the package. It is called with c.mu
+// held.
+func (c *Controller) release(pkg *Package) {
+	c.reserved -= min(pkg.reserved, c.reserved)
+	pkg.reserved = 0
+}
+
+// readUsage returns the usage of the shared directory and updates the related
+// metrics.
+func (c *Controller) readUsage() (diskusage.Usage, error) {
+	usage, err := c.diskUsage(c.sharedDir)
+	if err != nil {
+		c.logger.Error(err, "Failed to read shared directory usage.")
+		return usage, err
+	}
+
+	c.metrics.SharedDirectorySizeGauge.Set(float64(usage.Total))
+	c.metrics.SharedDirectoryFreeGauge.Set(float64(usage.Free))
+	if usage.Total > 0 {
+		c.metrics.SharedDirectoryUsageGauge.Set(float64(usage.Used()) / float64(usage.Total))
+	}
+
+	return usage, nil
+}
+
+// packageSize returns the size of the file or the directory tree at path.
+func packageSize(path string) (uint64, error) {
+	var size uint64
+	err := filepath.WalkDir(path, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		fi, err := d.Info()
+		if err != nil {
+			return err
+		}
+		size += uint64(fi.Size()) // nolint: gosec
+		return nil
+	})
+
+	return size, err
+}
@@ -0,0 +1,188 @@
+package controller
+
+import (
+	"errors"
+	"math"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"gotest.tools/v3/assert"
+	"gotest.tools/v3/fs"
+
+	"github.com/artefactual-labs/ccp/internal/cmd/servercmd/metrics"
+	"github.com/artefactual-labs/ccp/internal/diskusage"
+)
+
+func TestRequiredSpace(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name    string
+		size    uint64
+		factor  float64
+		reserve uint64
+		want    uint64
+	}{
+		{name: "Applies the factor and the reserve", size: 100, factor: 2.5, reserve: 10, want: 260},
+		{name: "Never estimates below the package size", size: 100, factor: 0, reserve: 0, want: 100},
+		{name: "Saturates instead of overflowing", size: math.MaxUint64 / 2, factor: 3, reserve: 1, want: math.MaxUint64},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, requiredSpace(tc.size, tc.factor, tc.reserve), tc.want)
+		})
+	}
+}
+
+func TestPackageSize(t *testing.T) {
+	t.Parallel()
+
+	dir := fs.NewDir(t, "",
+		fs.WithFile("a.txt", "12345"),
+		fs.WithDir("sub", fs.WithFile("b.txt", "123")),
+	)
+
+	size, err := packageSize(dir.Path())
+	assert.NilError(t, err)
+	assert.Equal(t, size, uint64(8))
+
+	size, err = packageSize(dir.Join("a.txt"))
+	assert.NilError(t, err)
+	assert.Equal(t, size, uint64(5))
+}
+
+func TestAdmission(t *testing.T) {
+	t.Parallel()
+
+	newController := func(t *testing.T, free uint64, err error) *Controller {
+		t.Helper()
+
+		c := New(logr.Discard(), Config{SafetyFactor: 2, MinFreeSpace: 100}, metrics.NewMetrics(nil), nil, nil, nil, t.TempDir(), t.TempDir())
+		c.diskUsage = func(string) (diskusage.Usage, error) {
+			return diskusage.Usage{Total: 1000, Free: free}, err
+		}
+		t.Cleanup(func() { c.Close() })
+
+		return c
+	}
+
+	newQueuedPackage := func(c *Controller, size uint64) *Package {
+		pkg := newPackage(logr.Discard(), nil, c.sharedDir)
+		pkg.id = uuid.New()
+		pkg.path = "/var/archivematica/sharedDirectory/currentlyProcessing/Images-" + pkg.id.String()
+		pkg.unit = &Transfer{pkg: pkg}
+		pkg.size = size
+		c.queued.push(pkg)
+
+		return pkg
+	}
+
+	t.Run("Holds packages back while there is not enough space", func(t *testing.T) {
+		t.Parallel()
+
+		c := newController(t, 299, nil)
+		first := newQueuedPackage(c, 100)
+		second := newQueuedPackage(c, 1)
+
+		c.pick()
+
+		assert.Equal(t, len(c.activePackages), 0)
+		assert.Equal(t, c.queued.len(), 2)
+		assert.Equal(t, testutil.ToFloat64(c.metrics.PackagesWaitingForSpaceGauge), float64(2))
+		assert.Equal(t, testutil.ToFloat64(c.metrics.SharedDirectoryFreeGauge), float64(299))
+		assert.Equal(t, testutil.ToFloat64(c.metrics.SharedDirectoryUsageGauge), 0.701)
+
+		want := `Waiting for space in the shared directory: 299 B available, 300 B required to start "Images" (100 B package × 2.0 + 100 B reserve).`
+		msg, ok := c.QueueMessage(first.id)
+		assert.Assert(t, ok)
+		assert.Equal(t, msg, want)
+		msg, ok = c.QueueMessage(second.id)
+		assert.Assert(t, ok)
+		assert.Equal(t, msg, `Waiting for "Images" to start first.`)
+
+		_, ok = c.QueueMessage(uuid.New())
+		assert.Assert(t, !ok)
+	})
+
+	t.Run("Admits packages that fit", func(t *testing.T) {
+		t.Parallel()
+
+		c := newController(t, 300, nil)
+		pkg := newQueuedPackage(c, 100)
+
+		ok, msg := c.admit(pkg, diskusage.Usage{Total: 1000, Free: 300}, nil)
+		assert.Assert(t, ok)
+		assert.Equal(t, msg, "")
+	})
+
+	t.Run("Accounts for the space reserved by active packages", func(t *testing.T) {
+		t.Parallel()
+
+		c := newController(t, 450, nil)
+		active := newQueuedPackage(c, 100)
+		pkg := newQueuedPackage(c, 100)
+		usage := diskusage.Usage{Total: 1000, Free: 450}
+
+		ok, _ := c.admit(active, usage, nil)
+		assert.Assert(t, ok)
+		c.reserve(active)
+		assert.Equal(t, c.reserved, uint64(200))
+
+		ok, msg := c.admit(pkg, usage, nil)
+		assert.Assert(t, !ok)
+		assert.Equal(t, msg, `Waiting for space in the shared directory: 250 B available (200 B reserved by active packages), 300 B required to start "Images" (100 B package × 2.0 + 100 B reserve).`)
+
+		c.release(active)
+		assert.Equal(t, c.reserved, uint64(0))
+
+		ok, _ = c.admit(pkg, usage, nil)
+		assert.Assert(t, ok)
+	})
+
+	t.Run("Shrinks the reservation as the package grows", func(t *testing.T) {
+		t.Parallel()
+
+		c := newController(t, 1000, nil)
+		dir := fs.NewDir(t, "", fs.WithFile("a.bin", strings.Repeat("a", 100)))
+		pkg := newQueuedPackage(c, 100)
+		pkg.path = dir.Path()
+
+		c.reserve(pkg)
+		assert.Equal(t, c.reserved, uint64(200))
+
+		// The size of the package doesn't change the reservation.
+		c.shrinkReservation(pkg)
+		assert.Equal(t, c.reserved, uint64(200))
+
+		// The usage reflects the files written since, e.g. normalization.
+		assert.NilError(t, os.WriteFile(dir.Join("b.bin"), []byte(strings.Repeat("b", 150)), 0o600))
+		c.shrinkReservation(pkg)
+		assert.Equal(t, pkg.reserved, uint64(50))
+		assert.Equal(t, c.reserved, uint64(50))
+
+		// Removing files doesn't grow the reservation back.
+		assert.NilError(t, os.Remove(dir.Join("b.bin")))
+		c.shrinkReservation(pkg)
+		assert.Equal(t, c.reserved, uint64(50))
+
+		assert.NilError(t, os.WriteFile(dir.Join("c.bin"), []byte(strings.Repeat("c", 300)), 0o600))
+		c.shrinkReservation(pkg)
+		assert.Equal(t, pkg.reserved, uint64(0))
+		assert.Equal(t, c.reserved, uint64(0))
+	})
+
+	t.Run("Admits packages when the usage is unknown", func(t *testing.T) {
+		t.Parallel()
+
+		c := newController(t, 0, nil)
+		pkg := newQueuedPackage(c, 100)
+
+		ok, _ := c.admit(pkg, diskusage.Usage{}, errors.New("statfs failed"))
+		assert.Assert(t, ok)
+	})
+}
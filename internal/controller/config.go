@@ -0,0 +1,51 @@
+package controller
+
+import "github.com/artefactual-labs/ccp/internal/store/enums"
+
+type Config struct {
+	// MaxActivePackages is the maximum number of packages processed
+	// concurrently, defaults to 2.
+	MaxActivePackages int
+
+	// MaxActiveTransfers, MaxActiveSIPs and MaxActiveDIPs limit the number of
+	// packages of each type processed concurrently, within MaxActivePackages.
+	// Zero means no limit other than MaxActivePackages.
+	MaxActiveTransfers int
+	MaxActiveSIPs      int
+	MaxActiveDIPs      int
+
+	// SafetyFactor multiplies the size of a package to estimate the space it
+	// needs in the shared directory during processing, e.g. normalization
+	// creates preservation and access copies of the original files. The
+	// estimate is reserved while the package is processed, less the space it
+	// has taken since it was started.
+	SafetyFactor float64
+
+	// MinFreeSpace is the space in bytes that must remain available in the
+	// shared directory after a package is started. Packages stay queued while
+	// the estimated space they need would bring the shared directory below
+	// this threshold.
+	MinFreeSpace uint64
+
+	// SkipSuccessfulTaskOutput omits the standard output and error of the
+	// tasks that succeed when their results are persisted, which reduces the
+	// size of the writes. The output of failed tasks is always persisted.
+	// CCP is the only writer of the results, the worker only sends the output
+	// when it captures it, see ARCHIVEMATICA_WORKER_CAPTURE_CLIENT_SCRIPT_OUTPUT.
+	SkipSuccessfulTaskOutput bool
+}
+
+// maxActive returns the limit of active packages of the given type, or zero
+// when the type is not limited.
+func (c Config) maxActive(pt enums.PackageType) int {
+	switch pt {
+	case enums.PackageTypeTransfer:
+		return c.MaxActiveTransfers
+	case enums.PackageTypeSIP:
+		return c.MaxActiveSIPs
+	case enums.PackageTypeDIP:
+		return c.MaxActiveDIPs
+	default:
+		return 0
+	}
+}
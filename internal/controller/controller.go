@@ -8,6 +8,7 @@ import (
 	"path/filepath"
 	"strconv"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"connectrpc.com/authn"
@@ -19,11 +20,15 @@ import (
 	adminv1 "github.com/artefactual-labs/ccp/internal/api/gen/archivematica/ccp/admin/v1beta1"
 	"github.com/artefactual-labs/ccp/internal/cmd/servercmd/metrics"
 	"github.com/artefactual-labs/ccp/internal/derrors"
+	"github.com/artefactual-labs/ccp/internal/diskusage"
+	"github.com/artefactual-labs/ccp/internal/storage"
 	"github.com/artefactual-labs/ccp/internal/store"
 	"github.com/artefactual-labs/ccp/internal/workflow"
 )
 
-const maxConcurrentPackages = 2
+// defaultMaxActivePackages is the number of packages processed concurrently
+// when not configured.
+const defaultMaxActivePackages = 2
 
 // Controller manages concurrent processing of packages.
 //
@@ -31,18 +36,24 @@ const maxConcurrentPackages = 2
 type Controller struct {
 	logger logr.Logger
 
+	// Controller configuration.
+	config Config
+
 	// Application metrics.
 	metrics *metrics.Metrics
 
 	// Application store.
 	store store.Store
 
-	// Embedded job server compatible with Gearman.
+	// Embedded job server compatible with Gearman, set by Run.
 	gearman *gearmin.Server
 
 	// wf is the workflow document.
 	wf *workflow.Document
 
+	// locations resolves the locations of the transfer sources.
+	locations storage.LocationResolver
+
 	// Archivematica shared directory.
 	sharedDir string
 
@@ -52,16 +63,41 @@ type Controller struct {
 	// activePackages is the list of active packages.
 	activePackages []*Package
 
-	// queuedPackages is the list of queued packages, FIFO style.
-	queuedPackages []*Package
+	// queued is the list of queued packages, ordered by the scheduler.
+	queued *scheduler
 
 	// awaitingPackages is the list packages awaiting a decision indexed by the
 	// package identifier.
 	awaitingPackages map[uuid.UUID][]*decision
 
+	// queueMessage explains why the queued packages are not being processed,
+	// empty when they are not held back.
+	queueMessage string
+
+	// queueMessagePkg is the package queueMessage is about, the others wait
+	// behind it. It is nil when the message applies to every queued package.
+	queueMessagePkg *Package
+
+	// diskUsage reads the usage of the filesystem of the shared directory.
+	diskUsage func(path string) (diskusage.Usage, error)
+
+	// reserved is the space of the shared directory reserved for the packages
+	// being processed, it is not reflected by the usage until they grow.
+	reserved uint64
+
+	// copyingPackages tracks the progress of the packages being copied indexed
+	// by the package identifier.
+	copyingPackages map[uuid.UUID]*copyProgress
+
 	// sync.RWMutex protects the internal Package slices.
 	mu sync.RWMutex
 
+	// queueMu serializes the writes to the persisted queue so the entry of a
+	// package is never created after it has started and its entry has been
+	// deleted. It is acquired before mu, and the store is written without
+	// holding mu.
+	queueMu sync.Mutex
+
 	// group is a collection of goroutines used for processing packages.
 	group *errgroup.Group
 
@@ -71,33 +107,68 @@ type Controller struct {
 	// groupCancel tells active goroutines in the errgroup to abandon.
 	groupCancel context.CancelFunc
 
+	// copyGroup is a collection of goroutines used for copying transfers.
+	copyGroup *errgroup.Group
+
+	// copySem limits the number of transfers copied concurrently.
+	copySem chan struct{}
+
+	// submitMu serializes the submission of requests with idempotency keys.
+	submitMu sync.Mutex
+
 	// closeOnce guarantees that the closing procedure runs only once.
 	closeOnce sync.Once
+
+	// lastTick is the time (Unix nanoseconds) of the last iteration of the
+	// loop started by Run, used to tell whether the loop is alive.
+	lastTick atomic.Int64
 }
 
-func New(logger logr.Logger, metrics *metrics.Metrics, store store.Store, gearman *gearmin.Server, wf *workflow.Document, sharedDir, watchedDir string) *Controller {
+func New(logger logr.Logger, config Config, metrics *metrics.Metrics, store store.Store, wf *workflow.Document, locations storage.LocationResolver, sharedDir, watchedDir string) *Controller {
 	c := &Controller{
 		logger:           logger,
+		config:           config,
 		metrics:          metrics,
 		store:            store,
-		gearman:          gearman,
 		wf:               wf,
+		locations:        locations,
 		sharedDir:        sharedDir,
 		watchedDir:       watchedDir,
 		activePackages:   []*Package{},
-		queuedPackages:   []*Package{},
+		queued:           newScheduler(),
 		awaitingPackages: map[uuid.UUID][]*decision{},
+		copyingPackages:  map[uuid.UUID]*copyProgress{},
+		diskUsage:        diskusage.Get,
+		copyGroup:        &errgroup.Group{},
+		copySem:          make(chan struct{}, maxConcurrentCopies),
+	}
+
+	if c.config.MaxActivePackages <= 0 {
+		c.config.MaxActivePackages = defaultMaxActivePackages
 	}
 
+	// The group has no limit, pick bounds the processing goroutines with
+	// MaxActivePackages and the limits per package type. Packages awaiting
+	// decisions are not counted as active but keep their goroutines, so a
+	// limit could make pick block in Go while holding c.mu, which they need
+	// to resume.
 	c.groupCtx, c.groupCancel = context.WithCancel(context.Background())
 	c.group, _ = errgroup.WithContext(c.groupCtx)
-	c.group.SetLimit(10)
 
 	return c
 }
 
-// Run tries to start processing queued transfers.
-func (c *Controller) Run() error {
+// Run restores the persisted queue and tries to start processing queued
+// transfers, dispatching their tasks to the job server.
+func (c *Controller) Run(gearman *gearmin.Server) error {
+	c.gearman = gearman
+
+	if err := c.restoreQueue(c.groupCtx); err != nil {
+		return fmt.Errorf("restore queue: %v", err)
+	}
+
+	c.lastTick.Store(time.Now().UnixNano())
+
 	go func() {
 		ticker := time.NewTicker(time.Second / 4)
 		defer ticker.Stop()
@@ -106,6 +177,7 @@ func (c *Controller) Run() error {
 			select {
 			case <-ticker.C:
 				c.pick()
+				c.lastTick.Store(time.Now().UnixNano())
 			case <-c.groupCtx.Done():
 				return
 			}
@@ -115,15 +187,49 @@ func (c *Controller) Run() error {
 	return nil
 }
 
-// Submit a transfer request.
-func (c *Controller) Submit(ctx context.Context, req *adminv1.CreatePackageRequest) (*Package, error) {
-	// TODO: have NewTransferPackage return a function we can schedule here.
-	var once sync.Once
-	queue := func(pkg *Package) {
-		once.Do(func() {
-			c.queue(pkg)
-			c.pick() // Start work right away, we don't want to wait for the next tick.
-		})
+// LastTick returns the time of the last iteration of the processing loop, or
+// the zero time if the loop is not running.
+func (c *Controller) LastTick() time.Time {
+	select {
+	case <-c.groupCtx.Done():
+		return time.Time{}
+	default:
+	}
+
+	nsec := c.lastTick.Load()
+	if nsec == 0 {
+		return time.Time{}
+	}
+
+	return time.Unix(0, nsec)
+}
+
+// Submit a transfer request. It returns the identifier of the package.
+//
+// Requests with an idempotency key already submitted are not submitted again,
+// the identifier of the original package is returned instead.
+func (c *Controller) Submit(ctx context.Context, req *adminv1.CreatePackageRequest) (uuid.UUID, error) {
+	if req.IdempotencyKey != "" {
+		c.submitMu.Lock()
+		defer c.submitMu.Unlock()
+
+		if id, err := c.replayedPackage(ctx, req); err != nil {
+			return uuid.Nil, err
+		} else if id != uuid.Nil {
+			return id, nil
+		}
+	}
+
+	config, err := resolveProcessingConfig(c.wf, c.sharedDir, req.ProcessingConfig, req.ProcessingConfigOverride)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	sources, err := resolveTransferSources(ctx, c.locations, req.Path)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	if config != nil && len(sources) == 1 && isArchive(sources[0]) {
+		return uuid.Nil, fmt.Errorf("%w: override cannot be applied to archives", ErrInvalidProcessingConfig)
 	}
 
 	pkg, err := NewTransferPackage( //nolint: contextcheck
@@ -133,13 +239,14 @@ func (c *Controller) Submit(ctx context.Context, req *adminv1.CreatePackageReque
 		c.store,
 		c.sharedDir,
 		req,
-		queue,
 	)
 	if err != nil {
-		return nil, fmt.Errorf("create package: %v", err)
+		return uuid.Nil, fmt.Errorf("create package: %v", err)
 	}
 
-	return pkg, nil
+	c.copyPackage(pkg, req.Name, sources, config)
+
+	return pkg.ID(), nil
 }
 
 // Notify the controller of a new with a slice of filesystem events.
@@ -183,40 +290,96 @@ func (c *Controller) Notify(path string) (err error) {
 }
 
 func (c *Controller) queue(pkg *Package) {
+	// The size is used to decide whether there is enough space to process
+	// the package, it's computed here to keep the tree walk out of the lock.
+	if size, err := packageSize(pkg.Path()); err != nil {
+		c.logger.Error(err, "Failed to compute package size.", "package", pkg)
+	} else {
+		pkg.size = size
+	}
+
+	c.queueMu.Lock()
+	defer c.queueMu.Unlock()
+
 	c.mu.Lock()
-	c.queuedPackages = append(c.queuedPackages, pkg)
+	item := c.queued.push(pkg)
 	c.metrics.PackageQueueLengthGauge.WithLabelValues(pkg.packageType().String()).Inc()
+	entry := queueEntry(item)
 	c.mu.Unlock()
+
+	if err := c.store.CreateQueueEntry(c.groupCtx, entry); err != nil {
+		// The package is still processed, it would be lost after a restart.
+		c.logger.Error(err, "Failed to persist queued package.", "package", pkg)
+	}
 }
 
+// pick starts processing the next package chosen by the scheduler, as long as
+// the concurrency limits and the space available in the shared directory allow
+// it.
 func (c *Controller) pick() {
+	// The usage is read without holding the lock, admit accounts for the
+	// packages started since with the space reserved for them.
+	usage, usageErr := c.readUsage()
+
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
-	if len(c.activePackages) == maxConcurrentPackages {
-		c.logger.V(2).Info("Not accepting new packages at this time.", "active", len(c.activePackages), "max", maxConcurrentPackages)
+	if len(c.activePackages) >= c.config.MaxActivePackages {
+		c.logger.V(2).Info("Not accepting new packages at this time.", "active", len(c.activePackages), "max", c.config.MaxActivePackages)
+		c.queueMessage = fmt.Sprintf("Waiting for one of the %d active packages to finish.", len(c.activePackages))
+		c.queueMessagePkg = nil
 		return
 	}
 
-	var pkg *Package
-	if len(c.queuedPackages) > 0 {
-		pkg = c.queuedPackages[0]
-		c.activePackages = append(c.activePackages, pkg)
-		c.queuedPackages = c.queuedPackages[1:]
-		c.metrics.ActivePackageGauge.Inc()
+	item := c.queued.next(c.eligible)
+	if item == nil {
+		c.queueMessage = ""
+		c.queueMessagePkg = nil
+		if c.queued.len() > 0 {
+			c.queueMessage = "Waiting for an active package of the same type to finish."
+		}
+		c.metrics.PackagesWaitingForSpaceGauge.Set(0)
+		return
 	}
 
-	if pkg == nil {
+	pkg := item.pkg
+	if ok, msg := c.admit(pkg, usage, usageErr); !ok {
+		if msg != c.queueMessage {
+			c.logger.Info("Not enough space to start a new package.", "msg", msg)
+		}
+		c.queueMessage = msg
+		c.queueMessagePkg = pkg
+		c.metrics.PackagesWaitingForSpaceGauge.Set(float64(c.queued.len()))
 		return
 	}
 
+	c.queued.pop(item)
+	c.activePackages = append(c.activePackages, pkg)
+	c.reserve(pkg)
+	c.metrics.ActivePackageGauge.Inc()
+	c.queueMessage = ""
+	c.queueMessagePkg = nil
+	c.metrics.PackagesWaitingForSpaceGauge.Set(0)
+
 	c.group.Go(func() error {
 		logger := c.logger.V(2).WithValues("package", pkg)
 		logger.Info("Processing started.")
 		defer c.deactivate(pkg)
 
-		iter := newJobIterator(c.groupCtx, logger, c.metrics, c.gearman, c.wf, pkg)
+		c.queueMu.Lock()
+		if err := c.store.DeleteQueueEntry(c.groupCtx, pkg.id); err != nil {
+			logger.Error(err, "Failed to remove package from the persisted queue.")
+		}
+		c.queueMu.Unlock()
+
+		iter := newJobIterator(c.groupCtx, logger, c.metrics, c.config, c.gearman, c.wf, pkg)
+		measuredAt := time.Now()
 		for {
+			if time.Since(measuredAt) >= reservationInterval {
+				c.shrinkReservation(pkg)
+				measuredAt = time.Now()
+			}
+
 			err := iter.next() // Runs the next job.
 
 			if errors.Is(err, errEnd) || errors.Is(err, io.EOF) {
@@ -235,11 +398,14 @@ func (c *Controller) pick() {
 	})
 }
 
-// deactivate removes a package from the activePackages queue.
+// deactivate removes a package from the activePackages queue and releases the
+// space reserved for it.
 func (c *Controller) deactivate(pkg *Package) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
+	c.release(pkg)
+
 	for i, item := range c.activePackages {
 		if item.id == pkg.id {
 			c.activePackages = append(c.activePackages[:i], c.activePackages[i+1:]...)
@@ -377,6 +543,38 @@ func (c *Controller) PackageDecisions(pkgID uuid.UUID) ([]*adminv1.Decision, boo
 	return ret, true
 }
 
+// CopyProgress returns the progress of the copy of a given package.
+func (c *Controller) CopyProgress(pkgID uuid.UUID) (*adminv1.CopyProgress, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	progress, ok := c.copyingPackages[pkgID]
+	if !ok {
+		return nil, false
+	}
+
+	return progress.convert(), true
+}
+
+// QueueMessage returns a message explaining why the package is queued, or
+// false if the package is not queued or nothing is holding it back.
+func (c *Controller) QueueMessage(pkgID uuid.UUID) (string, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if c.queueMessage == "" {
+		return "", false
+	}
+	if !c.queued.contains(pkgID) {
+		return "", false
+	}
+	if pkg := c.queueMessagePkg; pkg != nil && pkg.id != pkgID {
+		return fmt.Sprintf("Waiting for %q to start first.", pkg.Name()), true
+	}
+
+	return c.queueMessage, true
+}
+
 // Decisions lists awaiting decisions for all active packages.
 func (c *Controller) Decisions() map[uuid.UUID][]*adminv1.Decision {
 	c.mu.RLock()
@@ -457,6 +655,7 @@ func (c *Controller) Close() error {
 	var err error
 	c.closeOnce.Do(func() {
 		c.groupCancel()
+		_ = c.copyGroup.Wait()
 		if waitErr := c.group.Wait(); errors.Is(waitErr, context.Canceled) {
 			err = waitErr
 		}
@@ -0,0 +1,259 @@
+package controller
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+
+	"github.com/go-logr/logr"
+
+	adminv1 "github.com/artefactual-labs/ccp/internal/api/gen/archivematica/ccp/admin/v1beta1"
+	"github.com/artefactual-labs/ccp/internal/workflow"
+)
+
+// maxConcurrentCopies is the maximum number of transfers that can be copied
+// into the shared directory at any given time.
+const maxConcurrentCopies = 2
+
+// copyProgress tracks the progress of the copy of a transfer.
+type copyProgress struct {
+	totalFiles  atomic.Int64
+	totalBytes  atomic.Int64
+	copiedFiles atomic.Int64
+	copiedBytes atomic.Int64
+}
+
+func (p *copyProgress) convert() *adminv1.CopyProgress {
+	return &adminv1.CopyProgress{
+		TotalFiles:  p.totalFiles.Load(),
+		TotalBytes:  p.totalBytes.Load(),
+		CopiedFiles: p.copiedFiles.Load(),
+		CopiedBytes: p.copiedBytes.Load(),
+	}
+}
+
+// count adds the files and bytes found in path to the totals.
+func (p *copyProgress) count(path string) error {
+	if real, err := filepath.EvalSymlinks(path); err == nil {
+		path = real
+	}
+	return filepath.WalkDir(path, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		p.totalFiles.Add(1)
+		p.totalBytes.Add(info.Size())
+		return nil
+	})
+}
+
+// copyPackage runs the copy stage of a transfer and queues the package when
+// it completes successfully. The copy waits for its turn when the maximum
+// number of concurrent copies is reached.
+//
+// A failed copy marks the package as failed. The temporary directory created
+// under sharedDir/tmp is always removed, including on cancellation.
+func (c *Controller) copyPackage(pkg *Package, name string, sources []string, config []workflow.Choice) {
+	progress := &copyProgress{}
+
+	c.mu.Lock()
+	c.copyingPackages[pkg.id] = progress
+	c.mu.Unlock()
+
+	c.copyGroup.Go(func() error {
+		ctx := c.groupCtx
+		logger := c.logger.WithName("copy").WithValues("package", pkg.id)
+
+		defer func() {
+			c.mu.Lock()
+			delete(c.copyingPackages, pkg.id)
+			c.mu.Unlock()
+		}()
+
+		err := runCopy(ctx, logger, c.copySem, c.sharedDir, pkg, name, sources, config, progress)
+		if errors.Is(err, context.Canceled) {
+			logger.Info("Copy canceled.")
+			return nil
+		} else if err != nil {
+			logger.Error(err, "Copy failed.")
+			if err := pkg.markAsFailed(ctx, pkg.store); err != nil {
+				logger.Error(err, "Unable to mark package as failed.")
+			}
+			return nil
+		}
+
+		logger.V(2).Info("Transfer ready for processing.", "path", pkg.Path())
+
+		c.queue(pkg)
+		c.pick() // Start work right away, we don't want to wait for the next tick.
+
+		return nil
+	})
+}
+
+func runCopy(ctx context.Context, logger logr.Logger, sem chan struct{}, sharedDir string, pkg *Package, name string, sources []string, config []workflow.Choice, progress *copyProgress) error {
+	select {
+	case sem <- struct{}{}:
+		defer func() { <-sem }()
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	tmpDir, err := os.MkdirTemp(filepath.Join(sharedDir, "tmp"), "")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := os.RemoveAll(tmpDir); err != nil {
+			logger.Error(err, "Unable to remove temporary directory.", "tmpDir", tmpDir)
+		}
+	}()
+	_ = os.Chmod(tmpDir, os.FileMode(0o770))
+
+	for _, src := range sources {
+		if err := progress.count(src); err != nil {
+			return fmt.Errorf("read sources: %v", err)
+		}
+	}
+
+	path, err := copyTransfer(ctx, sharedDir, tmpDir, name, sources, config, progress)
+	if err != nil {
+		return fmt.Errorf("copy transfer: %w", err)
+	}
+
+	pkg.UpdatePath(path)
+	if err := pkg.store.UpdateTransferLocation(ctx, pkg.id, path); err != nil {
+		logger.Info("Unable to update the transfer location.", "path", path, "err", err)
+	}
+
+	return nil
+}
+
+// copyTree copies src into dst recursively. Regular files are verified after
+// they are written, symbolic links are recreated unless src is a symbolic link
+// itself, and other file types are rejected.
+func copyTree(ctx context.Context, src, dst string, progress *copyProgress) error {
+	if real, err := filepath.EvalSymlinks(src); err == nil {
+		src = real
+	}
+	return filepath.WalkDir(src, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, rel)
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+
+		switch mode := info.Mode(); {
+		case mode.IsDir():
+			return os.MkdirAll(target, mode.Perm()|0o700)
+		case mode.IsRegular():
+			return copyFile(ctx, path, target, mode.Perm(), progress)
+		case mode&fs.ModeSymlink != 0:
+			link, err := os.Readlink(path)
+			if err != nil {
+				return err
+			}
+			return os.Symlink(link, target)
+		default:
+			return fmt.Errorf("%s: unsupported file type %s", path, mode.Type())
+		}
+	})
+}
+
+// copyFile copies a regular file and confirms that the checksum of the file
+// written matches the checksum of the source.
+func copyFile(ctx context.Context, src, dst string, perm fs.FileMode, progress *copyProgress) error {
+	r, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	w, err := os.OpenFile(dst, os.O_CREATE|os.O_EXCL|os.O_WRONLY, perm|0o600)
+	if err != nil {
+		return err
+	}
+
+	srcHash := sha256.New()
+	_, err = io.Copy(w, io.TeeReader(&progressReader{ctx: ctx, r: r, progress: progress}, srcHash))
+	if err == nil {
+		err = w.Sync()
+	}
+	if closeErr := w.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+
+	dstHash, err := checksum(dst)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(srcHash.Sum(nil), dstHash) {
+		return fmt.Errorf("%s: checksum mismatch", dst)
+	}
+
+	progress.copiedFiles.Add(1)
+
+	return nil
+}
+
+// checksum returns the SHA-256 checksum of the file.
+func checksum(path string) ([]byte, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return nil, err
+	}
+
+	return h.Sum(nil), nil
+}
+
+// progressReader is an io.Reader that records the number of bytes read and
+// stops reading when the context is canceled.
+type progressReader struct {
+	ctx      context.Context
+	r        io.Reader
+	progress *copyProgress
+}
+
+func (r *progressReader) Read(p []byte) (int, error) {
+	if err := r.ctx.Err(); err != nil {
+		return 0, err
+	}
+	n, err := r.r.Read(p)
+	r.progress.copiedBytes.Add(int64(n))
+	return n, err
+}
@@ -0,0 +1,155 @@
+package controller
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/google/uuid"
+	"go.artefactual.dev/tools/mockutil"
+	"go.uber.org/mock/gomock"
+	"gotest.tools/v3/assert"
+	"gotest.tools/v3/fs"
+
+	"github.com/artefactual-labs/ccp/internal/store/enums"
+	"github.com/artefactual-labs/ccp/internal/store/storemock"
+)
+
+func TestCopyTree(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Copies and counts files", func(t *testing.T) {
+		t.Parallel()
+
+		src := fs.NewDir(t, "",
+			fs.WithFile("a.txt", "12345"),
+			fs.WithDir("sub", fs.WithFile("b.txt", "123")),
+		)
+		assert.NilError(t, os.Symlink("b.txt", src.Join("sub", "link")))
+		dst := fs.NewDir(t, "")
+		progress := &copyProgress{}
+
+		assert.NilError(t, progress.count(src.Path()))
+		assert.NilError(t, copyTree(context.Background(), src.Path(), dst.Join("copy"), progress))
+
+		assert.Assert(t, fs.Equal(dst.Join("copy"), fs.Expected(t,
+			fs.MatchAnyFileMode,
+			fs.WithFile("a.txt", "12345", fs.MatchAnyFileMode),
+			fs.WithDir("sub",
+				fs.MatchAnyFileMode,
+				fs.WithFile("b.txt", "123", fs.MatchAnyFileMode),
+				fs.WithSymlink("link", "b.txt"),
+			),
+		)))
+		p := progress.convert()
+		assert.Equal(t, p.TotalFiles, int64(2))
+		assert.Equal(t, p.TotalBytes, int64(8))
+		assert.Equal(t, p.CopiedFiles, int64(2))
+		assert.Equal(t, p.CopiedBytes, int64(8))
+	})
+
+	t.Run("Stops when the context is canceled", func(t *testing.T) {
+		t.Parallel()
+
+		src := fs.NewDir(t, "", fs.WithFile("a.txt", "12345"))
+		dst := fs.NewDir(t, "")
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		err := copyTree(ctx, src.Path(), dst.Join("copy"), &copyProgress{})
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+}
+
+func TestCopyPackage(t *testing.T) {
+	t.Parallel()
+
+	newController := func(t *testing.T) (*Controller, *storemock.MockStore, *fs.Dir) {
+		t.Helper()
+
+		sharedDir := fs.NewDir(t, "", fs.WithDir("currentlyProcessing"), fs.WithDir("tmp"))
+		store := storemock.NewMockStore(gomock.NewController(t))
+		c := New(logr.Discard(), Config{}, nil, store, nil, nil, sharedDir.Path(), sharedDir.Join("watchedDirectories"))
+
+		return c, store, sharedDir
+	}
+
+	newTransfer := func(c *Controller) *Package {
+		pkg := newPackage(logr.Discard(), c.store, c.sharedDir)
+		pkg.id = uuid.New()
+		pkg.unit = &Transfer{pkg: pkg}
+
+		return pkg
+	}
+
+	t.Run("Marks the package as failed when the copy fails", func(t *testing.T) {
+		t.Parallel()
+
+		c, store, sharedDir := newController(t)
+		pkg := newTransfer(c)
+
+		store.EXPECT().
+			UpdatePackageStatus(mockutil.Context(), pkg.id, enums.PackageTypeTransfer, enums.PackageStatusFailed).
+			Return(nil).
+			Times(1)
+
+		c.copyPackage(pkg, "Transfer", []string{sharedDir.Join("missing")}, nil)
+		assert.NilError(t, c.copyGroup.Wait())
+
+		_, ok := c.CopyProgress(pkg.id)
+		assert.Assert(t, !ok)
+		assert.Assert(t, fs.Equal(sharedDir.Join("tmp"), fs.Expected(t, fs.MatchAnyFileMode)))
+		assert.Equal(t, c.queued.len(), 0)
+	})
+
+	t.Run("Abandons the copy when the controller is closed", func(t *testing.T) {
+		t.Parallel()
+
+		c, _, sharedDir := newController(t)
+		pkg := newTransfer(c)
+		src := fs.NewDir(t, "", fs.WithFile("image.jpg", ""))
+
+		// Keep every copy slot busy.
+		for range maxConcurrentCopies {
+			c.copySem <- struct{}{}
+		}
+
+		c.copyPackage(pkg, "Transfer", []string{src.Path()}, nil)
+		progress, ok := c.CopyProgress(pkg.id)
+		assert.Assert(t, ok)
+		assert.Equal(t, progress.TotalFiles, int64(0))
+
+		assert.NilError(t, c.Close())
+
+		_, ok = c.CopyProgress(pkg.id)
+		assert.Assert(t, !ok)
+		assert.Assert(t, fs.Equal(sharedDir.Join("tmp"), fs.Expected(t, fs.MatchAnyFileMode)))
+	})
+}
+
+func TestRunCopy(t *testing.T) {
+	t.Parallel()
+
+	sharedDir := fs.NewDir(t, "", fs.WithDir("currentlyProcessing"), fs.WithDir("tmp"))
+	src := fs.NewDir(t, "", fs.WithDir("Images", fs.WithFile("image.jpg", "jpg")))
+	store := storemock.NewMockStore(gomock.NewController(t))
+	pkg := newPackage(logr.Discard(), store, sharedDir.Path())
+	pkg.id = uuid.New()
+	pkg.unit = &Transfer{pkg: pkg}
+	progress := &copyProgress{}
+
+	store.EXPECT().
+		UpdateTransferLocation(mockutil.Context(), pkg.id, sharedDir.Join("currentlyProcessing", "Images")).
+		Return(nil).
+		Times(1)
+
+	err := runCopy(context.Background(), logr.Discard(), make(chan struct{}, 1), sharedDir.Path(), pkg, "Images", []string{src.Join("Images")}, nil, progress)
+	assert.NilError(t, err)
+	assert.Equal(t, pkg.Path(), sharedDir.Join("currentlyProcessing", "Images"))
+	assert.Equal(t, progress.convert().CopiedFiles, int64(1))
+
+	entries, err := os.ReadDir(sharedDir.Join("tmp"))
+	assert.NilError(t, err)
+	assert.Equal(t, len(entries), 0)
+}
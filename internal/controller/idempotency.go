@@ -0,0 +1,53 @@
+package controller
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
+
+	adminv1 "github.com/artefactual-labs/ccp/internal/api/gen/archivematica/ccp/admin/v1beta1"
+	"github.com/artefactual-labs/ccp/internal/store"
+)
+
+// ErrIdempotencyKeyReused is returned when a request reuses the idempotency key
+// of a previous request with a different payload.
+var ErrIdempotencyKeyReused = errors.New("idempotency key already used with a different request")
+
+// requestDigest returns the digest of the payload of a request, i.e. excluding
+// its idempotency key.
+func requestDigest(req *adminv1.CreatePackageRequest) (string, error) {
+	req = proto.Clone(req).(*adminv1.CreatePackageRequest)
+	req.IdempotencyKey = ""
+
+	blob, err := proto.MarshalOptions{Deterministic: true}.Marshal(req)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(blob)
+
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// replayedPackage returns the identifier of the package previously created by
+// the same request, or uuid.Nil when the idempotency key was never used.
+func (c *Controller) replayedPackage(ctx context.Context, req *adminv1.CreatePackageRequest) (uuid.UUID, error) {
+	id, digest, err := c.store.ReadTransferWithIdempotencyKey(ctx, req.IdempotencyKey)
+	if errors.Is(err, store.ErrNotFound) {
+		return uuid.Nil, nil
+	} else if err != nil {
+		return uuid.Nil, err
+	}
+
+	if want, err := requestDigest(req); err != nil {
+		return uuid.Nil, err
+	} else if digest != want {
+		return uuid.Nil, fmt.Errorf("%w: %q", ErrIdempotencyKeyReused, req.IdempotencyKey)
+	}
+
+	return id, nil
+}
@@ -0,0 +1,104 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/google/uuid"
+	"go.artefactual.dev/tools/mockutil"
+	"go.uber.org/mock/gomock"
+	"gotest.tools/v3/assert"
+
+	adminv1 "github.com/artefactual-labs/ccp/internal/api/gen/archivematica/ccp/admin/v1beta1"
+	"github.com/artefactual-labs/ccp/internal/store"
+	"github.com/artefactual-labs/ccp/internal/store/storemock"
+)
+
+func TestRequestDigest(t *testing.T) {
+	t.Parallel()
+
+	req := &adminv1.CreatePackageRequest{
+		Name: "Images",
+		Path: []string{"/tmp/Images"},
+	}
+	digest, err := requestDigest(req)
+	assert.NilError(t, err)
+
+	// The idempotency key is not part of the digest.
+	other, err := requestDigest(&adminv1.CreatePackageRequest{
+		Name:           "Images",
+		Path:           []string{"/tmp/Images"},
+		IdempotencyKey: "key",
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, other, digest)
+
+	other, err = requestDigest(&adminv1.CreatePackageRequest{
+		Name: "Images",
+		Path: []string{"/tmp/Images", "/tmp/Documents"},
+	})
+	assert.NilError(t, err)
+	assert.Assert(t, other != digest)
+}
+
+func TestSubmitIdempotency(t *testing.T) {
+	t.Parallel()
+
+	req := &adminv1.CreatePackageRequest{
+		Name:           "Images",
+		Path:           []string{"/tmp/Images"},
+		IdempotencyKey: "b7e1a5c6",
+	}
+	digest, err := requestDigest(req)
+	assert.NilError(t, err)
+
+	t.Run("Returns the original package", func(t *testing.T) {
+		t.Parallel()
+
+		s := storemock.NewMockStore(gomock.NewController(t))
+		c := New(logr.Discard(), Config{}, nil, s, nil, nil, t.TempDir(), t.TempDir())
+		pkgID := uuid.New()
+
+		s.EXPECT().
+			ReadTransferWithIdempotencyKey(mockutil.Context(), "b7e1a5c6").
+			Return(pkgID, digest, nil).
+			Times(1)
+
+		id, err := c.Submit(context.Background(), req)
+		assert.NilError(t, err)
+		assert.Equal(t, id, pkgID)
+	})
+
+	t.Run("Rejects a different payload", func(t *testing.T) {
+		t.Parallel()
+
+		s := storemock.NewMockStore(gomock.NewController(t))
+		c := New(logr.Discard(), Config{}, nil, s, nil, nil, t.TempDir(), t.TempDir())
+
+		s.EXPECT().
+			ReadTransferWithIdempotencyKey(mockutil.Context(), "b7e1a5c6").
+			Return(uuid.New(), "digest", nil).
+			Times(1)
+
+		_, err := c.Submit(context.Background(), req)
+		assert.ErrorIs(t, err, ErrIdempotencyKeyReused)
+	})
+
+	t.Run("Submits new requests", func(t *testing.T) {
+		t.Parallel()
+
+		s := storemock.NewMockStore(gomock.NewController(t))
+		c := New(logr.Discard(), Config{}, nil, s, nil, nil, t.TempDir(), t.TempDir())
+
+		s.EXPECT().
+			ReadTransferWithIdempotencyKey(mockutil.Context(), "b7e1a5c6").
+			Return(uuid.Nil, "", store.ErrNotFound).
+			Times(1)
+
+		// The request continues and fails because there are no processing
+		// configurations in the shared directory.
+		_, err := c.Submit(context.Background(), req)
+		assert.ErrorIs(t, err, ErrInvalidProcessingConfig)
+	})
+}
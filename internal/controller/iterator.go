@@ -25,9 +25,10 @@ type jobIterator struct {
 	pkg      *Package
 	nextLink uuid.UUID // Next workflow link or workflow chain link.
 	chain    *chain    // Current workflow chain
+	config   Config
 }
 
-func newJobIterator(ctx context.Context, logger logr.Logger, metrics *metrics.Metrics, gearman *gearmin.Server, wf *workflow.Document, pkg *Package) *jobIterator {
+func newJobIterator(ctx context.Context, logger logr.Logger, metrics *metrics.Metrics, config Config, gearman *gearmin.Server, wf *workflow.Document, pkg *Package) *jobIterator {
 	iter := &jobIterator{
 		ctx:     ctx,
 		logger:  logger,
@@ -35,6 +36,7 @@ func newJobIterator(ctx context.Context, logger logr.Logger, metrics *metrics.Me
 		gearman: gearman,
 		wf:      wf,
 		pkg:     pkg,
+		config:  config,
 	}
 
 	return iter
@@ -43,7 +45,7 @@ func newJobIterator(ctx context.Context, logger logr.Logger, metrics *metrics.Me
 func (i *jobIterator) init() error {
 	i.logger.Info("Init iterator.")
 
-	err := i.pkg.markAsProcessing(i.ctx)
+	err := i.pkg.markAsProcessing(i.ctx, i.pkg.store)
 	if err != nil {
 		return err
 	}
@@ -106,11 +108,15 @@ func (i *jobIterator) next() error {
 
 	if errors.Is(err, io.EOF) {
 		if wl.End {
-			if err := j.pkg.markAsDone(i.ctx); err != nil {
+			if err := j.markDone(i.ctx); err != nil {
 				j.logger.Error(err, "Failed to mark the package as done.")
 			}
 			return errEnd
 		} else {
+			if err := j.markComplete(i.ctx); err != nil {
+				j.logger.Error(err, "Failed to mark the job as complete.")
+			}
+
 			// Signal end of this iterator.
 			// Workflow must continue using a watched directory.
 			//
@@ -120,7 +126,7 @@ func (i *jobIterator) next() error {
 	} else if _, ok := isErrWait(err); ok {
 		return err
 	} else if err != nil {
-		if err := j.pkg.markAsFailed(i.ctx); err != nil {
+		if err := j.markFailed(i.ctx); err != nil {
 			j.logger.Error(err, "Failed to mark the package as failed.")
 		}
 		return fmt.Errorf("exec job for link %s with manager %s (%s) : %v", wl.ID, wl.Manager, wl.Description, err)
@@ -145,6 +151,7 @@ func (i *jobIterator) buildJob(wl *workflow.Link, logger logr.Logger) (*job, err
 	if err != nil {
 		return nil, fmt.Errorf("build job: %v", err)
 	}
+	j.skipSuccessfulOutput = i.config.SkipSuccessfulTaskOutput
 
 	return j, nil
 }
@@ -11,6 +11,7 @@ import (
 
 	"github.com/artefactual-labs/ccp/internal/cmd/servercmd/metrics"
 	"github.com/artefactual-labs/ccp/internal/derrors"
+	"github.com/artefactual-labs/ccp/internal/store"
 	"github.com/artefactual-labs/ccp/internal/store/sqlcmysql"
 	"github.com/artefactual-labs/ccp/internal/workflow"
 )
@@ -43,8 +44,20 @@ type job struct {
 	// jobRunner is what makes a job executable.
 	jobRunner
 
-	// finalStatusRecorded remembers if updateStatusFromExitCode was used.
+	// status is the final status of the job set by setStatusFromExitCode,
+	// persisted when the job transitions.
+	status string
+
+	// staged holds the changes that must be persisted along with the final
+	// status of the job, see stage.
+	staged []func(context.Context, store.Tx) error
+
+	// finalStatusRecorded remembers if the job has transitioned.
 	finalStatusRecorded bool
+
+	// skipSuccessfulOutput omits the output of the tasks that succeed when
+	// they're persisted, see Config.SkipSuccessfulTaskOutput.
+	skipSuccessfulOutput bool
 }
 
 // jobRunner is the interface that all jobs must implement.
@@ -158,41 +171,94 @@ func (j *job) markAwaitingDecision(ctx context.Context) error {
 	return nil
 }
 
-// markComplete is used by decision jobs to persist the completion status.
+// markComplete persists the final status of the job, which is the status set
+// by setStatusFromExitCode or the completion status.
 func (j *job) markComplete(ctx context.Context) error {
-	// Certain jobs may have already used updateStatusFromExitCode.
+	// Decision jobs may complete before and after awaiting a decision.
 	if j.finalStatusRecorded {
 		return nil
 	}
 
-	err := j.pkg.store.UpdateJobStatus(ctx, j.id, "STATUS_COMPLETED_SUCCESSFULLY")
-	if err != nil {
+	if err := j.transition(ctx, j.finalStatus(), nil); err != nil {
 		return fmt.Errorf("mark complete: %v", err)
 	}
 
-	j.finalStatusRecorded = true
+	return nil
+}
+
+// markDone persists the final status of the job and marks the package as done
+// when the job terminates the workflow.
+func (j *job) markDone(ctx context.Context) error {
+	if err := j.transition(ctx, j.finalStatus(), j.pkg.markAsDone); err != nil {
+		return fmt.Errorf("mark done: %v", err)
+	}
 
 	return nil
 }
 
-func (j *job) updateStatusFromExitCode(ctx context.Context, code int) error {
-	status := ""
-	if ec, ok := j.wl.ExitCodes[code]; ok {
-		status = ec.JobStatus
-	} else {
-		status = j.wl.FallbackJobStatus
+// markFailed marks the job and the package as failed. The staged changes are
+// discarded.
+func (j *job) markFailed(ctx context.Context) error {
+	j.staged = nil
+
+	if err := j.transition(ctx, "STATUS_FAILED", j.pkg.markAsFailed); err != nil {
+		return fmt.Errorf("mark failed: %v", err)
 	}
 
-	err := j.pkg.store.UpdateJobStatus(ctx, j.id, status)
+	return nil
+}
+
+// transition persists the status of the job, the staged changes and the
+// changes made by fn (optional) in a single transaction, so the job and its
+// package cannot be left in conflicting states.
+func (j *job) transition(ctx context.Context, status string, fn func(context.Context, store.Tx) error) error {
+	err := j.pkg.store.RunInTx(ctx, func(tx store.Tx) error {
+		for _, change := range j.staged {
+			if err := change(ctx, tx); err != nil {
+				return err
+			}
+		}
+		if fn != nil {
+			if err := fn(ctx, tx); err != nil {
+				return err
+			}
+		}
+		return tx.UpdateJobStatus(ctx, j.id, status)
+	})
 	if err != nil {
-		return fmt.Errorf("update job status from exit code: %v", err)
+		return err
 	}
 
+	j.staged = nil
 	j.finalStatusRecorded = true
 
 	return nil
 }
 
+// stage defers a change until the job transitions, e.g. a unit variable that
+// must not be visible unless the job completes.
+func (j *job) stage(change func(context.Context, store.Tx) error) {
+	j.staged = append(j.staged, change)
+}
+
+// setStatusFromExitCode sets the final status of the job given the exit code
+// of its tasks. It is persisted when the job transitions.
+func (j *job) setStatusFromExitCode(code int) {
+	if ec, ok := j.wl.ExitCodes[code]; ok {
+		j.status = ec.JobStatus
+	} else {
+		j.status = j.wl.FallbackJobStatus
+	}
+}
+
+func (j *job) finalStatus() string {
+	if j.status == "" {
+		return "STATUS_COMPLETED_SUCCESSFULLY"
+	}
+
+	return j.status
+}
+
 // processTasksResults processes a set of task results produced by a client job,
 // e.g.: filesClientScriptJob. It returns the highest exist code seen.
 func (j *job) processTaskResults(cfg *workflow.LinkStandardTaskConfig, tr *taskResults) int {
@@ -43,9 +43,7 @@ func (l *directoryClientScriptJob) exec(ctx context.Context) (uuid.UUID, error)
 	}
 
 	exitCode := l.j.processTaskResults(l.config, taskResult)
-	if err := l.j.updateStatusFromExitCode(ctx, exitCode); err != nil {
-		return uuid.Nil, err
-	}
+	l.j.setStatusFromExitCode(exitCode)
 
 	if ec, ok := l.j.wl.ExitCodes[exitCode]; ok {
 		if ec.LinkID == nil {
@@ -115,9 +113,7 @@ func (l *filesClientScriptJob) exec(ctx context.Context) (uuid.UUID, error) {
 	}
 
 	exitCode := l.j.processTaskResults(l.config, taskResults)
-	if err := l.j.updateStatusFromExitCode(ctx, exitCode); err != nil {
-		return uuid.Nil, err
-	}
+	l.j.setStatusFromExitCode(exitCode)
 
 	if ec, ok := l.j.wl.ExitCodes[exitCode]; ok {
 		if ec.LinkID == nil {
@@ -137,15 +133,15 @@ func (l *filesClientScriptJob) submitTasks(ctx context.Context, filterSubDir str
 	rm := l.j.pkg.unit.replacements(filterSubDir).update(l.j.chain)
 	taskBackend := newTaskBackend(l.j.logger, l.j.metrics, l.j, l.j.pkg.store, l.j.gearman, l.config)
 
-	files, err := l.j.pkg.Files(ctx, l.config.FilterFileEnd, filterSubDir)
-	if err != nil {
-		return nil, err
-	}
-	if len(files) == 0 {
-		return &taskResults{}, nil // Nothing to do.
-	}
+	// The files are streamed into the batches of the backend, which are
+	// submitted as soon as they are full.
+	var submitted int
+	for fileReplacements, err := range l.j.pkg.Files(ctx, l.config.FilterFileEnd, filterSubDir) {
+		if err != nil {
+			return nil, err
+		}
+		submitted++
 
-	for _, fileReplacements := range files {
 		rm = rm.with(fileReplacements)
 		args := rm.replaceValues(l.config.Arguments)
 		stdout := rm.replaceValues(l.config.StdoutFile)
@@ -155,6 +151,9 @@ func (l *filesClientScriptJob) submitTasks(ctx context.Context, filterSubDir str
 			return nil, err
 		}
 	}
+	if submitted == 0 {
+		return &taskResults{}, nil // Nothing to do.
+	}
 
 	res, err := taskBackend.wait(ctx)
 	if err != nil {
@@ -55,6 +55,7 @@ func TestDirectoryClientScriptJob(t *testing.T) {
 		store.EXPECT().CreateJob(mockutil.Context(), gomock.Any()).Return(nil).Times(1)
 		store.EXPECT().UpdateJobStatus(mockutil.Context(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 		store.EXPECT().CreateTasks(mockutil.Context(), gomock.Any()).Return(nil).AnyTimes()
+		store.EXPECT().UpdateTasks(mockutil.Context(), gomock.Len(1)).Return(nil).Times(1)
 
 		_, err := job.exec(context.Background())
 		assert.ErrorIs(t, err, io.EOF) // End of chain.
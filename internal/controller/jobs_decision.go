@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"maps"
+	"slices"
 	"sync"
 
 	"github.com/google/uuid"
@@ -233,13 +234,7 @@ func (l *nextChainDecisionJob) exec(ctx context.Context) (_ uuid.UUID, err error
 		}
 
 		// Fail if the choice is not available in workflow.
-		var matched bool
-		for _, item := range l.config.Choices {
-			if _, ok := l.j.wf.Chains[item]; ok {
-				matched = true
-			}
-		}
-		if !matched {
+		if _, ok := l.j.wf.Chains[cid]; !ok || !slices.Contains(l.config.Choices, cid) {
 			return uuid.Nil, fmt.Errorf("choice %s is not one of the available choices", chainID)
 		}
 		return cid, nil
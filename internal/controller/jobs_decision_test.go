@@ -200,6 +200,9 @@ func createAutomatedProcessingConfig(t *testing.T, path string) {
 
 	path = filepath.Join(path, "processingMCP.xml")
 
-	err := workflow.SaveConfigFile(path, workflow.AutomatedConfig.Choices)
+	wf, err := workflow.Default()
+	assert.NilError(t, err)
+
+	err = workflow.SaveConfigFile(wf, path, workflow.AutomatedConfig.Choices)
 	assert.NilError(t, err)
 }
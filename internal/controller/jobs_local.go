@@ -39,9 +39,10 @@ func newSetUnitVarLinkJob(j *job) (*setUnitVarLinkJob, error) {
 func (l *setUnitVarLinkJob) exec(ctx context.Context) (_ uuid.UUID, err error) {
 	derrors.Add(&err, "setUnitVarLinkJob")
 
-	if err := l.j.pkg.saveLinkID(ctx, l.config.Variable, l.config.LinkID); err != nil {
-		return uuid.Nil, err
-	}
+	// The variable is saved when the job completes.
+	l.j.stage(func(ctx context.Context, tx store.Tx) error {
+		return l.j.pkg.saveLinkID(ctx, tx, l.config.Variable, l.config.LinkID)
+	})
 
 	return exitCodeLinkID(l.j.wl, 0), nil
 }
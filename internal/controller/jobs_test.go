@@ -2,17 +2,20 @@ package controller
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/artefactual-labs/gearmin/gearmintest"
 	"github.com/go-logr/logr"
 	"github.com/google/uuid"
 	"github.com/mikespook/gearman-go/worker"
+	"go.artefactual.dev/tools/mockutil"
 	"go.uber.org/mock/gomock"
 	"gotest.tools/v3/assert"
 	"gotest.tools/v3/fs"
 
 	"github.com/artefactual-labs/ccp/internal/cmd/servercmd/metrics"
+	"github.com/artefactual-labs/ccp/internal/store"
 	"github.com/artefactual-labs/ccp/internal/store/enums"
 	"github.com/artefactual-labs/ccp/internal/store/storemock"
 	"github.com/artefactual-labs/ccp/internal/workflow"
@@ -39,10 +42,17 @@ func createJobWithHandlers(t *testing.T, linkID string, handlers map[string]gear
 	gearmin := gearmintest.Server(t, handlers)
 	wf, _ := workflow.Default()
 	ln := wf.Links[uuid.MustParse(linkID)]
-	store := storemock.NewMockStore(gomock.NewController(t))
+	mockStore := storemock.NewMockStore(gomock.NewController(t))
 	chain := newChain(nil)
 
-	pkg := newPackage(logr.Discard(), store, tmpDir.Join("sharedDir"))
+	// Transactions run against the mock store, which also implements store.Tx.
+	mockStore.EXPECT().RunInTx(mockutil.Context(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, fn func(store.Tx) error) error {
+			return fn(mockStore)
+		},
+	).AnyTimes()
+
+	pkg := newPackage(logr.Discard(), mockStore, tmpDir.Join("sharedDir"))
 	pkg.id = uuid.New()
 	pkg.unit = &noUnit{}
 	pkg.path = tmpDir.Join("sharedDir/tmp/pkg")
@@ -50,7 +60,63 @@ func createJobWithHandlers(t *testing.T, linkID string, handlers map[string]gear
 	job, err := newJob(logr.Discard(), metrics.NewMetrics(nil), chain, pkg, gearmin, ln, wf)
 	assert.NilError(t, err)
 
-	return job, store
+	return job, mockStore
+}
+
+func TestJobTransitions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Marks the job and the package as done", func(t *testing.T) {
+		t.Parallel()
+
+		job, st := createJob(t, "b33c9544-145c-4525-8a80-d686b4d1c3fa")
+		job.setStatusFromExitCode(0)
+		job.stage(func(ctx context.Context, tx store.Tx) error {
+			return job.pkg.saveLinkID(ctx, tx, "var", uuid.Nil)
+		})
+
+		gomock.InOrder(
+			st.EXPECT().CreateUnitVar(mockutil.Context(), job.pkg.id, enums.PackageTypeTransfer, "var", "", uuid.Nil, true).Return(nil),
+			st.EXPECT().UpdatePackageStatus(mockutil.Context(), job.pkg.id, enums.PackageTypeTransfer, enums.PackageStatusDone).Return(nil),
+			st.EXPECT().UpdateJobStatus(mockutil.Context(), job.id, job.wl.ExitCodes[0].JobStatus).Return(nil),
+		)
+
+		err := job.markDone(context.Background())
+		assert.NilError(t, err)
+
+		// The job has transitioned already.
+		err = job.markComplete(context.Background())
+		assert.NilError(t, err)
+	})
+
+	t.Run("Marks the job and the package as failed", func(t *testing.T) {
+		t.Parallel()
+
+		job, st := createJob(t, "b33c9544-145c-4525-8a80-d686b4d1c3fa")
+		job.stage(func(ctx context.Context, tx store.Tx) error {
+			t.Fatal("Staged change of a failed job was persisted.")
+			return nil
+		})
+
+		st.EXPECT().UpdatePackageStatus(mockutil.Context(), job.pkg.id, enums.PackageTypeTransfer, enums.PackageStatusFailed).Return(nil)
+		st.EXPECT().UpdateJobStatus(mockutil.Context(), job.id, "STATUS_FAILED").Return(nil)
+
+		err := job.markFailed(context.Background())
+		assert.NilError(t, err)
+	})
+
+	t.Run("Does not transition if the transaction fails", func(t *testing.T) {
+		t.Parallel()
+
+		job, st := createJob(t, "b33c9544-145c-4525-8a80-d686b4d1c3fa")
+
+		st.EXPECT().UpdatePackageStatus(mockutil.Context(), job.pkg.id, enums.PackageTypeTransfer, enums.PackageStatusDone).Return(nil)
+		st.EXPECT().UpdateJobStatus(mockutil.Context(), job.id, "STATUS_COMPLETED_SUCCESSFULLY").Return(errors.New("deadlock"))
+
+		err := job.markDone(context.Background())
+		assert.Error(t, err, "mark done: deadlock")
+		assert.Assert(t, !job.finalStatusRecorded)
+	})
 }
 
 type noUnit struct{}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"io/fs"
+	"iter"
 	"maps"
 	"os"
 	"path/filepath"
@@ -14,7 +15,6 @@ import (
 	"connectrpc.com/authn"
 	"github.com/go-logr/logr"
 	"github.com/google/uuid"
-	"github.com/otiai10/copy"
 
 	adminv1 "github.com/artefactual-labs/ccp/internal/api/gen/archivematica/ccp/admin/v1beta1"
 	"github.com/artefactual-labs/ccp/internal/derrors"
@@ -47,6 +47,16 @@ type Package struct {
 
 	// Identifier of the link where the iterator must start processing.
 	startAtLinkID uuid.UUID
+
+	// Size in bytes of the package contents when it was queued.
+	size uint64
+
+	// Space in bytes of the shared directory reserved for the package while
+	// it is processed, protected by the mutex of the Controller.
+	reserved uint64
+
+	// Priority of the package in the processing queue.
+	priority adminv1.PackagePriority
 }
 
 func newPackage(logger logr.Logger, store store.Store, sharedDir string) *Package {
@@ -87,6 +97,39 @@ func NewPackage(ctx context.Context, logger logr.Logger, store store.Store, shar
 	return pkg, nil
 }
 
+// restorePackage recreates a package from its entry in the persisted queue.
+// The rest of its state is reloaded from the database when processing starts.
+func restorePackage(logger logr.Logger, store store.Store, sharedDir string, entry *store.QueueEntry) (*Package, error) {
+	pkg := newPackage(logger, store, sharedDir)
+	pkg.id = entry.PackageID
+	pkg.path = entry.Path
+	pkg.priority = adminv1.PackagePriority(entry.Priority)
+	pkg.size = entry.Size
+	if entry.StartChainID.Valid {
+		pkg.startAtChainID = entry.StartChainID.UUID
+	}
+	if entry.StartLinkID.Valid {
+		pkg.startAtLinkID = entry.StartLinkID.UUID
+	}
+
+	switch entry.PackageType {
+	case enums.PackageTypeTransfer:
+		pkg.unit = &Transfer{pkg: pkg}
+	case enums.PackageTypeSIP:
+		pkg.unit = &SIP{pkg: pkg}
+	case enums.PackageTypeDIP:
+		pkg.unit = &DIP{pkg: pkg}
+	default:
+		return nil, fmt.Errorf("unexpected package type %q", entry.PackageType)
+	}
+
+	if _, err := os.Stat(pkg.Path()); err != nil {
+		return nil, fmt.Errorf("stat: %v", err)
+	}
+
+	return pkg, nil
+}
+
 // NewTransferPackage creates a new package after an API request.
 //
 //  1. Create Package (Transfer).
@@ -107,17 +150,27 @@ func NewPackage(ctx context.Context, logger logr.Logger, store store.Store, shar
 //
 //  5. Adjust permissions?
 //     result.add_done_callback(lambda f: os.chmod(tmpdir, 0o770))
+//
+// Steps 2 to 5 are part of the copy stage, see Controller.copyPackage.
 func NewTransferPackage(
 	ctx context.Context,
 	logger logr.Logger,
 	store store.Store,
 	sharedDir string,
 	req *adminv1.CreatePackageRequest,
-	queue func(pkg *Package),
 ) (*Package, error) {
+	var digest string
+	if req.IdempotencyKey != "" {
+		var err error
+		if digest, err = requestDigest(req); err != nil {
+			return nil, err
+		}
+	}
+
 	pkg := newPackage(logger, store, sharedDir)
 	pkg.id = uuid.New()
 	pkg.unit = &Transfer{pkg: pkg}
+	pkg.priority = normalizePriority(req.Priority)
 
 	transferType := Transfers.WithType(req.Type)
 	pkg.startAtChainID = transferType.BypassChainID
@@ -140,52 +193,19 @@ func NewTransferPackage(
 		return nil, err
 	}
 
-	if err := pkg.updateActiveAgent(ctx); err != nil {
-		return nil, err
-	}
-
-	// TODO: copy of transfer needs to happen asynchronously because we can't
-	// block the user request. This should however be done within workflow for
-	// better tracking.
-	//
-	// Perhaps for now we can have a pool to cap the total amount of work that
-	// we are willing to do at any given time, i.e. to be nice with Storage.
-	go func() (err error) {
-		logger := logger
-
-		defer func() {
-			if err != nil {
-				logger.Info("Oopsie!", "err", err)
-			} else {
-				logger.Info("Done!")
-			}
-		}()
-
-		// Create temporary directory.
-		tmpDir, err := os.MkdirTemp(filepath.Join(sharedDir, "tmp"), "")
-		if err != nil {
-			return err
-		}
-		_ = os.Chmod(tmpDir, os.FileMode(0o770))
-		logger = logger.WithValues("tmpDir", tmpDir)
-
-		// Copy into the processing directory.
-		path, err := copyTransfer(sharedDir, tmpDir, req.Name, req.Path[0])
-		if err != nil {
-			return fmt.Errorf("copy transfer: %v", err)
+	if req.IdempotencyKey != "" {
+		if err := pkg.saveValue(ctx, "idempotencyDigest", digest); err != nil {
+			return nil, err
 		}
-		pkg.UpdatePath(path)
-		logger = logger.WithValues("path", path)
-		if err := store.UpdateTransferLocation(ctx, pkg.id, path); err != nil {
-			logger.Info("Unable to update the transfer location.", "id", pkg.id, "path", path, "err", err)
+		// The key is saved last so it is never found without the digest.
+		if err := pkg.saveValue(ctx, "idempotencyKey", req.IdempotencyKey); err != nil {
+			return nil, err
 		}
+	}
 
-		logger.V(2).Info("Transfer ready for processing.")
-
-		queue(pkg) // Start work.
-
-		return nil
-	}() // nolint:errcheck
+	if err := pkg.updateActiveAgent(ctx); err != nil {
+		return nil, err
+	}
 
 	return pkg, nil
 }
@@ -211,8 +231,14 @@ func (p *Package) PathForDB() string {
 
 // Name returns the package name derived from its dirname.
 func (p *Package) Name() string {
-	name := filepath.Base(filepath.Clean(p.Path()))
-	return strings.Replace(name, "-"+p.id.String(), "", 1)
+	return nameFromPath(p.id, p.Path())
+}
+
+// nameFromPath returns the name of the package with the given identifier
+// derived from the dirname of its path.
+func nameFromPath(id uuid.UUID, path string) string {
+	name := filepath.Base(filepath.Clean(path))
+	return strings.Replace(name, "-"+id.String(), "", 1)
 }
 
 // String implements fmt.Stringer.
@@ -220,23 +246,40 @@ func (p *Package) String() string {
 	return p.Name()
 }
 
+// processingConfigNames is the list of names of the processing configuration
+// file in the package, in order of precedence. The JSON and YAML formats take
+// precedence because processingMCP.xml may have been copied from the shared
+// directory by the workflow.
+var processingConfigNames = []string{
+	"processingMCP.json",
+	"processingMCP.yaml",
+	"processingMCP.yml",
+	"processingMCP.xml",
+}
+
 // parseProcessingConfig returns a list of preconfigured choices. A missing
 // configuration file is a non-error, i.e. returns an empty slice of choices.
 func (p *Package) parseProcessingConfig() ([]workflow.Choice, error) {
-	f, err := os.Open(filepath.Join(p.path, "processingMCP.xml"))
-	if err != nil {
-		if os.IsNotExist(err) {
-			return nil, nil
+	for _, name := range processingConfigNames {
+		path := filepath.Join(p.path, name)
+		f, err := os.Open(path)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, err
 		}
-		return nil, err
-	}
 
-	choices, err := workflow.ParseConfig(f)
-	if err != nil {
-		return nil, fmt.Errorf("parse: %v", err)
+		choices, err := workflow.ParseConfigFormat(f, workflow.ConfigFormatFromPath(path))
+		_ = f.Close()
+		if err != nil {
+			return nil, fmt.Errorf("parse %s: %v", name, err)
+		}
+
+		return choices, nil
 	}
 
-	return choices, nil
+	return nil, nil
 }
 
 // PreconfiguredChoice looks up a pre-configured choice in the processing
@@ -260,67 +303,115 @@ func (p *Package) PreconfiguredChoice(linkID uuid.UUID) (string, error) {
 	return ret, nil
 }
 
+// walkBatchSize is the number of files found by the walk in Package.Files that
+// are checked against the database at once.
+const walkBatchSize = 250
+
 // Files iterates over all files associated with the package or that should be
 // associated with a package, i.e. it first yields files based on database
 // records verified to exist on the filesystem, then yields additional files
 // found through filesystem traversal that meet specified filters.
 //
+// The files found by the walk are checked against the database in batches of
+// walkBatchSize to skip those already yielded, so the memory used does not
+// grow with the size of the package.
+//
 // Parameters:
 //   - filterFilenameEnd: the function filters files whose names end with
 //     the specified suffix.
 //   - filterSubdir: the function limits the search to files within
 //     the specified subdirectory.
-func (p *Package) Files(ctx context.Context, filterFilenameEnd, filterSubdir string) ([]replacementMapping, error) {
-	files, err := p.store.Files(ctx, p.id, p.packageType(), filterFilenameEnd, filterSubdir, p.replacementPath())
-	if err != nil {
-		return nil, err
-	}
-	ret := make([]replacementMapping, 0, len(files))
-	seen := make(map[string]struct{}, len(files))
-
-	for _, f := range files {
-		mapping := fileReplacements(p, &f)
-		inputFile, ok := mapping["%inputFile%"]
-		if !ok {
-			continue
-		}
-		if _, err := os.Stat(string(inputFile)); errors.Is(err, os.ErrNotExist) {
-			continue
+func (p *Package) Files(ctx context.Context, filterFilenameEnd, filterSubdir string) iter.Seq2[replacementMapping, error] {
+	return func(yield func(replacementMapping, error) bool) {
+		for f, err := range p.store.Files(ctx, p.id, p.packageType(), filterFilenameEnd, filterSubdir, p.replacementPath()) {
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			mapping := fileReplacements(p, &f)
+			inputFile, ok := mapping["%inputFile%"]
+			if !ok {
+				continue
+			}
+			if _, err := os.Stat(string(inputFile)); errors.Is(err, os.ErrNotExist) {
+				continue
+			}
+			if !yield(mapping, nil) {
+				return
+			}
 		}
-		seen[string(inputFile)] = struct{}{}
-		ret = append(ret, mapping)
-	}
 
-	startPath := p.Path()
-	if filterSubdir != "" {
-		startPath += filterSubdir
-	}
-	err = filepath.WalkDir(startPath, func(path string, d fs.DirEntry, err error) error {
-		if err != nil {
-			return err
+		root := joinPath(p.Path(), "")
+		batch := make([]string, 0, walkBatchSize)
+
+		// flush yields the files of the batch that were not yielded from
+		// the database. It reports whether the iteration continues.
+		flush := func() (bool, error) {
+			if len(batch) == 0 {
+				return true, nil
+			}
+			defer func() { batch = batch[:0] }()
+
+			locations := make([]string, len(batch))
+			for i, path := range batch {
+				locations[i] = p.replacementPath() + strings.TrimPrefix(path, root)
+			}
+			known, err := p.store.KnownFileLocations(ctx, p.id, p.packageType(), locations)
+			if err != nil {
+				return false, fmt.Errorf("read known files: %v", err)
+			}
+
+			for i, path := range batch {
+				// Same filter as the database query, see Store.Files.
+				if _, ok := known[locations[i]]; ok && strings.HasSuffix(locations[i], filterFilenameEnd) {
+					continue
+				}
+				if !yield(map[string]replacement{
+					"%relativeLocation": replacement(path),
+					"%fileUUID%":        replacement("None"),
+					"%fileGrpUse%":      replacement(""),
+				}, nil) {
+					return false, nil
+				}
+			}
+
+			return true, nil
 		}
-		if d.IsDir() {
-			return nil
+
+		startPath := p.Path()
+		if filterSubdir != "" {
+			startPath += filterSubdir
 		}
-		fname := d.Name()
-		if filterFilenameEnd != "" && !strings.HasPrefix(fname, filterFilenameEnd) {
+		var stopped bool
+		err := filepath.WalkDir(startPath, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if d.IsDir() {
+				return nil
+			}
+			fname := d.Name()
+			if filterFilenameEnd != "" && !strings.HasPrefix(fname, filterFilenameEnd) {
+				return nil
+			}
+			if batch = append(batch, path); len(batch) < walkBatchSize {
+				return nil
+			}
+			if ok, err := flush(); err != nil {
+				return err
+			} else if !ok {
+				stopped = true
+				return filepath.SkipAll
+			}
 			return nil
+		})
+		if err == nil && !stopped {
+			_, err = flush()
 		}
-		if _, ok := seen[path]; ok {
-			return nil
+		if err != nil {
+			yield(nil, fmt.Errorf("walk dir: %v", err))
 		}
-		ret = append(ret, map[string]replacement{
-			"%relativeLocation": replacement(path),
-			"%fileUUID%":        replacement("None"),
-			"%fileGrpUse%":      replacement(""),
-		})
-		return nil
-	})
-	if err != nil {
-		return nil, fmt.Errorf("walk dir: %v", err)
 	}
-
-	return ret, nil
 }
 
 func (p *Package) replacements() replacementMapping {
@@ -341,23 +432,23 @@ func (p *Package) saveValue(ctx context.Context, name, value string) error {
 }
 
 // saveLinkID persist "linkID" as a package variable.
-func (p *Package) saveLinkID(ctx context.Context, name string, linkID uuid.UUID) error {
-	if err := p.store.CreateUnitVar(ctx, p.id, p.packageType(), name, "", linkID, true); err != nil {
+func (p *Package) saveLinkID(ctx context.Context, tx store.Tx, name string, linkID uuid.UUID) error {
+	if err := tx.CreateUnitVar(ctx, p.id, p.packageType(), name, "", linkID, true); err != nil {
 		return fmt.Errorf("save linkID: %v", err)
 	}
 	return nil
 }
 
-func (p *Package) markAsProcessing(ctx context.Context) error {
-	return p.store.UpdatePackageStatus(ctx, p.id, p.packageType(), enums.PackageStatusProcessing)
+func (p *Package) markAsProcessing(ctx context.Context, tx store.Tx) error {
+	return tx.UpdatePackageStatus(ctx, p.id, p.packageType(), enums.PackageStatusProcessing)
 }
 
-func (p *Package) markAsDone(ctx context.Context) error {
-	return p.store.UpdatePackageStatus(ctx, p.id, p.packageType(), enums.PackageStatusDone)
+func (p *Package) markAsDone(ctx context.Context, tx store.Tx) error {
+	return tx.UpdatePackageStatus(ctx, p.id, p.packageType(), enums.PackageStatusDone)
 }
 
-func (p *Package) markAsFailed(ctx context.Context) error {
-	return p.store.UpdatePackageStatus(ctx, p.id, p.packageType(), enums.PackageStatusFailed)
+func (p *Package) markAsFailed(ctx context.Context, tx store.Tx) error {
+	return tx.UpdatePackageStatus(ctx, p.id, p.packageType(), enums.PackageStatusFailed)
 }
 
 // updateActiveAgent saves the activeAgent variable using the user information
@@ -752,15 +843,74 @@ func (rm replacementMapping) replaceValues(input string) string {
 	return input
 }
 
-// TODO: stop assuming we have a dir.
-func copyTransfer(sharedDir, tmpDir, name, path string) (string, error) {
-	base := filepath.Base(path)
-	dest := filepath.Join(tmpDir, base)
+// copyTransfer copies the transfer sources into the processing directory and
+// returns the final path of the transfer. The processing configuration is
+// written into the transfer unless config is nil.
+//
+// Like in the Archivematica Dashboard, a single directory source is copied as
+// the transfer, i.e. its contents become the contents of the transfer, and a
+// single archive source is copied as is. Otherwise, every source is copied
+// into a new directory. Sources sharing the same name are given a unique name.
+//
+// The progress of the copy is recorded in progress.
+func copyTransfer(ctx context.Context, sharedDir, tmpDir, name string, paths []string, config []workflow.Choice, progress *copyProgress) (string, error) {
+	if len(paths) == 0 {
+		return "", errors.New("no sources")
+	}
 
-	if err := copy.Copy(path, dest, copy.Options{
-		Sync: true,
-	}); err != nil {
-		return "", err
+	// Single archive, e.g. zipped bag.
+	if len(paths) == 1 && isArchive(paths[0]) {
+		path := paths[0]
+		if config != nil {
+			return "", errors.New("processing configuration cannot be written into an archive")
+		}
+
+		base := filepath.Base(path)
+		dest := filepath.Join(tmpDir, base)
+		if err := copyTree(ctx, path, dest, progress); err != nil {
+			return "", err
+		}
+
+		return move(
+			dest,
+			filepath.Join(sharedDir, "currentlyProcessing", base),
+		)
+	}
+
+	var dest string
+	if len(paths) == 1 && isDir(paths[0]) {
+		dest = filepath.Join(tmpDir, filepath.Base(paths[0]))
+		if err := copyTree(ctx, paths[0], dest, progress); err != nil {
+			return "", err
+		}
+	} else {
+		dest = filepath.Join(tmpDir, name)
+		if err := os.Mkdir(dest, os.FileMode(0o770)); err != nil {
+			return "", err
+		}
+		names := map[string]struct{}{}
+		for _, path := range paths {
+			if _, err := os.Stat(path); err != nil {
+				return "", err
+			}
+			base := uniqueName(names, filepath.Base(filepath.Clean(path)))
+			if err := copyTree(ctx, path, filepath.Join(dest, base), progress); err != nil {
+				return "", err
+			}
+		}
+	}
+
+	if config != nil {
+		// The override replaces the configuration found in the transfer in any
+		// format, otherwise a JSON or YAML file would take precedence over it.
+		for _, name := range processingConfigNames {
+			if err := os.Remove(filepath.Join(dest, name)); err != nil && !os.IsNotExist(err) {
+				return "", fmt.Errorf("remove processing configuration: %v", err)
+			}
+		}
+		if err := writeProcessingConfig(filepath.Join(dest, "processingMCP.xml"), config); err != nil {
+			return "", fmt.Errorf("write processing configuration: %v", err)
+		}
 	}
 
 	return move(
@@ -769,6 +919,25 @@ func copyTransfer(sharedDir, tmpDir, name, path string) (string, error) {
 	)
 }
 
+// uniqueName returns name, or name with a numeric suffix added before its
+// extension when it is already in the set of names, e.g. "image-1.jpg". The
+// name returned is added to the set.
+func uniqueName(names map[string]struct{}, name string) string {
+	ext := filepath.Ext(name)
+	stem := strings.TrimSuffix(name, ext)
+
+	ret := name
+	for i := 1; ; i++ {
+		if _, ok := names[ret]; !ok {
+			break
+		}
+		ret = fmt.Sprintf("%s-%d%s", stem, i, ext)
+	}
+	names[ret] = struct{}{}
+
+	return ret
+}
+
 // move a directory.
 func move(src, dst string) (_ string, err error) {
 	defer derrors.Add(&err, "move(%s, %s)", src, dst)
@@ -1,9 +1,23 @@
 package controller
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
 	"testing"
 
+	"github.com/go-logr/logr"
+	"github.com/google/uuid"
+	"go.artefactual.dev/tools/mockutil"
+	"go.uber.org/mock/gomock"
 	"gotest.tools/v3/assert"
+	"gotest.tools/v3/fs"
+
+	"github.com/artefactual-labs/ccp/internal/store"
+	"github.com/artefactual-labs/ccp/internal/store/enums"
+	"github.com/artefactual-labs/ccp/internal/store/storemock"
+	"github.com/artefactual-labs/ccp/internal/workflow"
 )
 
 func TestReplacements(t *testing.T) {
@@ -28,6 +42,340 @@ func TestReplacements(t *testing.T) {
 	})
 }
 
+func TestPackageFiles(t *testing.T) {
+	t.Parallel()
+
+	// Streams the files of the store that exist in the filesystem, followed by
+	// the files that are only found in the filesystem.
+	setup := func(t *testing.T) (*Package, []store.File) {
+		t.Helper()
+
+		tmpDir := fs.NewDir(t, "ccp",
+			fs.WithDir("pkg",
+				fs.WithDir("objects",
+					fs.WithFile("a.txt", ""),
+					fs.WithFile("b.txt", ""),
+					fs.WithFile("c.txt", ""),
+				),
+			),
+		)
+
+		files := []store.File{
+			{ID: uuid.New(), CurrentLocation: "%transferDirectory%objects/a.txt"},
+			{ID: uuid.New(), CurrentLocation: "%transferDirectory%objects/deleted.txt"},
+			{ID: uuid.New(), CurrentLocation: "%transferDirectory%objects/b.txt"},
+		}
+
+		mockStore := storemock.NewMockStore(gomock.NewController(t))
+		mockStore.EXPECT().Files(mockutil.Context(), gomock.Any(), enums.PackageTypeTransfer, "", "objects/", "").Return(
+			func(yield func(store.File, error) bool) {
+				for _, f := range files {
+					if !yield(f, nil) {
+						return
+					}
+				}
+			},
+		)
+		mockStore.EXPECT().KnownFileLocations(mockutil.Context(), gomock.Any(), enums.PackageTypeTransfer, []string{"objects/a.txt", "objects/b.txt", "objects/c.txt"}).Return(
+			map[string]struct{}{"objects/a.txt": {}, "objects/b.txt": {}}, nil,
+		).MaxTimes(1)
+
+		pkg := newPackage(logr.Discard(), mockStore, tmpDir.Path())
+		pkg.id = uuid.New()
+		pkg.unit = &noUnit{}
+		pkg.path = tmpDir.Join("pkg") + "/"
+
+		return pkg, files
+	}
+
+	t.Run("Yields the files of the store and the filesystem", func(t *testing.T) {
+		t.Parallel()
+
+		pkg, files := setup(t)
+
+		var got []replacementMapping
+		for rm, err := range pkg.Files(context.Background(), "", "objects/") {
+			assert.NilError(t, err)
+			got = append(got, rm)
+		}
+
+		assert.Equal(t, len(got), 3)
+		assert.Equal(t, got[0]["%fileUUID%"], replacement(files[0].ID.String()))
+		assert.Equal(t, got[0]["%inputFile%"], replacement(pkg.Path()+"objects/a.txt"))
+		assert.Equal(t, got[1]["%fileUUID%"], replacement(files[2].ID.String()))
+		assert.Equal(t, got[2]["%fileUUID%"], replacement("None"))
+		assert.Equal(t, got[2]["%relativeLocation"], replacement(pkg.Path()+"objects/c.txt"))
+	})
+
+	t.Run("Stops when the caller stops", func(t *testing.T) {
+		t.Parallel()
+
+		pkg, _ := setup(t)
+
+		var n int
+		for _, err := range pkg.Files(context.Background(), "", "objects/") {
+			assert.NilError(t, err)
+			n++
+			break
+		}
+		assert.Equal(t, n, 1)
+	})
+
+	t.Run("Checks the files of the filesystem in batches", func(t *testing.T) {
+		t.Parallel()
+
+		ops := make([]fs.PathOp, 0, walkBatchSize+1)
+		for i := range walkBatchSize + 1 {
+			ops = append(ops, fs.WithFile(fmt.Sprintf("%03d.txt", i), ""))
+		}
+		tmpDir := fs.NewDir(t, "ccp", ops...)
+
+		mockStore := storemock.NewMockStore(gomock.NewController(t))
+		mockStore.EXPECT().Files(mockutil.Context(), gomock.Any(), enums.PackageTypeTransfer, "", "", "").Return(
+			func(yield func(store.File, error) bool) {},
+		)
+		mockStore.EXPECT().KnownFileLocations(mockutil.Context(), gomock.Any(), enums.PackageTypeTransfer, gomock.Len(walkBatchSize)).Return(
+			map[string]struct{}{"000.txt": {}}, nil,
+		)
+		mockStore.EXPECT().KnownFileLocations(mockutil.Context(), gomock.Any(), enums.PackageTypeTransfer, []string{fmt.Sprintf("%03d.txt", walkBatchSize)}).Return(
+			map[string]struct{}{}, nil,
+		)
+
+		pkg := newPackage(logr.Discard(), mockStore, tmpDir.Path())
+		pkg.unit = &noUnit{}
+		pkg.path = tmpDir.Path() + "/"
+
+		var n int
+		for _, err := range pkg.Files(context.Background(), "", "") {
+			assert.NilError(t, err)
+			n++
+		}
+		assert.Equal(t, n, walkBatchSize)
+	})
+
+	t.Run("Stops after an error", func(t *testing.T) {
+		t.Parallel()
+
+		mockStore := storemock.NewMockStore(gomock.NewController(t))
+		mockStore.EXPECT().Files(mockutil.Context(), gomock.Any(), enums.PackageTypeTransfer, "", "", "").Return(
+			func(yield func(store.File, error) bool) {
+				yield(store.File{}, errors.New("connection lost"))
+			},
+		)
+		pkg := newPackage(logr.Discard(), mockStore, t.TempDir())
+		pkg.unit = &noUnit{}
+
+		var errs []error
+		for _, err := range pkg.Files(context.Background(), "", "") {
+			errs = append(errs, err)
+		}
+		assert.Equal(t, len(errs), 1)
+		assert.Error(t, errs[0], "connection lost")
+	})
+}
+
+func TestParseProcessingConfig(t *testing.T) {
+	t.Parallel()
+
+	xml := `<processingMCP>
+  <preconfiguredChoices>
+    <preconfiguredChoice>
+      <appliesTo>5e58066d-e113-4383-b20b-f301ed4d751c</appliesTo>
+      <goToChain>4500f34e-f004-4ccf-8720-5c38d0be2254</goToChain>
+    </preconfiguredChoice>
+  </preconfiguredChoices>
+</processingMCP>`
+
+	t.Run("Parses the XML format", func(t *testing.T) {
+		t.Parallel()
+
+		dir := fs.NewDir(t, "", fs.WithFile("processingMCP.xml", xml))
+		pkg := newPackage(logr.Discard(), nil, "")
+		pkg.path = dir.Path()
+
+		choices, err := pkg.parseProcessingConfig()
+		assert.NilError(t, err)
+		assert.Equal(t, len(choices), 1)
+		assert.Equal(t, choices[0].GoToChain, "4500f34e-f004-4ccf-8720-5c38d0be2254")
+	})
+
+	t.Run("Prefers the JSON and YAML formats", func(t *testing.T) {
+		t.Parallel()
+
+		dir := fs.NewDir(t, "",
+			fs.WithFile("processingMCP.xml", xml),
+			fs.WithFile("processingMCP.yaml", "store_dip: 8d29eb3d-a8a8-4347-806e-3d8227ed44a1\n"),
+		)
+		pkg := newPackage(logr.Discard(), nil, "")
+		pkg.path = dir.Path()
+
+		choice, err := pkg.PreconfiguredChoice(uuid.MustParse("5e58066d-e113-4383-b20b-f301ed4d751c"))
+		assert.NilError(t, err)
+		assert.Equal(t, choice, "8d29eb3d-a8a8-4347-806e-3d8227ed44a1")
+	})
+
+	t.Run("Ignores a missing configuration", func(t *testing.T) {
+		t.Parallel()
+
+		pkg := newPackage(logr.Discard(), nil, "")
+		pkg.path = fs.NewDir(t, "").Path()
+
+		choices, err := pkg.parseProcessingConfig()
+		assert.NilError(t, err)
+		assert.Equal(t, len(choices), 0)
+	})
+
+	t.Run("Reports invalid configurations", func(t *testing.T) {
+		t.Parallel()
+
+		dir := fs.NewDir(t, "", fs.WithFile("processingMCP.json", `{"store": "yes"}`))
+		pkg := newPackage(logr.Discard(), nil, "")
+		pkg.path = dir.Path()
+
+		_, err := pkg.parseProcessingConfig()
+		assert.Error(t, err, `parse processingMCP.json: unknown field "store"`)
+	})
+}
+
 func TestCopyTransfer(t *testing.T) {
 	t.Parallel()
+
+	t.Run("Writes the processing configuration", func(t *testing.T) {
+		t.Parallel()
+
+		src := fs.NewDir(t, "", fs.WithDir("Images", fs.WithFile("image.jpg", "")))
+		sharedDir := fs.NewDir(t, "", fs.WithDir("currentlyProcessing"), fs.WithDir("tmp"))
+
+		path, err := copyTransfer(context.Background(), sharedDir.Path(), sharedDir.Join("tmp"), "Images", []string{src.Join("Images")}, []workflow.Choice{
+			{
+				Comment:   "Store DIP",
+				AppliesTo: "5e58066d-e113-4383-b20b-f301ed4d751c",
+				GoToChain: "8d29eb3d-a8a8-4347-806e-3d8227ed44a1",
+			},
+		}, &copyProgress{})
+		assert.NilError(t, err)
+		assert.Equal(t, path, sharedDir.Join("currentlyProcessing", "Images"))
+		assert.Assert(t, fs.Equal(path, fs.Expected(t,
+			fs.MatchAnyFileMode,
+			fs.WithFile("image.jpg", "", fs.MatchAnyFileMode),
+			fs.WithFile("processingMCP.xml", `<processingMCP>
+  <preconfiguredChoices>
+    <!-- Store DIP -->
+    <preconfiguredChoice>
+      <appliesTo>5e58066d-e113-4383-b20b-f301ed4d751c</appliesTo>
+      <goToChain>8d29eb3d-a8a8-4347-806e-3d8227ed44a1</goToChain>
+    </preconfiguredChoice>
+  </preconfiguredChoices>
+</processingMCP>`, fs.MatchAnyFileMode),
+		)))
+	})
+
+	t.Run("Replaces the processing configuration of the transfer", func(t *testing.T) {
+		t.Parallel()
+
+		src := fs.NewDir(t, "", fs.WithDir("Images",
+			fs.WithFile("image.jpg", ""),
+			fs.WithFile("processingMCP.json", `{"preconfiguredChoices": []}`),
+			fs.WithFile("processingMCP.yaml", "preconfiguredChoices: []"),
+			fs.WithFile("processingMCP.xml", "<processingMCP/>"),
+		))
+		sharedDir := fs.NewDir(t, "", fs.WithDir("currentlyProcessing"), fs.WithDir("tmp"))
+
+		path, err := copyTransfer(context.Background(), sharedDir.Path(), sharedDir.Join("tmp"), "Images", []string{src.Join("Images")}, []workflow.Choice{
+			{
+				AppliesTo: "5e58066d-e113-4383-b20b-f301ed4d751c",
+				GoToChain: "8d29eb3d-a8a8-4347-806e-3d8227ed44a1",
+			},
+		}, &copyProgress{})
+		assert.NilError(t, err)
+		assert.Assert(t, fs.Equal(path, fs.Expected(t,
+			fs.MatchAnyFileMode,
+			fs.WithFile("image.jpg", "", fs.MatchAnyFileMode),
+			fs.WithFile("processingMCP.xml", "", fs.MatchAnyFileMode, fs.MatchAnyFileContent),
+		)))
+
+		pkg := newPackage(logr.Discard(), nil, sharedDir.Path())
+		pkg.path = path
+		choices, err := pkg.parseProcessingConfig()
+		assert.NilError(t, err)
+		assert.Equal(t, len(choices), 1)
+		assert.Equal(t, choices[0].GoToChain, "8d29eb3d-a8a8-4347-806e-3d8227ed44a1")
+	})
+
+	t.Run("Copies every source", func(t *testing.T) {
+		t.Parallel()
+
+		src := fs.NewDir(t, "",
+			fs.WithDir("a", fs.WithDir("Images", fs.WithFile("image.jpg", "a"))),
+			fs.WithDir("b", fs.WithDir("Images", fs.WithFile("image.jpg", "b"))),
+			fs.WithDir("c", fs.WithFile("image.jpg", "c")),
+			fs.WithDir("d", fs.WithFile("image.jpg", "d")),
+			fs.WithFile("bag.zip", "zip"),
+		)
+		sharedDir := fs.NewDir(t, "", fs.WithDir("currentlyProcessing"), fs.WithDir("tmp"))
+
+		path, err := copyTransfer(context.Background(), sharedDir.Path(), sharedDir.Join("tmp"), "Transfer", []string{
+			src.Join("a", "Images") + "/",
+			src.Join("b", "Images"),
+			src.Join("c", "image.jpg"),
+			src.Join("d", "image.jpg"),
+			src.Join("bag.zip"),
+		}, nil, &copyProgress{})
+		assert.NilError(t, err)
+		assert.Equal(t, path, sharedDir.Join("currentlyProcessing", "Transfer"))
+		assert.Assert(t, fs.Equal(path, fs.Expected(t,
+			fs.MatchAnyFileMode,
+			fs.WithDir("Images", fs.MatchAnyFileMode, fs.WithFile("image.jpg", "a", fs.MatchAnyFileMode)),
+			fs.WithDir("Images-1", fs.MatchAnyFileMode, fs.WithFile("image.jpg", "b", fs.MatchAnyFileMode)),
+			fs.WithFile("image.jpg", "c", fs.MatchAnyFileMode),
+			fs.WithFile("image-1.jpg", "d", fs.MatchAnyFileMode),
+			fs.WithFile("bag.zip", "zip", fs.MatchAnyFileMode),
+		)))
+	})
+
+	t.Run("Copies a single file into a directory", func(t *testing.T) {
+		t.Parallel()
+
+		src := fs.NewDir(t, "", fs.WithFile("image.jpg", "jpg"))
+		sharedDir := fs.NewDir(t, "", fs.WithDir("currentlyProcessing"), fs.WithDir("tmp"))
+
+		path, err := copyTransfer(context.Background(), sharedDir.Path(), sharedDir.Join("tmp"), "Image", []string{src.Join("image.jpg")}, nil, &copyProgress{})
+		assert.NilError(t, err)
+		assert.Equal(t, path, sharedDir.Join("currentlyProcessing", "Image"))
+		assert.Assert(t, fs.Equal(path, fs.Expected(t,
+			fs.MatchAnyFileMode,
+			fs.WithFile("image.jpg", "jpg", fs.MatchAnyFileMode),
+		)))
+	})
+
+	t.Run("Copies a single archive as is", func(t *testing.T) {
+		t.Parallel()
+
+		src := fs.NewDir(t, "", fs.WithFile("Bag.ZIP", "zip"))
+		sharedDir := fs.NewDir(t, "", fs.WithDir("currentlyProcessing"), fs.WithDir("tmp"))
+
+		path, err := copyTransfer(context.Background(), sharedDir.Path(), sharedDir.Join("tmp"), "Bag", []string{src.Join("Bag.ZIP")}, nil, &copyProgress{})
+		assert.NilError(t, err)
+		assert.Equal(t, path, sharedDir.Join("currentlyProcessing", "Bag.ZIP"))
+		assert.Assert(t, fs.Equal(sharedDir.Join("currentlyProcessing"), fs.Expected(t,
+			fs.MatchAnyFileMode,
+			fs.WithFile("Bag.ZIP", "zip", fs.MatchAnyFileMode),
+		)))
+
+		_, err = copyTransfer(context.Background(), sharedDir.Path(), sharedDir.Join("tmp"), "Bag", []string{src.Join("Bag.ZIP")}, []workflow.Choice{}, &copyProgress{})
+		assert.Error(t, err, "processing configuration cannot be written into an archive")
+	})
+
+	t.Run("Reports missing sources", func(t *testing.T) {
+		t.Parallel()
+
+		src := fs.NewDir(t, "", fs.WithFile("image.jpg", ""))
+		sharedDir := fs.NewDir(t, "", fs.WithDir("currentlyProcessing"), fs.WithDir("tmp"))
+
+		_, err := copyTransfer(context.Background(), sharedDir.Path(), sharedDir.Join("tmp"), "Transfer", []string{
+			src.Join("image.jpg"),
+			src.Join("missing.jpg"),
+		}, nil, &copyProgress{})
+		assert.ErrorIs(t, err, os.ErrNotExist)
+	})
 }
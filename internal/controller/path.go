@@ -57,7 +57,20 @@ func locationPath(locationPath string) (id uuid.UUID, path string) {
 	return id, path
 }
 
-func isDir(path string) bool { //nolint: unused
+// isArchive reports whether the path has the extension of one of the archive
+// formats that can be submitted as a transfer.
+func isArchive(path string) bool {
+	path = strings.ToLower(path)
+	for _, ext := range []string{".zip", ".tgz", ".tar.gz"} {
+		if strings.HasSuffix(path, ext) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isDir(path string) bool {
 	info, err := os.Stat(path)
 	if err != nil {
 		return false
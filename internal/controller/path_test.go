@@ -119,3 +119,23 @@ func TestLocationPath(t *testing.T) {
 		assert.Equal(t, path, tc.path)
 	}
 }
+
+func TestIsArchive(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		path string
+		want bool
+	}{
+		{path: "/tmp/bag.zip", want: true},
+		{path: "/tmp/bag.ZIP", want: true},
+		{path: "/tmp/bag.tgz", want: true},
+		{path: "/tmp/bag.tar.gz", want: true},
+		{path: "/tmp/bag.tar", want: false},
+		{path: "/tmp/zip", want: false},
+		{path: "/tmp/bag.zip/", want: false},
+	}
+	for _, tc := range tests {
+		assert.Equal(t, isArchive(tc.path), tc.want, tc.path)
+	}
+}
@@ -0,0 +1,113 @@
+package controller
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/google/uuid"
+
+	adminv1 "github.com/artefactual-labs/ccp/internal/api/gen/archivematica/ccp/admin/v1beta1"
+	"github.com/artefactual-labs/ccp/internal/workflow"
+)
+
+// ErrInvalidProcessingConfig is returned when a package references a
+// processing configuration that is missing or does not validate.
+var ErrInvalidProcessingConfig = errors.New("invalid processing configuration")
+
+// processingConfigPath returns the path to the processing configuration file
+// for the given name, e.g. "default" or "automated".
+func processingConfigPath(sharedDir, name string) string {
+	return filepath.Join(
+		sharedDir,
+		"sharedMicroServiceTasksConfigs/processingMCPConfigs",
+		fmt.Sprintf("%sProcessingMCP.xml", name),
+	)
+}
+
+// resolveProcessingConfig confirms that the processing configuration requested
+// exists in the shared directory and that its choices, merged with the
+// override when present, are valid in the workflow.
+//
+// It returns the merged choices that need to be included in the transfer, or
+// nil when the named configuration is used as is.
+func resolveProcessingConfig(wf *workflow.Document, sharedDir, name string, override *adminv1.ProcessingConfigOverride) ([]workflow.Choice, error) {
+	if name == "" {
+		name = "default"
+	}
+	if filepath.Base(name) != name {
+		return nil, fmt.Errorf("%w: unexpected name %q", ErrInvalidProcessingConfig, name)
+	}
+
+	choices, err := workflow.ParseConfigFile(processingConfigPath(sharedDir, name))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("%w: %q not found", ErrInvalidProcessingConfig, name)
+	} else if err != nil {
+		return nil, fmt.Errorf("%w: %q cannot be parsed: %v", ErrInvalidProcessingConfig, name, err)
+	}
+
+	if err := workflow.ValidateConfig(wf, choices); err != nil {
+		return nil, fmt.Errorf("%w: %q: %v", ErrInvalidProcessingConfig, name, err)
+	}
+
+	if len(override.GetChoice()) == 0 {
+		return nil, nil
+	}
+
+	choices = mergeChoices(wf, choices, override.GetChoice())
+	if err := workflow.ValidateConfig(wf, choices); err != nil {
+		return nil, fmt.Errorf("%w: override of %q: %v", ErrInvalidProcessingConfig, name, err)
+	}
+
+	return choices, nil
+}
+
+// mergeChoices returns a copy of choices where the choices in the override
+// replace those that apply to the same link. Choices that do not apply to any
+// of the existing links are appended.
+func mergeChoices(wf *workflow.Document, choices []workflow.Choice, override []*adminv1.ProcessingConfigChoice) []workflow.Choice {
+	ret := make([]workflow.Choice, len(choices), len(choices)+len(override))
+	copy(ret, choices)
+
+	index := make(map[string]int, len(ret))
+	for i, c := range ret {
+		index[c.AppliesTo] = i
+	}
+
+	for _, item := range override {
+		if i, ok := index[item.AppliesTo]; ok {
+			ret[i].GoToChain = item.GoToChain
+			continue
+		}
+		c := workflow.Choice{
+			AppliesTo: item.AppliesTo,
+			GoToChain: item.GoToChain,
+		}
+		if id, err := uuid.Parse(item.AppliesTo); err == nil {
+			if ln, ok := wf.Links[id]; ok {
+				c.Comment = ln.Description.String()
+			}
+		}
+		index[c.AppliesTo] = len(ret)
+		ret = append(ret, c)
+	}
+
+	return ret
+}
+
+// writeProcessingConfig writes the choices to path using the processingMCP.xml
+// format.
+func writeProcessingConfig(path string, choices []workflow.Choice) error {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o660)
+	if err != nil {
+		return err
+	}
+
+	if err := workflow.WriteConfig(f, choices); err != nil {
+		_ = f.Close()
+		return err
+	}
+
+	return f.Close()
+}
@@ -0,0 +1,113 @@
+package controller
+
+import (
+	"errors"
+	"testing"
+
+	"gotest.tools/v3/assert"
+	"gotest.tools/v3/fs"
+
+	adminv1 "github.com/artefactual-labs/ccp/internal/api/gen/archivematica/ccp/admin/v1beta1"
+	"github.com/artefactual-labs/ccp/internal/workflow"
+)
+
+func TestResolveProcessingConfig(t *testing.T) {
+	t.Parallel()
+
+	wf, err := workflow.Default()
+	assert.NilError(t, err)
+
+	sharedDir := fs.NewDir(t, "",
+		fs.WithDir("sharedMicroServiceTasksConfigs",
+			fs.WithDir("processingMCPConfigs",
+				fs.WithFile("brokenProcessingMCP.xml", `<processingMCP>
+  <preconfiguredChoices>
+    <preconfiguredChoice>
+      <appliesTo>5e58066d-e113-4383-b20b-f301ed4d751c</appliesTo>
+      <goToChain>8d29eb3d-a8a8-4347-806e-3d8227ed44a0</goToChain>
+    </preconfiguredChoice>
+  </preconfiguredChoices>
+</processingMCP>`),
+				fs.WithFile("storeProcessingMCP.xml", `<processingMCP>
+  <preconfiguredChoices>
+    <!-- Store DIP -->
+    <preconfiguredChoice>
+      <appliesTo>5e58066d-e113-4383-b20b-f301ed4d751c</appliesTo>
+      <goToChain>8d29eb3d-a8a8-4347-806e-3d8227ed44a1</goToChain>
+    </preconfiguredChoice>
+  </preconfiguredChoices>
+</processingMCP>`),
+			),
+		),
+	)
+	err = workflow.InstallBuiltinConfigs(sharedDir.Join("sharedMicroServiceTasksConfigs", "processingMCPConfigs"))
+	assert.NilError(t, err)
+
+	t.Run("Accepts built-in configurations", func(t *testing.T) {
+		t.Parallel()
+
+		for _, name := range []string{"", "default", "automated"} {
+			choices, err := resolveProcessingConfig(wf, sharedDir.Path(), name, nil)
+			assert.NilError(t, err)
+			assert.Assert(t, choices == nil)
+		}
+	})
+
+	t.Run("Rejects missing configurations", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := resolveProcessingConfig(wf, sharedDir.Path(), "unknown", nil)
+		assert.Assert(t, errors.Is(err, ErrInvalidProcessingConfig))
+		assert.Error(t, err, `invalid processing configuration: "unknown" not found`)
+
+		_, err = resolveProcessingConfig(wf, sharedDir.Path(), "../default", nil)
+		assert.Assert(t, errors.Is(err, ErrInvalidProcessingConfig))
+	})
+
+	t.Run("Rejects invalid configurations", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := resolveProcessingConfig(wf, sharedDir.Path(), "broken", nil)
+		assert.Assert(t, errors.Is(err, ErrInvalidProcessingConfig))
+		assert.ErrorContains(t, err, "goToChain is not one of the chain choices of the link")
+	})
+
+	t.Run("Merges the override", func(t *testing.T) {
+		t.Parallel()
+
+		choices, err := resolveProcessingConfig(wf, sharedDir.Path(), "store", &adminv1.ProcessingConfigOverride{
+			Choice: []*adminv1.ProcessingConfigChoice{
+				{
+					AppliesTo: "5e58066d-e113-4383-b20b-f301ed4d751c", // Store DIP.
+					GoToChain: "4500f34e-f004-4ccf-8720-5c38d0be2254",
+				},
+				{
+					AppliesTo: "bd899573-694e-4d33-8c9b-df0af802437d", // Assign UUIDs to directories?
+					GoToChain: "891f60d0-1ba8-48d3-b39e-dd0934635d29",
+				},
+			},
+		})
+		assert.NilError(t, err)
+		assert.Equal(t, len(choices), 2)
+		assert.Equal(t, choices[0].AppliesTo, "5e58066d-e113-4383-b20b-f301ed4d751c")
+		assert.Equal(t, choices[0].GoToChain, "4500f34e-f004-4ccf-8720-5c38d0be2254")
+		assert.Equal(t, choices[1].Comment, "Assign UUIDs to directories?")
+		assert.Equal(t, choices[1].AppliesTo, "bd899573-694e-4d33-8c9b-df0af802437d")
+		assert.Equal(t, choices[1].GoToChain, "891f60d0-1ba8-48d3-b39e-dd0934635d29")
+	})
+
+	t.Run("Rejects invalid overrides", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := resolveProcessingConfig(wf, sharedDir.Path(), "default", &adminv1.ProcessingConfigOverride{
+			Choice: []*adminv1.ProcessingConfigChoice{
+				{
+					AppliesTo: "5e58066d-e113-4383-b20b-f301ed4d751c",
+					GoToChain: "891f60d0-1ba8-48d3-b39e-dd0934635d29",
+				},
+			},
+		})
+		assert.Assert(t, errors.Is(err, ErrInvalidProcessingConfig))
+		assert.ErrorContains(t, err, `override of "default"`)
+	})
+}
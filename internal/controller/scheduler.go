@@ -0,0 +1,362 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"math"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	adminv1 "github.com/artefactual-labs/ccp/internal/api/gen/archivematica/ccp/admin/v1beta1"
+	"github.com/artefactual-labs/ccp/internal/store"
+	"github.com/artefactual-labs/ccp/internal/store/enums"
+)
+
+// priorityWeights are the weights used to share the processing slots between
+// the priority classes. A class with twice the weight of another starts twice
+// as many packages when both have packages waiting.
+var priorityWeights = map[adminv1.PackagePriority]float64{
+	adminv1.PackagePriority_PACKAGE_PRIORITY_LOW:    1,
+	adminv1.PackagePriority_PACKAGE_PRIORITY_NORMAL: 2,
+	adminv1.PackagePriority_PACKAGE_PRIORITY_HIGH:   4,
+	adminv1.PackagePriority_PACKAGE_PRIORITY_URGENT: 8,
+}
+
+// normalizePriority maps unknown priorities to the default priority.
+func normalizePriority(p adminv1.PackagePriority) adminv1.PackagePriority {
+	if _, ok := priorityWeights[p]; !ok {
+		return adminv1.PackagePriority_PACKAGE_PRIORITY_NORMAL
+	}
+	return p
+}
+
+// queueItem is a package waiting in the scheduler.
+type queueItem struct {
+	pkg      *Package
+	queuedAt time.Time
+
+	// tag is the virtual finish time assigned by the scheduler, items are
+	// started in ascending tag order.
+	tag float64
+}
+
+// scheduler orders the queued packages using weighted fair queuing, where each
+// priority class is a flow. Every package is tagged on arrival with a virtual
+// finish time:
+//
+//	tag = max(vclock, last tag of its class) + 1 / weight of its class
+//
+// and packages are started in ascending tag order. A backlog of packages in one
+// class gets increasingly distant tags, so a package submitted later to a class
+// with no backlog is started before most of it, while lower classes still get
+// their share of the slots.
+//
+// Operators can reorder the queue; a moved package gets a tag between those of
+// its new neighbours. The items are kept sorted by tag, with ties kept in the
+// order they were inserted. The scheduler is not safe for concurrent use.
+type scheduler struct {
+	items   []*queueItem
+	vclock  float64
+	lastTag map[adminv1.PackagePriority]float64
+}
+
+func newScheduler() *scheduler {
+	return &scheduler{
+		items:   []*queueItem{},
+		lastTag: map[adminv1.PackagePriority]float64{},
+	}
+}
+
+func (s *scheduler) len() int {
+	return len(s.items)
+}
+
+// push adds a package to the queue.
+func (s *scheduler) push(pkg *Package) *queueItem {
+	priority := normalizePriority(pkg.priority)
+	start := s.vclock
+	if last, ok := s.lastTag[priority]; ok {
+		start = max(start, last)
+	}
+	tag := start + 1/priorityWeights[priority]
+	s.lastTag[priority] = tag
+
+	return s.insert(&queueItem{pkg: pkg, queuedAt: time.Now(), tag: tag})
+}
+
+// restore adds a package with the tag that it was given before a restart. The
+// virtual clock is moved to the head of the queue so packages queued after the
+// restart are scheduled relative to the restored ones.
+func (s *scheduler) restore(pkg *Package, tag float64, queuedAt time.Time) *queueItem {
+	priority := normalizePriority(pkg.priority)
+	if last, ok := s.lastTag[priority]; !ok || tag > last {
+		s.lastTag[priority] = tag
+	}
+
+	item := s.insert(&queueItem{pkg: pkg, queuedAt: queuedAt, tag: tag})
+	s.vclock = s.items[0].tag
+
+	return item
+}
+
+// insert adds the item after those with the same or a lower tag.
+func (s *scheduler) insert(item *queueItem) *queueItem {
+	pos := sort.Search(len(s.items), func(i int) bool {
+		return s.items[i].tag > item.tag
+	})
+	s.items = slices.Insert(s.items, pos, item)
+
+	return item
+}
+
+// next returns the first item in the queue accepted by the eligible function,
+// or nil when there is none.
+func (s *scheduler) next(eligible func(pkg *Package) bool) *queueItem {
+	for _, item := range s.items {
+		if eligible(item.pkg) {
+			return item
+		}
+	}
+	return nil
+}
+
+// pop removes the item from the queue and advances the virtual clock.
+func (s *scheduler) pop(item *queueItem) {
+	s.remove(item.pkg.id)
+	s.vclock = max(s.vclock, item.tag)
+}
+
+// remove removes the package from the queue. It reports whether the package
+// was found.
+func (s *scheduler) remove(id uuid.UUID) bool {
+	pos := s.index(id)
+	if pos < 0 {
+		return false
+	}
+	s.items = slices.Delete(s.items, pos, pos+1)
+
+	return true
+}
+
+// moveTag returns the item of the package and the tag that moves it to the
+// given position, without moving it. Positions beyond the end of the queue
+// move the package to the end. It returns a nil item if the package was not
+// found.
+func (s *scheduler) moveTag(id uuid.UUID, pos int) (*queueItem, float64) {
+	cur := s.index(id)
+	if cur < 0 {
+		return nil, 0
+	}
+
+	item := s.items[cur]
+	others := slices.Delete(slices.Clone(s.items), cur, cur+1)
+	pos = min(max(pos, 0), len(others))
+
+	switch {
+	case len(others) == 0:
+		return item, item.tag
+	case pos == 0:
+		return item, others[0].tag - 1
+	case pos == len(others):
+		return item, others[pos-1].tag + 1
+	default:
+		return item, (others[pos-1].tag + others[pos].tag) / 2
+	}
+}
+
+// retag gives the item a new tag and moves it to the matching position. It
+// reports whether the item was found, e.g. it is not found once it has been
+// started.
+func (s *scheduler) retag(item *queueItem, tag float64) bool {
+	if !s.remove(item.pkg.id) {
+		return false
+	}
+	item.tag = tag
+	s.insert(item)
+
+	return true
+}
+
+// contains reports whether the package is queued.
+func (s *scheduler) contains(id uuid.UUID) bool {
+	return s.index(id) >= 0
+}
+
+func (s *scheduler) index(id uuid.UUID) int {
+	return slices.IndexFunc(s.items, func(item *queueItem) bool {
+		return item.pkg.id == id
+	})
+}
+
+// ErrNotQueued is returned when the package is not in the queue, e.g. it has
+// already started.
+var ErrNotQueued = errors.New("package not queued")
+
+// eligible reports whether the limit of active packages of the same type
+// allows the package to start. It is called with c.mu held.
+func (c *Controller) eligible(pkg *Package) bool {
+	limit := c.config.maxActive(pkg.packageType())
+	if limit <= 0 {
+		return true
+	}
+
+	active := 0
+	for _, item := range c.activePackages {
+		if item.packageType() == pkg.packageType() {
+			active++
+		}
+	}
+
+	return active < limit
+}
+
+// Queue returns the queued packages in the order they are expected to start,
+// and a message explaining why they are waiting.
+func (c *Controller) Queue() ([]*adminv1.QueuedPackage, string) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.listQueue(), c.queueMessage
+}
+
+// ReorderQueue moves the queued package to the given position. The new tag is
+// computed with c.mu held but the queue only changes once the tag has been
+// persisted, which is done without holding c.mu.
+func (c *Controller) ReorderQueue(ctx context.Context, id uuid.UUID, pos int) ([]*adminv1.QueuedPackage, error) {
+	c.queueMu.Lock()
+	defer c.queueMu.Unlock()
+
+	c.mu.Lock()
+	item, tag := c.queued.moveTag(id, pos)
+	c.mu.Unlock()
+	if item == nil {
+		return nil, ErrNotQueued
+	}
+
+	if err := c.store.UpdateQueueEntryTag(ctx, id, tag); err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// The package may have started meanwhile, its entry is deleted once
+	// queueMu is released.
+	c.queued.retag(item, tag)
+
+	return c.listQueue(), nil
+}
+
+// PersistedQueue returns the queued packages as recorded in the persisted
+// queue, in order. It is meant for instances on standby, where the queue of
+// the controller is empty while the leader processes packages.
+func (c *Controller) PersistedQueue(ctx context.Context) ([]*adminv1.QueuedPackage, error) {
+	entries, err := c.store.ListQueueEntries(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make([]*adminv1.QueuedPackage, 0, len(entries))
+	for _, entry := range entries {
+		ret = append(ret, &adminv1.QueuedPackage{
+			Id:       entry.PackageID.String(),
+			Name:     nameFromPath(entry.PackageID, entry.Path),
+			Type:     packageTypeToProto(entry.PackageType),
+			Priority: normalizePriority(adminv1.PackagePriority(entry.Priority)),
+			QueuedAt: timestamppb.New(entry.QueuedAt),
+			Size:     int64(min(entry.Size, math.MaxInt64)), // nolint: gosec
+		})
+	}
+
+	return ret, nil
+}
+
+func (c *Controller) listQueue() []*adminv1.QueuedPackage {
+	ret := make([]*adminv1.QueuedPackage, 0, c.queued.len())
+	for _, item := range c.queued.items {
+		ret = append(ret, &adminv1.QueuedPackage{
+			Id:       item.pkg.id.String(),
+			Name:     item.pkg.Name(),
+			Type:     packageTypeToProto(item.pkg.packageType()),
+			Priority: normalizePriority(item.pkg.priority),
+			QueuedAt: timestamppb.New(item.queuedAt),
+			Size:     int64(min(item.pkg.size, math.MaxInt64)), // nolint: gosec
+		})
+	}
+
+	return ret
+}
+
+// queueEntry returns the entry of the item in the persisted queue. It is
+// called with c.mu held since the tag of the item changes when the queue is
+// reordered.
+func queueEntry(item *queueItem) *store.QueueEntry {
+	pkg := item.pkg
+	entry := &store.QueueEntry{
+		PackageID:   pkg.id,
+		PackageType: pkg.packageType(),
+		Path:        pkg.PathForDB(),
+		Priority:    int32(normalizePriority(pkg.priority)),
+		Size:        pkg.size,
+		Tag:         item.tag,
+		QueuedAt:    item.queuedAt,
+	}
+	if pkg.startAtChainID != uuid.Nil {
+		entry.StartChainID = uuid.NullUUID{UUID: pkg.startAtChainID, Valid: true}
+	}
+	if pkg.startAtLinkID != uuid.Nil {
+		entry.StartLinkID = uuid.NullUUID{UUID: pkg.startAtLinkID, Valid: true}
+	}
+
+	return entry
+}
+
+// restoreQueue loads the persisted queue, in order. Entries of packages that
+// cannot be restored are discarded.
+func (c *Controller) restoreQueue(ctx context.Context) error {
+	entries, err := c.store.ListQueueEntries(ctx)
+	if err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, entry := range entries {
+		logger := c.logger.WithName("package").WithValues("path", entry.Path)
+		pkg, err := restorePackage(logger, c.store, c.sharedDir, entry)
+		if err != nil {
+			c.logger.Error(err, "Discarding queued package.", "package", entry.PackageID)
+			if err := c.store.DeleteQueueEntry(ctx, entry.PackageID); err != nil {
+				return err
+			}
+			continue
+		}
+
+		c.queued.restore(pkg, entry.Tag, entry.QueuedAt)
+		c.metrics.PackageQueueLengthGauge.WithLabelValues(pkg.packageType().String()).Inc()
+	}
+
+	if len(entries) > 0 {
+		c.logger.Info("Restored queued packages.", "count", c.queued.len())
+	}
+
+	return nil
+}
+
+func packageTypeToProto(pt enums.PackageType) adminv1.PackageType {
+	switch pt {
+	case enums.PackageTypeTransfer:
+		return adminv1.PackageType_PACKAGE_TYPE_TRANSFER
+	case enums.PackageTypeSIP:
+		return adminv1.PackageType_PACKAGE_TYPE_SIP
+	case enums.PackageTypeDIP:
+		return adminv1.PackageType_PACKAGE_TYPE_DIP
+	default:
+		return adminv1.PackageType_PACKAGE_TYPE_UNSPECIFIED
+	}
+}
@@ -0,0 +1,324 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/google/uuid"
+	"go.artefactual.dev/tools/mockutil"
+	"go.uber.org/mock/gomock"
+	"gotest.tools/v3/assert"
+
+	adminv1 "github.com/artefactual-labs/ccp/internal/api/gen/archivematica/ccp/admin/v1beta1"
+	"github.com/artefactual-labs/ccp/internal/cmd/servercmd/metrics"
+	"github.com/artefactual-labs/ccp/internal/diskusage"
+	ccpstore "github.com/artefactual-labs/ccp/internal/store"
+	"github.com/artefactual-labs/ccp/internal/store/enums"
+	"github.com/artefactual-labs/ccp/internal/store/storemock"
+)
+
+const (
+	low    = adminv1.PackagePriority_PACKAGE_PRIORITY_LOW
+	normal = adminv1.PackagePriority_PACKAGE_PRIORITY_NORMAL
+	high   = adminv1.PackagePriority_PACKAGE_PRIORITY_HIGH
+	urgent = adminv1.PackagePriority_PACKAGE_PRIORITY_URGENT
+)
+
+func newScheduledPackage(name string, priority adminv1.PackagePriority, newUnit func(*Package) unit) *Package {
+	pkg := newPackage(logr.Discard(), nil, "/var/archivematica/sharedDirectory")
+	pkg.id = uuid.New()
+	pkg.path = "/var/archivematica/sharedDirectory/currentlyProcessing/" + name
+	pkg.priority = priority
+	if newUnit == nil {
+		newUnit = func(pkg *Package) unit { return &Transfer{pkg: pkg} }
+	}
+	pkg.unit = newUnit(pkg)
+
+	return pkg
+}
+
+// drain pops all the items in the scheduler, returning the package names.
+func drain(s *scheduler) []string {
+	names := []string{}
+	for s.len() > 0 {
+		item := s.next(func(*Package) bool { return true })
+		s.pop(item)
+		names = append(names, item.pkg.Name())
+	}
+
+	return names
+}
+
+func TestScheduler(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Keeps FIFO order within the same priority", func(t *testing.T) {
+		t.Parallel()
+
+		s := newScheduler()
+		s.push(newScheduledPackage("a", normal, nil))
+		s.push(newScheduledPackage("b", adminv1.PackagePriority_PACKAGE_PRIORITY_UNSPECIFIED, nil))
+		s.push(newScheduledPackage("c", normal, nil))
+
+		assert.DeepEqual(t, drain(s), []string{"a", "b", "c"})
+	})
+
+	t.Run("Does not let a backlog block urgent packages", func(t *testing.T) {
+		t.Parallel()
+
+		s := newScheduler()
+		for _, name := range []string{"n1", "n2", "n3", "n4", "n5"} {
+			s.push(newScheduledPackage(name, normal, nil))
+		}
+		s.pop(s.next(func(*Package) bool { return true }))
+		s.push(newScheduledPackage("u1", urgent, nil))
+
+		assert.DeepEqual(t, drain(s), []string{"u1", "n2", "n3", "n4", "n5"})
+	})
+
+	t.Run("Shares slots between priorities by weight", func(t *testing.T) {
+		t.Parallel()
+
+		s := newScheduler()
+		for _, name := range []string{"l1", "l2"} {
+			s.push(newScheduledPackage(name, low, nil))
+		}
+		for _, name := range []string{"h1", "h2", "h3", "h4", "h5"} {
+			s.push(newScheduledPackage(name, high, nil))
+		}
+
+		// High has four times the weight of low, ties keep the arrival order.
+		assert.DeepEqual(t, drain(s), []string{"h1", "h2", "h3", "l1", "h4", "h5", "l2"})
+	})
+
+	t.Run("Moves packages", func(t *testing.T) {
+		t.Parallel()
+
+		s := newScheduler()
+		a := s.push(newScheduledPackage("a", normal, nil)).pkg
+		s.push(newScheduledPackage("b", normal, nil))
+		c := s.push(newScheduledPackage("c", normal, nil)).pkg
+		d := s.push(newScheduledPackage("d", normal, nil)).pkg
+
+		move := func(id uuid.UUID, pos int) bool {
+			item, tag := s.moveTag(id, pos)
+			return item != nil && s.retag(item, tag)
+		}
+
+		assert.Assert(t, move(d.id, 0))
+		assert.Assert(t, move(a.id, 2))
+		assert.Assert(t, move(c.id, 100))
+		assert.Assert(t, !move(uuid.New(), 0))
+
+		// Packages queued later keep their place after the moved ones.
+		s.push(newScheduledPackage("e", normal, nil))
+
+		assert.DeepEqual(t, drain(s), []string{"d", "b", "a", "c", "e"})
+	})
+}
+
+func TestControllerScheduling(t *testing.T) {
+	t.Parallel()
+
+	newController := func(t *testing.T, config Config) (*Controller, *storemock.MockStore) {
+		t.Helper()
+
+		store := storemock.NewMockStore(gomock.NewController(t))
+		c := New(logr.Discard(), config, metrics.NewMetrics(nil), store, nil, nil, t.TempDir(), t.TempDir())
+		c.diskUsage = func(string) (diskusage.Usage, error) {
+			return diskusage.Usage{Total: 1 << 40, Free: 1 << 40}, nil
+		}
+		t.Cleanup(func() { c.Close() })
+
+		return c, store
+	}
+
+	t.Run("Honors the limits per package type", func(t *testing.T) {
+		t.Parallel()
+
+		c, _ := newController(t, Config{MaxActivePackages: 3, MaxActiveTransfers: 1})
+		c.activePackages = append(c.activePackages, newScheduledPackage("active", normal, nil))
+
+		transfer := newScheduledPackage("transfer", urgent, nil)
+		sip := newScheduledPackage("sip", low, func(pkg *Package) unit { return &SIP{pkg: pkg} })
+		c.queued.push(transfer)
+		c.queued.push(sip)
+
+		assert.Assert(t, !c.eligible(transfer))
+		assert.Assert(t, c.eligible(sip))
+
+		c.activePackages = append(c.activePackages, sip)
+		c.queued.remove(sip.id)
+		c.pick()
+
+		pkgs, msg := c.Queue()
+		assert.Equal(t, len(pkgs), 1)
+		assert.Equal(t, pkgs[0].Name, "transfer")
+		assert.Equal(t, msg, "Waiting for an active package of the same type to finish.")
+	})
+
+	t.Run("Honors the global limit", func(t *testing.T) {
+		t.Parallel()
+
+		c, _ := newController(t, Config{})
+		c.activePackages = append(c.activePackages,
+			newScheduledPackage("a", normal, nil),
+			newScheduledPackage("b", normal, nil),
+		)
+		c.queued.push(newScheduledPackage("c", normal, nil))
+
+		c.pick()
+
+		pkgs, msg := c.Queue()
+		assert.Equal(t, len(pkgs), 1)
+		assert.Equal(t, msg, "Waiting for one of the 2 active packages to finish.")
+	})
+
+	t.Run("Reorders the queue", func(t *testing.T) {
+		t.Parallel()
+
+		c, store := newController(t, Config{})
+		a := c.queued.push(newScheduledPackage("a", normal, nil))
+		b := newScheduledPackage("b", low, func(pkg *Package) unit { return &DIP{pkg: pkg} })
+		c.queued.push(b)
+
+		store.EXPECT().UpdateQueueEntryTag(mockutil.Context(), b.id, a.tag-1).Return(nil)
+
+		pkgs, err := c.ReorderQueue(context.Background(), b.id, 0)
+		assert.NilError(t, err)
+		assert.Equal(t, len(pkgs), 2)
+		assert.Equal(t, pkgs[0].Id, b.id.String())
+		assert.Equal(t, pkgs[0].Type, adminv1.PackageType_PACKAGE_TYPE_DIP)
+		assert.Equal(t, pkgs[0].Priority, low)
+
+		_, err = c.ReorderQueue(context.Background(), uuid.New(), 0)
+		assert.ErrorIs(t, err, ErrNotQueued)
+	})
+
+	t.Run("Keeps the order when the queue cannot be persisted", func(t *testing.T) {
+		t.Parallel()
+
+		c, store := newController(t, Config{})
+		a := c.queued.push(newScheduledPackage("a", normal, nil))
+		b := c.queued.push(newScheduledPackage("b", normal, nil))
+		tag := b.tag
+
+		store.EXPECT().UpdateQueueEntryTag(mockutil.Context(), b.pkg.id, a.tag-1).Return(errors.New("database is gone"))
+
+		_, err := c.ReorderQueue(context.Background(), b.pkg.id, 0)
+		assert.Error(t, err, "database is gone")
+
+		pkgs, _ := c.Queue()
+		assert.Equal(t, len(pkgs), 2)
+		assert.Equal(t, pkgs[0].Id, a.pkg.id.String())
+		assert.Equal(t, pkgs[1].Id, b.pkg.id.String())
+		assert.Equal(t, b.tag, tag)
+	})
+
+	t.Run("Persists the order without holding the lock", func(t *testing.T) {
+		t.Parallel()
+
+		c, store := newController(t, Config{})
+		a := c.queued.push(newScheduledPackage("a", normal, nil))
+		b := c.queued.push(newScheduledPackage("b", normal, nil))
+
+		// The package starts while its tag is persisted.
+		store.EXPECT().
+			UpdateQueueEntryTag(mockutil.Context(), b.pkg.id, a.tag-1).
+			DoAndReturn(func(ctx context.Context, id uuid.UUID, tag float64) error {
+				pkgs, _ := c.Queue()
+				assert.Equal(t, pkgs[0].Id, a.pkg.id.String())
+
+				c.mu.Lock()
+				c.queued.pop(b)
+				c.mu.Unlock()
+
+				return nil
+			})
+
+		pkgs, err := c.ReorderQueue(context.Background(), b.pkg.id, 0)
+		assert.NilError(t, err)
+		assert.Equal(t, len(pkgs), 1)
+		assert.Equal(t, pkgs[0].Id, a.pkg.id.String())
+	})
+
+	t.Run("Persists queued packages", func(t *testing.T) {
+		t.Parallel()
+
+		c, store := newController(t, Config{})
+		pkg := newPackage(logr.Discard(), nil, c.sharedDir)
+		pkg.id = uuid.New()
+		pkg.unit = &Transfer{pkg: pkg}
+		pkg.priority = high
+		pkg.path = filepath.Join(c.sharedDir, "currentlyProcessing", "a")
+		pkg.startAtChainID = uuid.MustParse("b4567e89-9fea-4256-99f5-a88987026488")
+		assert.NilError(t, os.MkdirAll(pkg.Path(), 0o700))
+		assert.NilError(t, os.WriteFile(filepath.Join(pkg.Path(), "file"), []byte("12345"), 0o600))
+
+		store.EXPECT().
+			CreateQueueEntry(mockutil.Context(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, entry *ccpstore.QueueEntry) error {
+				assert.Equal(t, entry.PackageID, pkg.id)
+				assert.Equal(t, entry.PackageType, enums.PackageTypeTransfer)
+				assert.Equal(t, entry.Path, "%sharedPath%currentlyProcessing/a")
+				assert.Equal(t, entry.StartChainID, uuid.NullUUID{UUID: pkg.startAtChainID, Valid: true})
+				assert.Equal(t, entry.StartLinkID.Valid, false)
+				assert.Equal(t, entry.Priority, int32(high))
+				assert.Equal(t, entry.Size, uint64(5))
+				assert.Equal(t, entry.Tag, 0.25)
+				return nil
+			})
+
+		c.queue(pkg)
+		assert.Equal(t, c.queued.len(), 1)
+	})
+
+	t.Run("Restores the persisted queue in order", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		store := ccpstore.NewMemoryStore(logr.Discard())
+		c := New(logr.Discard(), Config{}, metrics.NewMetrics(nil), store, nil, nil, t.TempDir(), t.TempDir())
+		t.Cleanup(func() { c.Close() })
+
+		sharedDir := c.sharedDir
+		for _, name := range []string{"a", "b"} {
+			assert.NilError(t, os.MkdirAll(filepath.Join(sharedDir, "currentlyProcessing", name), 0o700))
+		}
+
+		a, b, gone := uuid.New(), uuid.New(), uuid.New()
+		for _, entry := range []*ccpstore.QueueEntry{
+			{PackageID: a, PackageType: enums.PackageTypeTransfer, Path: "%sharedPath%currentlyProcessing/a", Tag: 3},
+			{PackageID: gone, PackageType: enums.PackageTypeTransfer, Path: "%sharedPath%currentlyProcessing/gone", Tag: 0.5},
+			{PackageID: b, PackageType: enums.PackageTypeSIP, Path: "%sharedPath%currentlyProcessing/b", Priority: int32(low)},
+		} {
+			assert.NilError(t, store.CreateQueueEntry(ctx, entry))
+		}
+		assert.NilError(t, store.UpdateQueueEntryTag(ctx, b, -1))
+
+		assert.NilError(t, c.restoreQueue(ctx))
+
+		// The entry of the package that no longer exists is discarded.
+		entries, err := store.ListQueueEntries(ctx)
+		assert.NilError(t, err)
+		assert.Equal(t, len(entries), 2)
+		assert.Equal(t, entries[0].PackageID, b)
+		assert.Equal(t, entries[1].PackageID, a)
+
+		pkgs, _ := c.Queue()
+		assert.Equal(t, len(pkgs), 2)
+		assert.Equal(t, pkgs[0].Id, b.String())
+		assert.Equal(t, pkgs[0].Type, adminv1.PackageType_PACKAGE_TYPE_SIP)
+		assert.Equal(t, pkgs[0].Priority, low)
+		assert.Equal(t, pkgs[1].Id, a.String())
+
+		// Packages queued after the restart are scheduled after the head.
+		item := c.queued.push(newScheduledPackage("c", urgent, nil))
+		assert.Equal(t, item.tag, -1+0.125)
+		assert.Equal(t, c.queued.index(item.pkg.id), 1)
+	})
+}
@@ -1,6 +1,7 @@
 package controller
 
 import (
+	"cmp"
 	"context"
 	"encoding/json"
 	"errors"
@@ -28,6 +29,11 @@ import (
 // set it juuuust right.
 var batchSize = 128
 
+// resultsFlushSize is the number of task results buffered before they are
+// persisted. Results arrive in batches of batchSize, buffering them means that
+// fewer and larger updates are sent to the store.
+const resultsFlushSize = 1024
+
 // taskBackend submits tasks to MCPClient via Gearman.
 //
 // Tasks are batched into batchSize groups, serialized and sent to MCPClient.
@@ -74,6 +80,16 @@ type taskBackend struct {
 	// results contains the aggregated outcome of all batches.
 	results *taskResults
 
+	// pending contains the results not persisted yet, see saveResults.
+	pending []*store.TaskResult
+
+	// skipSuccessfulOutput omits the output of the tasks that succeed when the
+	// results are persisted.
+	skipSuccessfulOutput bool
+
+	// err is the first error found persisting the results.
+	err error
+
 	// mu is used to synchronize write access from handleJobUpdate.
 	mu sync.Mutex
 }
@@ -89,7 +105,8 @@ func newTaskBackend(logger logr.Logger, metrics *metrics.Metrics, job *job, stor
 		results: &taskResults{
 			Results: map[uuid.UUID]*taskResult{},
 		},
-		config: config,
+		config:               config,
+		skipSuccessfulOutput: job.skipSuccessfulOutput,
 	}
 }
 
@@ -228,6 +245,10 @@ func (b *taskBackend) saveTasks(ctx context.Context, batch []*task) error {
 		}
 
 		tt = append(tt, task)
+
+		// The mapping is not needed once the task is persisted, release it
+		// since the tasks are kept until the job completes.
+		item.rm = nil
 	}
 
 	return b.store.CreateTasks(ctx, tt)
@@ -260,15 +281,56 @@ func (b *taskBackend) handleJobUpdate(ctx context.Context, update *gearmin.JobUp
 	}
 
 	b.mu.Lock()
-	defer b.mu.Unlock()
 	for _, task := range b.tasks {
 		id := task.ID
 		if r, ok := res.Results[id]; ok {
 			r.task = task
 			b.results.Results[id] = r
 			_ = task.writeOutput(r.Stdout, r.Stderr)
+			b.pending = append(b.pending, b.taskResult(id, r))
 		}
 	}
+	var pending []*store.TaskResult
+	if len(b.pending) >= resultsFlushSize {
+		pending, b.pending = b.pending, nil
+	}
+	b.mu.Unlock()
+
+	// The batch goroutine is blocked while the results are written, so the
+	// job does not complete until they are persisted.
+	if err := b.saveResults(ctx, pending); err != nil {
+		b.mu.Lock()
+		b.err = cmp.Or(b.err, err)
+		b.mu.Unlock()
+	}
+}
+
+// taskResult returns the result of a task as it's persisted.
+func (b *taskBackend) taskResult(id uuid.UUID, r *taskResult) *store.TaskResult {
+	ret := &store.TaskResult{
+		ID:       id,
+		EndedAt:  r.FinishedAt,
+		ExitCode: int16(r.ExitCode), //nolint:gosec
+	}
+	if r.ExitCode != 0 || !b.skipSuccessfulOutput {
+		ret.Stdout = r.Stdout
+		ret.Stderr = r.Stderr
+	}
+
+	return ret
+}
+
+// saveResults persists the results of the tasks in bulk.
+func (b *taskBackend) saveResults(ctx context.Context, results []*store.TaskResult) error {
+	if len(results) == 0 {
+		return nil
+	}
+
+	if err := b.store.UpdateTasks(ctx, results); err != nil {
+		return fmt.Errorf("save results: %v", err)
+	}
+
+	return nil
 }
 
 func (b *taskBackend) wait(ctx context.Context) (*taskResults, error) {
@@ -283,7 +345,10 @@ func (b *taskBackend) wait(ctx context.Context) (*taskResults, error) {
 		return nil, err
 	}
 
-	var err error // TODO: capture errors.
+	// Persist the results that remain in the buffer.
+	err := cmp.Or(b.err, b.saveResults(ctx, b.pending))
+	b.pending = nil
+
 	b.logger.Info("Completed all batches.", "batches", b.count, "tasks", len(b.results.Results), "err", err)
 
 	return b.results, err
@@ -3,14 +3,18 @@ package controller
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"testing"
 	"time"
 
+	"github.com/artefactual-labs/gearmin"
 	"github.com/artefactual-labs/gearmin/gearmintest"
+	"github.com/go-logr/logr"
 	"github.com/go-logr/logr/testr"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/google/uuid"
 	"github.com/mikespook/gearman-go/worker"
+	"go.artefactual.dev/tools/mockutil"
 	"go.uber.org/mock/gomock"
 	"gotest.tools/v3/assert"
 	"gotest.tools/v3/fs"
@@ -76,6 +80,7 @@ func TestTaskBackend(t *testing.T) {
 		tasks := tt.([]*store.Task)
 		return len(tasks) <= batchSize // It should never exceed the batch size.
 	})).AnyTimes()
+	s.EXPECT().UpdateTasks(gomock.Any(), gomock.Any()).AnyTimes()
 
 	logger := testr.NewWithOptions(t, testr.Options{Verbosity: 10})
 	backend := newTaskBackend(logger, metrics.NewMetrics(nil), &job{}, s, srv, &workflow.LinkStandardTaskConfig{
@@ -102,6 +107,124 @@ func TestTaskBackend(t *testing.T) {
 	)))
 }
 
+func TestTaskBackendResults(t *testing.T) {
+	t.Parallel()
+
+	newBackend := func(t *testing.T, skipSuccessfulOutput bool) (*taskBackend, *storemock.MockStore) {
+		s := storemock.NewMockStore(gomock.NewController(t))
+		b := newTaskBackend(logr.Discard(), metrics.NewMetrics(nil), &job{skipSuccessfulOutput: skipSuccessfulOutput}, s, nil, &workflow.LinkStandardTaskConfig{
+			Execute: "do",
+		})
+		return b, s
+	}
+
+	// update submits n tasks and returns the update of their batch, the exit
+	// code of every other task is 1.
+	update := func(t *testing.T, b *taskBackend, n int) *gearmin.JobUpdate {
+		res := make(map[uuid.UUID]*taskResult, n)
+		for i := range n {
+			task := &task{ID: uuid.New()}
+			b.tasks = append(b.tasks, task)
+			res[task.ID] = &taskResult{
+				ExitCode:   i % 2,
+				FinishedAt: time.Now(),
+				Stdout:     "stdout",
+				Stderr:     "stderr",
+			}
+		}
+		return &gearmin.JobUpdate{
+			Type: gearmin.JobUpdateTypeComplete,
+			Data: encodeTaskResults(t, res),
+		}
+	}
+
+	t.Run("Persists the results in bulk", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		b, s := newBackend(t, false)
+
+		var saved []*store.TaskResult
+		s.EXPECT().UpdateTasks(mockutil.Context(), gomock.Len(3*batchSize)).DoAndReturn(
+			func(ctx context.Context, results []*store.TaskResult) error {
+				saved = results
+				return nil
+			},
+		).Times(1)
+
+		for range 3 {
+			b.handleJobUpdate(ctx, update(t, b, batchSize))
+		}
+		res, err := b.wait(ctx)
+		assert.NilError(t, err)
+		assert.Equal(t, len(res.Results), 3*batchSize)
+
+		for _, r := range saved {
+			assert.Equal(t, r.Stdout, "stdout")
+			assert.Equal(t, r.Stderr, "stderr")
+			assert.Equal(t, int(r.ExitCode), res.Results[r.ID].ExitCode)
+		}
+	})
+
+	t.Run("Flushes the results when the buffer is full", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		b, s := newBackend(t, false)
+
+		s.EXPECT().UpdateTasks(mockutil.Context(), gomock.Len(resultsFlushSize)).Return(nil).Times(1)
+		b.handleJobUpdate(ctx, update(t, b, resultsFlushSize))
+		assert.Equal(t, len(b.pending), 0)
+
+		s.EXPECT().UpdateTasks(mockutil.Context(), gomock.Len(1)).Return(nil).Times(1)
+		b.handleJobUpdate(ctx, update(t, b, 1))
+		_, err := b.wait(ctx)
+		assert.NilError(t, err)
+	})
+
+	t.Run("Skips the output of successful tasks", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		b, s := newBackend(t, true)
+
+		var saved []*store.TaskResult
+		s.EXPECT().UpdateTasks(mockutil.Context(), gomock.Len(batchSize)).DoAndReturn(
+			func(ctx context.Context, results []*store.TaskResult) error {
+				saved = results
+				return nil
+			},
+		).Times(1)
+
+		b.handleJobUpdate(ctx, update(t, b, batchSize))
+		_, err := b.wait(ctx)
+		assert.NilError(t, err)
+
+		for _, r := range saved {
+			if r.ExitCode == 0 {
+				assert.Equal(t, r.Stdout, "")
+				assert.Equal(t, r.Stderr, "")
+			} else {
+				assert.Equal(t, r.Stdout, "stdout")
+				assert.Equal(t, r.Stderr, "stderr")
+			}
+		}
+	})
+
+	t.Run("Returns the error persisting the results", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		b, s := newBackend(t, false)
+
+		s.EXPECT().UpdateTasks(mockutil.Context(), gomock.Any()).Return(errors.New("database is gone")).Times(1)
+
+		b.handleJobUpdate(ctx, update(t, b, 1))
+		_, err := b.wait(ctx)
+		assert.Error(t, err, "save results: database is gone")
+	})
+}
+
 func TestTasksEncoding(t *testing.T) {
 	t.Parallel()
 
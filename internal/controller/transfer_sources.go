@@ -0,0 +1,99 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/artefactual-labs/ccp/internal/storage"
+)
+
+// ErrInvalidTransferSource is returned when a transfer source cannot be
+// resolved to a path inside of a known location.
+var ErrInvalidTransferSource = errors.New("invalid transfer source")
+
+// resolveTransferSources resolves a list of locationPath values into absolute
+// paths that can be copied into the transfer.
+//
+// Without a location resolver, paths are used as is and location identifiers
+// are ignored, which is only meant for development environments.
+func resolveTransferSources(ctx context.Context, locations storage.LocationResolver, items []string) ([]string, error) {
+	paths := make([]string, 0, len(items))
+
+	if locations == nil {
+		for _, item := range items {
+			_, path := locationPath(item)
+			paths = append(paths, path)
+		}
+		return paths, nil
+	}
+
+	roots := map[uuid.UUID]string{}
+	for _, item := range items {
+		id, rel := locationPath(item)
+		if id == uuid.Nil {
+			return nil, fmt.Errorf("%w: %q: missing location identifier", ErrInvalidTransferSource, item)
+		}
+
+		root, ok := roots[id]
+		if !ok {
+			loc, err := locations.Location(ctx, id)
+			if errors.Is(err, storage.ErrUnknownLocation) {
+				return nil, fmt.Errorf("%w: %q: %v", ErrInvalidTransferSource, item, err)
+			} else if err != nil {
+				return nil, fmt.Errorf("resolve location: %v", err)
+			}
+			root = loc.Path
+			roots[id] = root
+		}
+
+		path, err := locationJoin(root, rel)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q: %v", ErrInvalidTransferSource, item, err)
+		}
+		paths = append(paths, path)
+	}
+
+	return paths, nil
+}
+
+// locationJoin joins the location root and a path relative to it. It fails if
+// the path does not exist or escapes the root, including via symbolic links.
+func locationJoin(root, rel string) (string, error) {
+	path := filepath.Join(root, rel)
+	if !within(root, path) {
+		return "", errors.New("path escapes location")
+	}
+
+	realRoot, err := filepath.EvalSymlinks(root)
+	if err != nil {
+		return "", fmt.Errorf("location not available: %v", err)
+	}
+	realPath, err := filepath.EvalSymlinks(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return "", errors.New("path not found")
+	} else if err != nil {
+		return "", err
+	}
+	if !within(realRoot, realPath) {
+		return "", errors.New("path escapes location")
+	}
+
+	return path, nil
+}
+
+// within reports whether path is root or one of its descendants. Both paths are
+// expected to be clean.
+func within(root, path string) bool {
+	rel, err := filepath.Rel(root, path)
+	if err != nil {
+		return false
+	}
+
+	return rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
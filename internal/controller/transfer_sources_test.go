@@ -0,0 +1,116 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/google/uuid"
+	"gotest.tools/v3/assert"
+	"gotest.tools/v3/fs"
+
+	"github.com/artefactual-labs/ccp/internal/storage"
+)
+
+type failingResolver struct{}
+
+func (failingResolver) Location(ctx context.Context, id uuid.UUID) (*storage.Location, error) {
+	return nil, errors.New("connection refused")
+}
+
+func TestResolveTransferSources(t *testing.T) {
+	t.Parallel()
+
+	const (
+		locID   = "c059a454-dafa-418e-a126-74d0c7219ce6"
+		otherID = "9a4a1d68-6c74-4bda-8e8c-49cb2c1ab3d0"
+	)
+
+	outside := fs.NewDir(t, "", fs.WithFile("secret.txt", ""))
+	root := fs.NewDir(t, "",
+		fs.WithDir("Images", fs.WithFile("image.jpg", "")),
+		fs.WithFile("bag.zip", ""),
+	)
+	assert.NilError(t, os.Symlink(outside.Path(), root.Join("escape")))
+	locations := storage.Locations{uuid.MustParse(locID): root.Path()}
+	ctx := context.Background()
+
+	t.Run("Resolves paths relative to their location", func(t *testing.T) {
+		t.Parallel()
+
+		paths, err := resolveTransferSources(ctx, locations, []string{
+			locID + ":Images/",
+			locID + ":/bag.zip",
+		})
+		assert.NilError(t, err)
+		assert.DeepEqual(t, paths, []string{
+			root.Join("Images"),
+			root.Join("bag.zip"),
+		})
+	})
+
+	t.Run("Uses paths as is without a resolver", func(t *testing.T) {
+		t.Parallel()
+
+		paths, err := resolveTransferSources(ctx, nil, []string{
+			locID + ":/tmp/Images",
+			"/tmp/bag.zip",
+		})
+		assert.NilError(t, err)
+		assert.DeepEqual(t, paths, []string{"/tmp/Images", "/tmp/bag.zip"})
+	})
+
+	t.Run("Rejects invalid sources", func(t *testing.T) {
+		t.Parallel()
+
+		for _, tc := range []struct {
+			item string
+			err  string
+		}{
+			{
+				item: "/tmp/Images",
+				err:  `invalid transfer source: "/tmp/Images": missing location identifier`,
+			},
+			{
+				item: otherID + ":Images",
+				err:  `invalid transfer source: "` + otherID + `:Images": unknown location: ` + otherID,
+			},
+			{
+				item: locID + ":../Images",
+				err:  `invalid transfer source: "` + locID + `:../Images": path escapes location`,
+			},
+			{
+				item: locID + ":escape/secret.txt",
+				err:  `invalid transfer source: "` + locID + `:escape/secret.txt": path escapes location`,
+			},
+			{
+				item: locID + ":Missing",
+				err:  `invalid transfer source: "` + locID + `:Missing": path not found`,
+			},
+		} {
+			_, err := resolveTransferSources(ctx, locations, []string{tc.item})
+			assert.ErrorIs(t, err, ErrInvalidTransferSource)
+			assert.Error(t, err, tc.err)
+		}
+	})
+
+	t.Run("Reports resolver errors", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := resolveTransferSources(ctx, failingResolver{}, []string{locID + ":Images"})
+		assert.Error(t, err, "resolve location: connection refused")
+		assert.Assert(t, !errors.Is(err, ErrInvalidTransferSource))
+	})
+}
+
+func TestWithin(t *testing.T) {
+	t.Parallel()
+
+	assert.Assert(t, within("/home", "/home"))
+	assert.Assert(t, within("/home", "/home/Images"))
+	assert.Assert(t, within("/home", "/home/..Images"))
+	assert.Assert(t, !within("/home", "/"))
+	assert.Assert(t, !within("/home", "/homework"))
+	assert.Assert(t, !within("/home", "/tmp"+string(os.PathSeparator)+"Images"))
+}
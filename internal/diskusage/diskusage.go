@@ -0,0 +1,48 @@
+// Package diskusage reports the space available in a filesystem.
+package diskusage
+
+import (
+	"fmt"
+	"syscall"
+)
+
+// Usage describes the space of the filesystem containing a path, in bytes.
+type Usage struct {
+	// Total is the size of the filesystem.
+	Total uint64
+	// Free is the space available to unprivileged users.
+	Free uint64
+}
+
+// Used is the space not available to unprivileged users.
+func (u Usage) Used() uint64 {
+	return u.Total - u.Free
+}
+
+// Get returns the usage of the filesystem containing path.
+func Get(path string) (Usage, error) {
+	var st syscall.Statfs_t
+	if err := syscall.Statfs(path, &st); err != nil {
+		return Usage{}, fmt.Errorf("statfs %s: %v", path, err)
+	}
+
+	bsize := uint64(st.Bsize) // nolint: gosec
+	return Usage{
+		Total: uint64(st.Blocks) * bsize,
+		Free:  uint64(st.Bavail) * bsize,
+	}, nil
+}
+
+// Format returns a human-readable representation of a number of bytes.
+func Format(n uint64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	div, exp := uint64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
@@ -0,0 +1,153 @@
+// Package election implements leader election on top of a lease kept in the
+// database, so only one of the instances sharing the database processes
+// packages while the others stay on standby, ready to take over.
+package election
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-logr/logr"
+
+	"github.com/artefactual-labs/ccp/internal/store"
+)
+
+// ErrLost is returned by Run when the elector loses the lease after being
+// elected, e.g. it could not renew it before it expired.
+var ErrLost = errors.New("leadership lost")
+
+// LeaseStore is the subset of store.Store used by the elector.
+type LeaseStore interface {
+	AcquireLease(ctx context.Context, name, holder string, ttl time.Duration) (bool, error)
+	ReadLeaseHolder(ctx context.Context, name string) (string, error)
+	ReleaseLease(ctx context.Context, name, holder string) error
+}
+
+// Elector campaigns for the lease on behalf of the instance.
+//
+// The lease is renewed three times per TTL. The leader steps down when the
+// lease is taken by another instance or when it could not be renewed before
+// it would expire, so two instances never act as leaders at the same time as
+// long as their clocks run at a similar rate.
+type Elector struct {
+	logger  logr.Logger
+	store   LeaseStore
+	name    string
+	id      string
+	ttl     time.Duration
+	leader  atomic.Bool
+	elected chan struct{}
+}
+
+func New(logger logr.Logger, store LeaseStore, name, id string, ttl time.Duration) *Elector {
+	return &Elector{
+		logger:  logger,
+		store:   store,
+		name:    name,
+		id:      id,
+		ttl:     ttl,
+		elected: make(chan struct{}),
+	}
+}
+
+// ID returns the identifier of this instance.
+func (e *Elector) ID() string {
+	return e.id
+}
+
+// Leader reports whether this instance holds the lease.
+func (e *Elector) Leader() bool {
+	return e.leader.Load()
+}
+
+// Elected returns a channel that is closed when this instance is elected.
+func (e *Elector) Elected() <-chan struct{} {
+	return e.elected
+}
+
+// LeaderID returns the identifier of the instance holding the lease, or an
+// empty string when there is no leader.
+func (e *Elector) LeaderID(ctx context.Context) (string, error) {
+	id, err := e.store.ReadLeaseHolder(ctx, e.name)
+	if errors.Is(err, store.ErrNotFound) {
+		return "", nil
+	}
+	return id, err
+}
+
+// Run campaigns for the lease until the context is canceled or, once elected,
+// until the lease is lost. The lease is released when the context is canceled
+// so a standby instance can take over without waiting for it to expire.
+func (e *Elector) Run(ctx context.Context) error {
+	interval := e.ttl / 3
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	// renewed is the time of the last successful attempt, the lease expires
+	// no earlier than renewed + ttl.
+	var renewed time.Time
+
+	for {
+		// A renewal must complete before the deadline to step down, leaving
+		// one interval of margin before the lease expires.
+		timeout := interval
+		if e.leader.Load() {
+			timeout = min(timeout, time.Until(renewed.Add(e.ttl-interval)))
+		}
+
+		start := time.Now()
+		ok, err := e.acquire(ctx, timeout)
+		switch {
+		case err == nil && ok:
+			renewed = start
+			if !e.leader.Load() {
+				e.logger.Info("Elected as leader.", "id", e.id)
+				e.leader.Store(true)
+				close(e.elected)
+			}
+		case !e.leader.Load():
+			if err != nil {
+				e.logger.V(1).Info("Failed to acquire lease.", "err", err)
+			}
+		case err == nil:
+			e.leader.Store(false)
+			e.logger.Info("Lease taken by another instance.")
+			return ErrLost
+		case time.Since(renewed) >= e.ttl-interval:
+			e.leader.Store(false)
+			e.logger.Error(err, "Failed to renew lease.")
+			return ErrLost
+		default:
+			e.logger.V(1).Info("Failed to renew lease, retrying.", "err", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			e.release()
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (e *Elector) acquire(ctx context.Context, timeout time.Duration) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	return e.store.AcquireLease(ctx, e.name, e.id, e.ttl)
+}
+
+func (e *Elector) release() {
+	if !e.leader.Swap(false) {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	if err := e.store.ReleaseLease(ctx, e.name, e.id); err != nil {
+		e.logger.Error(err, "Failed to release lease.")
+	}
+}
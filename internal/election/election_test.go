@@ -0,0 +1,167 @@
+package election_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"gotest.tools/v3/assert"
+
+	"github.com/artefactual-labs/ccp/internal/election"
+	"github.com/artefactual-labs/ccp/internal/store"
+)
+
+const ttl = time.Millisecond * 60
+
+// leaseStore keeps the leases in memory.
+type leaseStore struct {
+	mu      sync.Mutex
+	holder  string
+	expires time.Time
+	err     error // Returned by all the methods when set.
+}
+
+func (s *leaseStore) AcquireLease(ctx context.Context, name, holder string, ttl time.Duration) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.err != nil {
+		return false, s.err
+	}
+	if s.holder == holder || time.Now().After(s.expires) {
+		s.holder = holder
+		s.expires = time.Now().Add(ttl)
+	}
+
+	return s.holder == holder, nil
+}
+
+func (s *leaseStore) ReadLeaseHolder(ctx context.Context, name string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.err != nil {
+		return "", s.err
+	}
+	if time.Now().After(s.expires) {
+		return "", store.ErrNotFound
+	}
+
+	return s.holder, nil
+}
+
+func (s *leaseStore) ReleaseLease(ctx context.Context, name, holder string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.err != nil {
+		return s.err
+	}
+	if s.holder == holder {
+		s.holder = ""
+		s.expires = time.Time{}
+	}
+
+	return nil
+}
+
+func (s *leaseStore) fail(err error) {
+	s.mu.Lock()
+	s.err = err
+	s.mu.Unlock()
+}
+
+// run runs the elector in the background, the error returned is sent to the
+// channel.
+func run(ctx context.Context, e *election.Elector) <-chan error {
+	done := make(chan error, 1)
+	go func() { done <- e.Run(ctx) }()
+	return done
+}
+
+func wait(t *testing.T, ch <-chan struct{}) {
+	t.Helper()
+
+	select {
+	case <-ch:
+	case <-time.After(time.Second):
+		t.Fatal("timed out")
+	}
+}
+
+func TestElector(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Keeps the second instance on standby", func(t *testing.T) {
+		t.Parallel()
+
+		s := &leaseStore{}
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		a := election.New(logr.Discard(), s, "ccp", "a", ttl)
+		run(ctx, a)
+		wait(t, a.Elected())
+
+		b := election.New(logr.Discard(), s, "ccp", "b", ttl)
+		run(ctx, b)
+		time.Sleep(ttl * 2)
+
+		assert.Assert(t, a.Leader())
+		assert.Assert(t, !b.Leader())
+
+		id, err := b.LeaderID(ctx)
+		assert.NilError(t, err)
+		assert.Equal(t, id, "a")
+	})
+
+	t.Run("Hands over the lease when the leader stops", func(t *testing.T) {
+		t.Parallel()
+
+		s := &leaseStore{}
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		actx, acancel := context.WithCancel(ctx)
+		a := election.New(logr.Discard(), s, "ccp", "a", ttl)
+		adone := run(actx, a)
+		wait(t, a.Elected())
+
+		b := election.New(logr.Discard(), s, "ccp", "b", ttl)
+		run(ctx, b)
+
+		acancel()
+		assert.ErrorIs(t, <-adone, context.Canceled)
+		assert.Assert(t, !a.Leader())
+
+		wait(t, b.Elected())
+		assert.Assert(t, b.Leader())
+	})
+
+	t.Run("Steps down when the lease cannot be renewed", func(t *testing.T) {
+		t.Parallel()
+
+		s := &leaseStore{}
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		a := election.New(logr.Discard(), s, "ccp", "a", ttl)
+		done := run(ctx, a)
+		wait(t, a.Elected())
+
+		start := time.Now()
+		s.fail(errors.New("connection refused"))
+
+		select {
+		case err := <-done:
+			assert.ErrorIs(t, err, election.ErrLost)
+		case <-time.After(time.Second):
+			t.Fatal("timed out")
+		}
+		assert.Assert(t, !a.Leader())
+		assert.Assert(t, time.Since(start) < ttl)
+	})
+}
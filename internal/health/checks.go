@@ -0,0 +1,79 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/artefactual-labs/ccp/internal/diskusage"
+)
+
+// Pinger is implemented by the dependencies that can be pinged, e.g. the store.
+type Pinger interface {
+	Running() bool
+	Ping(ctx context.Context) error
+}
+
+// PingCheck returns a check that fails when p is not running or it does not
+// respond to pings.
+func PingCheck(p Pinger) CheckFunc {
+	return func(ctx context.Context) (string, error) {
+		if p == nil || !p.Running() {
+			return "", errors.New("not running")
+		}
+		if err := p.Ping(ctx); err != nil {
+			return "", fmt.Errorf("ping: %v", err)
+		}
+		return "", nil
+	}
+}
+
+// DirCheck returns a check that fails when the directory has less than minFree
+// bytes available or when it is not writable.
+func DirCheck(path string, minFree uint64) CheckFunc {
+	return func(ctx context.Context) (string, error) {
+		usage, err := diskusage.Get(path)
+		if err != nil {
+			return "", err
+		}
+		free := diskusage.Format(usage.Free) + " free"
+		if usage.Free < minFree {
+			return "", fmt.Errorf("%s, below the minimum of %s", free, diskusage.Format(minFree))
+		}
+
+		f, err := os.CreateTemp(path, ".healthcheck-*")
+		if err != nil {
+			return "", fmt.Errorf("not writable: %v", err)
+		}
+		name := f.Name()
+		_, err = f.WriteString(time.Now().Format(time.RFC3339Nano))
+		if closeErr := f.Close(); err == nil {
+			err = closeErr
+		}
+		if removeErr := os.Remove(name); err == nil {
+			err = removeErr
+		}
+		if err != nil {
+			return "", fmt.Errorf("not writable: %v", err)
+		}
+
+		return free, nil
+	}
+}
+
+// HeartbeatCheck returns a check that fails when the last beat reported by
+// the function is older than maxAge, e.g. to detect a stuck loop.
+func HeartbeatCheck(last func() time.Time, maxAge time.Duration) CheckFunc {
+	return func(ctx context.Context) (string, error) {
+		t := last()
+		if t.IsZero() {
+			return "", errors.New("not started")
+		}
+		if age := time.Since(t).Truncate(time.Millisecond); age > maxAge {
+			return "", fmt.Errorf("last beat %s ago", age)
+		}
+		return "", nil
+	}
+}
@@ -0,0 +1,204 @@
+// Package health reports on the health of the server components.
+//
+// Checks are registered with a Checker as either liveness or readiness checks.
+// Liveness checks fail when the process is in a state that it cannot recover
+// from and needs to be restarted, e.g. the controller loop is stuck. Readiness
+// checks fail when the server is temporarily unable to do useful work, e.g. the
+// database is unreachable. Readiness includes the liveness checks.
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	"connectrpc.com/grpchealth"
+)
+
+// Kind determines which probes run a check.
+type Kind int
+
+const (
+	// Liveness checks are run by both the liveness and the readiness probes.
+	Liveness Kind = iota
+	// Readiness checks are only run by the readiness probe.
+	Readiness
+)
+
+// CheckFunc runs a check. It returns an error when the check fails, and
+// optionally a short description of the observed state, e.g. the number of
+// workers connected.
+type CheckFunc func(ctx context.Context) (string, error)
+
+const (
+	StatusOK   = "OK"
+	StatusFail = "FAIL"
+)
+
+// Result is the outcome of a single check.
+type Result struct {
+	Name    string `json:"name"`
+	Status  string `json:"status"`
+	Message string `json:"message,omitempty"`
+}
+
+// Report is the outcome of a probe.
+type Report struct {
+	Status string   `json:"status"`
+	Checks []Result `json:"checks"`
+}
+
+// OK reports whether all the checks passed.
+func (r Report) OK() bool {
+	return r.Status == StatusOK
+}
+
+type check struct {
+	name string
+	kind Kind
+	fn   CheckFunc
+}
+
+// Checker runs the registered checks. It is safe for concurrent use.
+type Checker struct {
+	timeout time.Duration
+	mu      sync.RWMutex
+	checks  []check
+}
+
+var _ grpchealth.Checker = (*Checker)(nil)
+
+// NewChecker returns a Checker that cancels checks that take longer than the
+// timeout.
+func NewChecker(timeout time.Duration) *Checker {
+	return &Checker{timeout: timeout}
+}
+
+// Add registers a check. Checks are reported in the order they're added.
+func (c *Checker) Add(name string, kind Kind, fn CheckFunc) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.checks = append(c.checks, check{name: name, kind: kind, fn: fn})
+}
+
+// Live runs the liveness checks.
+func (c *Checker) Live(ctx context.Context) Report {
+	return c.run(ctx, Liveness)
+}
+
+// Ready runs both the liveness and the readiness checks.
+func (c *Checker) Ready(ctx context.Context) Report {
+	return c.run(ctx, Readiness)
+}
+
+// run runs the checks concurrently. Checks with a kind greater than the given
+// kind are skipped.
+func (c *Checker) run(ctx context.Context, kind Kind) Report {
+	c.mu.RLock()
+	checks := make([]check, 0, len(c.checks))
+	for _, chk := range c.checks {
+		if chk.kind <= kind {
+			checks = append(checks, chk)
+		}
+	}
+	c.mu.RUnlock()
+
+	if c.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.timeout)
+		defer cancel()
+	}
+
+	report := Report{Status: StatusOK, Checks: make([]Result, len(checks))}
+
+	var wg sync.WaitGroup
+	for i, chk := range checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			report.Checks[i] = runCheck(ctx, chk)
+		}()
+	}
+	wg.Wait()
+
+	for _, r := range report.Checks {
+		if r.Status != StatusOK {
+			report.Status = StatusFail
+			break
+		}
+	}
+
+	return report
+}
+
+// runCheck runs a single check, giving up when the context is done even if the
+// check function does not honor it.
+func runCheck(ctx context.Context, chk check) Result {
+	type outcome struct {
+		msg string
+		err error
+	}
+	done := make(chan outcome, 1)
+	go func() {
+		msg, err := chk.fn(ctx)
+		done <- outcome{msg, err}
+	}()
+
+	res := Result{Name: chk.name, Status: StatusOK}
+	select {
+	case o := <-done:
+		res.Message = o.msg
+		if o.err != nil {
+			res.Status = StatusFail
+			res.Message = o.err.Error()
+		}
+	case <-ctx.Done():
+		res.Status = StatusFail
+		res.Message = ctx.Err().Error()
+	}
+
+	return res
+}
+
+// Check implements grpchealth.Checker. Every service shares the readiness of
+// the server.
+func (c *Checker) Check(ctx context.Context, _ *grpchealth.CheckRequest) (*grpchealth.CheckResponse, error) {
+	status := grpchealth.StatusServing
+	if !c.Ready(ctx).OK() {
+		status = grpchealth.StatusNotServing
+	}
+
+	return &grpchealth.CheckResponse{Status: status}, nil
+}
+
+// LiveHandler returns an HTTP handler that runs the liveness checks.
+func (c *Checker) LiveHandler() http.Handler {
+	return c.handler(Liveness)
+}
+
+// ReadyHandler returns an HTTP handler that runs the readiness checks.
+func (c *Checker) ReadyHandler() http.Handler {
+	return c.handler(Readiness)
+}
+
+// handler responds with the report encoded as JSON. The status code is 503
+// when any of the checks fail, which is what the Kubernetes HTTP probes look
+// at.
+func (c *Checker) handler(kind Kind) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		report := c.run(r.Context(), kind)
+
+		code := http.StatusOK
+		if !report.OK() {
+			code = http.StatusServiceUnavailable
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Cache-Control", "no-store")
+		w.WriteHeader(code)
+		_ = json.NewEncoder(w).Encode(report)
+	})
+}
@@ -0,0 +1,225 @@
+package health_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"connectrpc.com/grpchealth"
+	"go.uber.org/mock/gomock"
+	"gotest.tools/v3/assert"
+
+	"github.com/artefactual-labs/ccp/internal/health"
+	"github.com/artefactual-labs/ccp/internal/store/storemock"
+)
+
+func pass(msg string) health.CheckFunc {
+	return func(context.Context) (string, error) { return msg, nil }
+}
+
+func fail(msg string) health.CheckFunc {
+	return func(context.Context) (string, error) { return "", errors.New(msg) }
+}
+
+func TestChecker(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Liveness only runs liveness checks", func(t *testing.T) {
+		t.Parallel()
+
+		c := health.NewChecker(time.Second)
+		c.Add("loop", health.Liveness, pass(""))
+		c.Add("database", health.Readiness, fail("unreachable"))
+
+		assert.DeepEqual(t, c.Live(context.Background()), health.Report{
+			Status: health.StatusOK,
+			Checks: []health.Result{
+				{Name: "loop", Status: health.StatusOK},
+			},
+		})
+	})
+
+	t.Run("Readiness runs all the checks", func(t *testing.T) {
+		t.Parallel()
+
+		c := health.NewChecker(time.Second)
+		c.Add("loop", health.Liveness, pass(""))
+		c.Add("workers", health.Readiness, pass("2 connected"))
+		c.Add("database", health.Readiness, fail("unreachable"))
+
+		assert.DeepEqual(t, c.Ready(context.Background()), health.Report{
+			Status: health.StatusFail,
+			Checks: []health.Result{
+				{Name: "loop", Status: health.StatusOK},
+				{Name: "workers", Status: health.StatusOK, Message: "2 connected"},
+				{Name: "database", Status: health.StatusFail, Message: "unreachable"},
+			},
+		})
+	})
+
+	t.Run("Gives up on checks that exceed the timeout", func(t *testing.T) {
+		t.Parallel()
+
+		block := make(chan struct{})
+		defer close(block)
+
+		c := health.NewChecker(time.Millisecond * 50)
+		c.Add("stuck", health.Liveness, func(context.Context) (string, error) {
+			<-block
+			return "", nil
+		})
+
+		report := c.Live(context.Background())
+		assert.Equal(t, report.Status, health.StatusFail)
+		assert.Equal(t, report.Checks[0].Message, context.DeadlineExceeded.Error())
+	})
+
+	t.Run("Implements grpchealth.Checker", func(t *testing.T) {
+		t.Parallel()
+
+		c := health.NewChecker(time.Second)
+		c.Add("loop", health.Liveness, pass(""))
+
+		resp, err := c.Check(context.Background(), &grpchealth.CheckRequest{})
+		assert.NilError(t, err)
+		assert.Equal(t, resp.Status, grpchealth.StatusServing)
+
+		c.Add("database", health.Readiness, fail("unreachable"))
+
+		resp, err = c.Check(context.Background(), &grpchealth.CheckRequest{})
+		assert.NilError(t, err)
+		assert.Equal(t, resp.Status, grpchealth.StatusNotServing)
+	})
+}
+
+func TestCheckerHandlers(t *testing.T) {
+	t.Parallel()
+
+	c := health.NewChecker(time.Second)
+	c.Add("loop", health.Liveness, pass(""))
+	c.Add("database", health.Readiness, fail("unreachable"))
+
+	for _, tc := range []struct {
+		name    string
+		handler http.Handler
+		code    int
+		status  string
+	}{
+		{name: "Liveness", handler: c.LiveHandler(), code: http.StatusOK, status: health.StatusOK},
+		{name: "Readiness", handler: c.ReadyHandler(), code: http.StatusServiceUnavailable, status: health.StatusFail},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			rec := httptest.NewRecorder()
+			tc.handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+
+			assert.Equal(t, rec.Code, tc.code)
+			assert.Equal(t, rec.Header().Get("Content-Type"), "application/json")
+
+			var report health.Report
+			assert.NilError(t, json.NewDecoder(rec.Body).Decode(&report))
+			assert.Equal(t, report.Status, tc.status)
+		})
+	}
+}
+
+func TestPingCheck(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Passes when the store responds", func(t *testing.T) {
+		t.Parallel()
+
+		s := storemock.NewMockStore(gomock.NewController(t))
+		s.EXPECT().Running().Return(true)
+		s.EXPECT().Ping(gomock.Any()).Return(nil)
+
+		_, err := health.PingCheck(s)(context.Background())
+		assert.NilError(t, err)
+	})
+
+	t.Run("Fails when the store does not respond", func(t *testing.T) {
+		t.Parallel()
+
+		s := storemock.NewMockStore(gomock.NewController(t))
+		s.EXPECT().Running().Return(true)
+		s.EXPECT().Ping(gomock.Any()).Return(errors.New("connection refused"))
+
+		_, err := health.PingCheck(s)(context.Background())
+		assert.Error(t, err, "ping: connection refused")
+	})
+
+	t.Run("Fails when the store is not running", func(t *testing.T) {
+		t.Parallel()
+
+		s := storemock.NewMockStore(gomock.NewController(t))
+		s.EXPECT().Running().Return(false)
+
+		_, err := health.PingCheck(s)(context.Background())
+		assert.Error(t, err, "not running")
+	})
+}
+
+func TestDirCheck(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Reports the free space", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		msg, err := health.DirCheck(dir, 0)(context.Background())
+		assert.NilError(t, err)
+		assert.Assert(t, msg != "")
+
+		// The probe file is removed.
+		entries, err := os.ReadDir(dir)
+		assert.NilError(t, err)
+		assert.Equal(t, len(entries), 0)
+	})
+
+	t.Run("Fails when the free space is below the minimum", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := health.DirCheck(t.TempDir(), math.MaxUint64)(context.Background())
+		assert.ErrorContains(t, err, "below the minimum")
+	})
+
+	t.Run("Fails when the directory does not exist", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := health.DirCheck("/does/not/exist", 0)(context.Background())
+		assert.ErrorContains(t, err, "statfs")
+	})
+}
+
+func TestHeartbeatCheck(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	for _, tc := range []struct {
+		name string
+		last time.Time
+		err  string
+	}{
+		{name: "Passes with a recent beat", last: now},
+		{name: "Fails with an old beat", last: now.Add(-time.Hour), err: "last beat"},
+		{name: "Fails when not started", err: "not started"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := health.HeartbeatCheck(func() time.Time { return tc.last }, time.Minute)(context.Background())
+			if tc.err == "" {
+				assert.NilError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tc.err)
+			}
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package storage
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path/filepath"
+
+	"github.com/google/uuid"
+)
+
+// Client is a LocationResolver backed by the Archivematica Storage Service
+// REST API.
+type Client struct {
+	baseURL *url.URL
+	user    string
+	key     string
+	client  *http.Client
+}
+
+var _ LocationResolver = (*Client)(nil)
+
+// NewClient returns a new Storage Service client. The HTTP client is optional,
+// http.DefaultClient is used when nil.
+func NewClient(baseURL, user, key string, client *http.Client) (*Client, error) {
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid URL: %v", err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("invalid URL: unsupported scheme %q", u.Scheme)
+	}
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	return &Client{
+		baseURL: u,
+		user:    user,
+		key:     key,
+		client:  client,
+	}, nil
+}
+
+// location is the subset of the location resource that we care about.
+type location struct {
+	UUID    string `json:"uuid"`
+	Path    string `json:"path"`
+	Enabled bool   `json:"enabled"`
+}
+
+// Location implements LocationResolver by retrieving the location from
+// `/api/v2/location/<uuid>/`. Disabled locations are reported as unknown.
+func (c *Client) Location(ctx context.Context, id uuid.UUID) (*Location, error) {
+	u := c.baseURL.JoinPath("api/v2/location", id.String()).String() + "/"
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Authorization", fmt.Sprintf("ApiKey %s:%s", c.user, c.key))
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("storage service: %v", err)
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownLocation, id)
+	case resp.StatusCode != http.StatusOK:
+		_, _ = io.Copy(io.Discard, resp.Body)
+		return nil, fmt.Errorf("storage service: unexpected status %q", resp.Status)
+	}
+
+	var loc location
+	if err := json.NewDecoder(resp.Body).Decode(&loc); err != nil {
+		return nil, fmt.Errorf("storage service: decode response: %v", err)
+	}
+	if !loc.Enabled {
+		return nil, fmt.Errorf("%w: %s is disabled", ErrUnknownLocation, id)
+	}
+	if !filepath.IsAbs(loc.Path) {
+		return nil, fmt.Errorf("storage service: location %s has unexpected path %q", id, loc.Path)
+	}
+
+	return &Location{ID: id, Path: filepath.Clean(loc.Path)}, nil
+}
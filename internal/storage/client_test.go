@@ -0,0 +1,77 @@
+package storage_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"gotest.tools/v3/assert"
+
+	"github.com/artefactual-labs/ccp/internal/storage"
+)
+
+func TestClient(t *testing.T) {
+	t.Parallel()
+
+	var (
+		enabledID  = uuid.MustParse("c059a454-dafa-418e-a126-74d0c7219ce6")
+		disabledID = uuid.MustParse("9a4a1d68-6c74-4bda-8e8c-49cb2c1ab3d0")
+		brokenID   = uuid.MustParse("f6e4dba9-6ab5-4e3a-9a3a-1f6a2a8a5a3e")
+	)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "ApiKey test:secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch r.URL.Path {
+		case "/storage/api/v2/location/" + enabledID.String() + "/":
+			w.Write([]byte(`{"uuid": "` + enabledID.String() + `", "path": "/home/archivematica/", "enabled": true}`)) //nolint: errcheck
+		case "/storage/api/v2/location/" + disabledID.String() + "/":
+			w.Write([]byte(`{"uuid": "` + disabledID.String() + `", "path": "/home", "enabled": false}`)) //nolint: errcheck
+		case "/storage/api/v2/location/" + brokenID.String() + "/":
+			w.WriteHeader(http.StatusInternalServerError)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	client, err := storage.NewClient(srv.URL+"/storage", "test", "secret", srv.Client())
+	assert.NilError(t, err)
+	ctx := context.Background()
+
+	t.Run("Resolves locations", func(t *testing.T) {
+		t.Parallel()
+
+		loc, err := client.Location(ctx, enabledID)
+		assert.NilError(t, err)
+		assert.DeepEqual(t, loc, &storage.Location{ID: enabledID, Path: "/home/archivematica"})
+	})
+
+	t.Run("Reports unknown locations", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := client.Location(ctx, uuid.New())
+		assert.ErrorIs(t, err, storage.ErrUnknownLocation)
+
+		_, err = client.Location(ctx, disabledID)
+		assert.ErrorIs(t, err, storage.ErrUnknownLocation)
+	})
+
+	t.Run("Reports server errors", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := client.Location(ctx, brokenID)
+		assert.Error(t, err, `storage service: unexpected status "500 Internal Server Error"`)
+	})
+
+	t.Run("Rejects invalid URLs", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := storage.NewClient("ftp://example.com", "test", "secret", nil)
+		assert.Error(t, err, `invalid URL: unsupported scheme "ftp"`)
+	})
+}
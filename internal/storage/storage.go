@@ -0,0 +1,71 @@
+// Package storage resolves the storage locations used as transfer sources.
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// ErrUnknownLocation is returned when a location cannot be found or it is not
+// available to the pipeline.
+var ErrUnknownLocation = errors.New("unknown location")
+
+// Location is a storage location.
+type Location struct {
+	// ID is the identifier of the location.
+	ID uuid.UUID
+
+	// Path is the absolute path where the location is mounted.
+	Path string
+}
+
+// LocationResolver resolves location identifiers.
+type LocationResolver interface {
+	// Location returns the location with the given identifier or an error
+	// wrapping ErrUnknownLocation when it is not known.
+	Location(ctx context.Context, id uuid.UUID) (*Location, error)
+}
+
+// Locations is a LocationResolver backed by a static list of locations.
+type Locations map[uuid.UUID]string
+
+var _ LocationResolver = Locations{}
+
+// ParseLocations parses a list of locations using the "<uuid>:<path>" format,
+// e.g. from the configuration file.
+func ParseLocations(items []string) (Locations, error) {
+	locs := make(Locations, len(items))
+	for _, item := range items {
+		before, after, found := strings.Cut(item, ":")
+		if !found {
+			return nil, fmt.Errorf("invalid location %q: expected <uuid>:<path>", item)
+		}
+		id, err := uuid.Parse(before)
+		if err != nil {
+			return nil, fmt.Errorf("invalid location %q: %v", item, err)
+		}
+		if !filepath.IsAbs(after) {
+			return nil, fmt.Errorf("invalid location %q: path is not absolute", item)
+		}
+		if _, ok := locs[id]; ok {
+			return nil, fmt.Errorf("invalid location %q: duplicate identifier", item)
+		}
+		locs[id] = filepath.Clean(after)
+	}
+
+	return locs, nil
+}
+
+func (l Locations) Location(ctx context.Context, id uuid.UUID) (*Location, error) {
+	path, ok := l[id]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownLocation, id)
+	}
+
+	return &Location{ID: id, Path: path}, nil
+}
@@ -0,0 +1,65 @@
+package storage_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"gotest.tools/v3/assert"
+
+	"github.com/artefactual-labs/ccp/internal/storage"
+)
+
+func TestParseLocations(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Parses locations", func(t *testing.T) {
+		t.Parallel()
+
+		locs, err := storage.ParseLocations([]string{
+			"c059a454-dafa-418e-a126-74d0c7219ce6:/home/archivematica/",
+			"9a4a1d68-6c74-4bda-8e8c-49cb2c1ab3d0:/var/archivematica/sharedDirectory",
+		})
+		assert.NilError(t, err)
+		assert.DeepEqual(t, locs, storage.Locations{
+			uuid.MustParse("c059a454-dafa-418e-a126-74d0c7219ce6"): "/home/archivematica",
+			uuid.MustParse("9a4a1d68-6c74-4bda-8e8c-49cb2c1ab3d0"): "/var/archivematica/sharedDirectory",
+		})
+	})
+
+	t.Run("Rejects invalid locations", func(t *testing.T) {
+		t.Parallel()
+
+		for _, tc := range []struct {
+			item string
+			err  string
+		}{
+			{"/home", `invalid location "/home": expected <uuid>:<path>`},
+			{"12345:/home", `invalid location "12345:/home": invalid UUID length: 5`},
+			{"c059a454-dafa-418e-a126-74d0c7219ce6:home", `invalid location "c059a454-dafa-418e-a126-74d0c7219ce6:home": path is not absolute`},
+		} {
+			_, err := storage.ParseLocations([]string{tc.item})
+			assert.Error(t, err, tc.err)
+		}
+
+		_, err := storage.ParseLocations([]string{
+			"c059a454-dafa-418e-a126-74d0c7219ce6:/home",
+			"c059a454-dafa-418e-a126-74d0c7219ce6:/tmp",
+		})
+		assert.Error(t, err, `invalid location "c059a454-dafa-418e-a126-74d0c7219ce6:/tmp": duplicate identifier`)
+	})
+}
+
+func TestLocations(t *testing.T) {
+	t.Parallel()
+
+	id := uuid.MustParse("c059a454-dafa-418e-a126-74d0c7219ce6")
+	locs := storage.Locations{id: "/home"}
+
+	loc, err := locs.Location(context.Background(), id)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, loc, &storage.Location{ID: id, Path: "/home"})
+
+	_, err = locs.Location(context.Background(), uuid.Nil)
+	assert.ErrorIs(t, err, storage.ErrUnknownLocation)
+}
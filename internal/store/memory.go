@@ -0,0 +1,1337 @@
+package store
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"crypto/subtle"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"iter"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/google/uuid"
+	"go.artefactual.dev/tools/ref"
+
+	adminv1 "github.com/artefactual-labs/ccp/internal/api/gen/archivematica/ccp/admin/v1beta1"
+	"github.com/artefactual-labs/ccp/internal/store/enums"
+	"github.com/artefactual-labs/ccp/internal/store/sqlcmysql"
+)
+
+// MemoryStore implements the Store interface in memory, for tests and demos.
+// It models the tables the other implementations use and follows the same
+// rules, e.g. the transient data removed at startup or the way unit
+// variables are updated. All methods are safe for concurrent use.
+//
+// The tables that CCP does not write to, i.e. dashboard settings, users and
+// files, are populated with the Create methods of MemoryStore.
+type MemoryStore struct {
+	logger logr.Logger
+
+	mu        sync.RWMutex
+	jobs      map[uuid.UUID]*memoryJob
+	tasks     map[uuid.UUID]*Task
+	transfers map[uuid.UUID]*memoryTransfer
+	sips      map[uuid.UUID]*SIP // SIPs and DIPs, like the SIPs table.
+	unitVars  map[uuid.UUID][]*memoryUnitVar
+	files     map[uuid.UUID]*memoryFile
+	settings  []memorySetting
+	users     map[int]*memoryUser
+	events    []*AuditEvent
+	queue     map[uuid.UUID]*QueueEntry
+	queueSeq  int64
+	leases    map[string]*memoryLease
+}
+
+type memoryJob struct {
+	sqlcmysql.CreateJobParams
+	seq int64 // Creation order, breaks ties between jobs created at once.
+}
+
+type memoryTransfer struct {
+	Transfer
+	accessionID    string
+	accessSystemID string
+	metadataSetID  uuid.NullUUID
+	status         int
+	completedAt    sql.NullTime
+}
+
+type memoryUnitVar struct {
+	packageType enums.PackageType
+	name        string
+	value       sql.NullString
+	linkID      uuid.NullUUID
+}
+
+type memoryFile struct {
+	File
+	transferID uuid.NullUUID
+	sipID      uuid.NullUUID
+}
+
+type memorySetting struct {
+	Scope string `json:"scope"`
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type memoryUser struct {
+	User
+	password string
+	apiKey   string
+}
+
+type memoryLease struct {
+	holder    string
+	expiresAt time.Time
+}
+
+var _ Store = (*MemoryStore)(nil)
+
+// NewMemoryStore returns an empty MemoryStore.
+func NewMemoryStore(logger logr.Logger) *MemoryStore {
+	return &MemoryStore{
+		logger:    logger,
+		jobs:      map[uuid.UUID]*memoryJob{},
+		tasks:     map[uuid.UUID]*Task{},
+		transfers: map[uuid.UUID]*memoryTransfer{},
+		sips:      map[uuid.UUID]*SIP{},
+		unitVars:  map[uuid.UUID][]*memoryUnitVar{},
+		files:     map[uuid.UUID]*memoryFile{},
+		users:     map[int]*memoryUser{},
+		queue:     map[uuid.UUID]*QueueEntry{},
+		leases:    map[string]*memoryLease{},
+	}
+}
+
+// memorySeed is the document loaded by newMemoryStore, e.g.:
+//
+//	{
+//	  "dashboardSettings": [
+//	    {"scope": "", "name": "dashboard_uuid", "value": "a0a6eb54-e5f4-4b8d-8e7a-1b1e4e1a7a2c"}
+//	  ],
+//	  "users": [
+//	    {"username": "demo", "password": "pbkdf2_sha256$...", "apiKey": "demo", "active": true, "groups": ["admins"]}
+//	  ]
+//	}
+type memorySeed struct {
+	DashboardSettings []memorySetting `json:"dashboardSettings"`
+	Users             []struct {
+		ID       int      `json:"id"`
+		Username string   `json:"username"`
+		Email    string   `json:"email"`
+		Password string   `json:"password"` // Encoded like in auth_user.
+		APIKey   string   `json:"apiKey"`
+		Active   bool     `json:"active"`
+		AgentID  *int     `json:"agentID"`
+		Groups   []string `json:"groups"`
+	} `json:"users"`
+}
+
+// newMemoryStore returns a MemoryStore populated with the JSON document found
+// at path, if any, see memorySeed.
+func newMemoryStore(logger logr.Logger, path string) (*MemoryStore, error) {
+	s := NewMemoryStore(logger)
+	if path == "" {
+		return s, nil
+	}
+
+	blob, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read seed: %v", err)
+	}
+	var seed memorySeed
+	if err := json.Unmarshal(blob, &seed); err != nil {
+		return nil, fmt.Errorf("decode seed: %v", err)
+	}
+
+	for _, item := range seed.DashboardSettings {
+		s.CreateDashboardSetting(item.Scope, item.Name, item.Value)
+	}
+	for _, item := range seed.Users {
+		s.CreateUser(&User{
+			ID:       item.ID,
+			Username: item.Username,
+			Email:    item.Email,
+			Active:   item.Active,
+			AgentID:  item.AgentID,
+			Groups:   item.Groups,
+		}, item.Password, item.APIKey)
+	}
+
+	logger.V(2).Info("Loaded seed.", "path", path, "settings", len(seed.DashboardSettings), "users", len(seed.Users))
+
+	return s, nil
+}
+
+// CreateDashboardSetting adds a dashboard setting, replacing the setting with
+// the same scope and name if it exists.
+func (s *MemoryStore) CreateDashboardSetting(scope, name, value string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i, item := range s.settings {
+		if item.Scope == scope && item.Name == name {
+			s.settings[i].Value = value
+			return
+		}
+	}
+
+	s.settings = append(s.settings, memorySetting{Scope: scope, Name: name, Value: value})
+}
+
+// CreateUser adds a user that belongs to user.Groups. The password must be
+// encoded like in Django's auth_user table, an empty apiKey means that the
+// user has no API key. A new identifier is assigned to the user when user.ID
+// is zero.
+func (s *MemoryStore) CreateUser(user *User, password, apiKey string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if user.ID == 0 {
+		for id := range s.users {
+			user.ID = max(user.ID, id)
+		}
+		user.ID++
+	}
+
+	u := &memoryUser{User: *user, password: password, apiKey: apiKey}
+	u.Groups = slices.Clone(user.Groups)
+	u.AgentID = cloneRef(user.AgentID)
+	s.users[user.ID] = u
+}
+
+// CreateFiles adds files to the package, which is a Transfer or a SIP.
+func (s *MemoryStore) CreateFiles(pkgID uuid.UUID, packageType enums.PackageType, files ...File) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, f := range files {
+		item := &memoryFile{File: f}
+		switch packageType {
+		case enums.PackageTypeTransfer:
+			item.transferID = uuid.NullUUID{UUID: pkgID, Valid: true}
+		case enums.PackageTypeSIP, enums.PackageTypeDIP:
+			item.sipID = uuid.NullUUID{UUID: pkgID, Valid: true}
+		default:
+			return fmt.Errorf("unexpected package type: %q", packageType)
+		}
+		s.files[f.ID] = item
+	}
+
+	return nil
+}
+
+// memoryTx implements the Tx interface. The changes are made in place while
+// RunInTx holds the lock, and undone in reverse order if the transaction is
+// rolled back.
+type memoryTx struct {
+	s    *MemoryStore
+	undo []func()
+}
+
+var _ Tx = (*memoryTx)(nil)
+
+func (t *memoryTx) onRollback(fn func()) {
+	t.undo = append(t.undo, fn)
+}
+
+func (s *MemoryStore) RunInTx(ctx context.Context, fn func(tx Tx) error) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tx := &memoryTx{s: s}
+	if err := fn(tx); err != nil {
+		for _, undo := range slices.Backward(tx.undo) {
+			undo()
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (s *MemoryStore) RemoveTransientData(ctx context.Context) (err error) {
+	defer wrap(&err, "RemoveTransientData")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := sql.NullTime{Time: time.Now(), Valid: true}
+
+	for _, t := range s.tasks {
+		if !t.ExitCode.Valid {
+			t.ExitCode = sql.NullInt16{Int16: -1, Valid: true}
+			t.Stderr = "MCP shut down while processing."
+		}
+	}
+
+	for id, t := range s.transfers {
+		if _, queued := s.queue[id]; !queued && (t.status == 0 || t.status == 1) {
+			t.status, t.completedAt = 4, now
+		}
+	}
+
+	for id, job := range s.jobs {
+		if job.Currentstep != 1 {
+			continue
+		}
+		for taskID, t := range s.tasks {
+			if t.JobID == id {
+				delete(s.tasks, taskID)
+			}
+		}
+		delete(s.jobs, id)
+	}
+
+	for id, sip := range s.sips {
+		if _, queued := s.queue[id]; !queued && (sip.Status == 0 || sip.Status == 1) {
+			sip.Status, sip.CompletedAt = 4, now.Time
+		}
+	}
+
+	for _, job := range s.jobs {
+		if job.Currentstep == 3 {
+			job.Currentstep = 4
+		}
+	}
+
+	return nil
+}
+
+func (s *MemoryStore) CreateJob(ctx context.Context, params *sqlcmysql.CreateJobParams) (err error) {
+	defer wrap(&err, "CreateJob")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.jobs[params.ID]; ok {
+		return fmt.Errorf("job %s already exists", params.ID)
+	}
+
+	s.jobs[params.ID] = &memoryJob{CreateJobParams: *params, seq: int64(len(s.jobs))}
+
+	return nil
+}
+
+func (s *MemoryStore) UpdateJobStatus(ctx context.Context, id uuid.UUID, status string) error {
+	return s.RunInTx(ctx, func(tx Tx) error {
+		return tx.UpdateJobStatus(ctx, id, status)
+	})
+}
+
+func (t *memoryTx) UpdateJobStatus(ctx context.Context, id uuid.UUID, status string) (err error) {
+	defer wrap(&err, "UpdateJobStatus(%s, %s)", id, status)
+
+	step, err := jobStep(status)
+	if err != nil {
+		return err
+	}
+
+	if job, ok := t.s.jobs[id]; ok {
+		prev := job.Currentstep
+		t.onRollback(func() { job.Currentstep = prev })
+		job.Currentstep = step
+	}
+
+	return nil
+}
+
+func (s *MemoryStore) FindAwaitingJob(ctx context.Context, params *FindAwaitingJobParams) (_ *adminv1.Job, err error) {
+	defer wrap(&err, "FindAwaitingJob(ctx, params)")
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var found *memoryJob
+	for _, job := range s.sortedJobs() {
+		if job.Currentstep != 1 {
+			continue
+		}
+		if params.Directory != nil { // ApproveTransferByPath
+			if job.Directory != *params.Directory {
+				continue
+			}
+		} else if params.PackageID != nil { // ApprovePartialReingest
+			if job.SIPID != *params.PackageID || job.Microservicegroup != ref.DerefZero(params.Group) {
+				continue
+			}
+		}
+		found = job
+		break
+	}
+	if found == nil {
+		return nil, ErrNotFound
+	}
+
+	ret := &adminv1.Job{
+		Id:        found.ID.String(),
+		PackageId: found.SIPID.String(),
+	}
+
+	return ret, nil
+}
+
+func (s *MemoryStore) ListJobs(ctx context.Context, pkgID uuid.UUID) (_ []*adminv1.Job, err error) {
+	defer wrap(&err, "ListJobs(%s)", pkgID)
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	ret := []*adminv1.Job{}
+	jobs := s.sortedJobs()
+	slices.Reverse(jobs) // Most recent first.
+	for _, j := range jobs {
+		if j.SIPID != pkgID {
+			continue
+		}
+		job, err := j.convert()
+		if err != nil {
+			return nil, fmt.Errorf("convert: %v", err)
+		}
+		ret = append(ret, job)
+	}
+
+	return ret, nil
+}
+
+func (s *MemoryStore) ListPackageJobs(ctx context.Context, packageType adminv1.PackageType) (_ map[uuid.UUID][]*adminv1.Job, err error) {
+	defer wrap(&err, "ListPackageJobs(%s)", packageType)
+
+	if packageType != adminv1.PackageType_PACKAGE_TYPE_TRANSFER && packageType != adminv1.PackageType_PACKAGE_TYPE_SIP {
+		return nil, fmt.Errorf("unsupported package type: %s", packageType)
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	ret := map[uuid.UUID][]*adminv1.Job{}
+	jobs := s.sortedJobs()
+	slices.Reverse(jobs) // Most recent first.
+	for _, j := range jobs {
+		// Jobs are selected by package identifier like in ListJobs, the
+		// DIP jobs of a SIP share its identifier.
+		if !s.visible(j.SIPID, packageType) {
+			continue
+		}
+		job, err := j.convert()
+		if err != nil {
+			return nil, fmt.Errorf("convert: %v", err)
+		}
+		ret[j.SIPID] = append(ret[j.SIPID], job)
+	}
+
+	return ret, nil
+}
+
+// visible reports whether a package is listed, i.e. it exists and it's not
+// hidden. Transfers created by CCP are never hidden.
+func (s *MemoryStore) visible(id uuid.UUID, packageType adminv1.PackageType) bool {
+	if packageType == adminv1.PackageType_PACKAGE_TYPE_TRANSFER {
+		_, ok := s.transfers[id]
+		return ok
+	}
+	sip, ok := s.sips[id]
+
+	return ok && !sip.Hidden
+}
+
+// convert returns the job as it's listed.
+func (j *memoryJob) convert() (*adminv1.Job, error) {
+	job := &adminv1.Job{
+		Id:              j.ID.String(),
+		PackageId:       j.SIPID.String(),
+		PackageType:     jobPackageType(j.Unittype),
+		Directory:       j.Directory,
+		LinkId:          j.LinkID.UUID.String(),
+		LinkDescription: j.Type,
+		Hidden:          j.Hidden,
+		Group:           j.Microservicegroup,
+		Status:          adminv1.JobStatus(j.Currentstep),
+	}
+	if err := updateTimeWithFraction(&job.CreatedAt, j.CreatedAt, j.Createdtimedec); err != nil {
+		return nil, err
+	}
+
+	return job, nil
+}
+
+// sortedJobs returns the jobs in the order they were created.
+func (s *MemoryStore) sortedJobs() []*memoryJob {
+	jobs := make([]*memoryJob, 0, len(s.jobs))
+	for _, job := range s.jobs {
+		jobs = append(jobs, job)
+	}
+	slices.SortFunc(jobs, func(a, b *memoryJob) int {
+		return cmp.Or(a.CreatedAt.Compare(b.CreatedAt), cmp.Compare(a.seq, b.seq))
+	})
+
+	return jobs
+}
+
+func (s *MemoryStore) CreateTasks(ctx context.Context, tasks []*Task) (err error) {
+	defer wrap(&err, "CreateTasks(tasks)")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, t := range tasks {
+		if _, ok := s.tasks[t.ID]; ok {
+			return fmt.Errorf("task %s already exists", t.ID)
+		}
+		if _, ok := s.jobs[t.JobID]; !ok {
+			return fmt.Errorf("job %s does not exist", t.JobID)
+		}
+	}
+
+	for _, t := range tasks {
+		task := *t
+		s.tasks[t.ID] = &task
+	}
+
+	return nil
+}
+
+func (s *MemoryStore) UpdateTasks(ctx context.Context, results []*TaskResult) (err error) {
+	defer wrap(&err, "UpdateTasks(results)")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, r := range results {
+		t, ok := s.tasks[r.ID]
+		if !ok {
+			continue
+		}
+		t.EndedAt = sql.NullTime{Time: r.EndedAt, Valid: true}
+		t.ExitCode = sql.NullInt16{Int16: r.ExitCode, Valid: true}
+		t.Stdout = r.Stdout
+		t.Stderr = r.Stderr
+	}
+
+	return nil
+}
+
+func (s *MemoryStore) ReadPackagesWithCreationTimestamps(ctx context.Context, packageType adminv1.PackageType) (ret []*adminv1.Package, err error) {
+	defer wrap(&err, "ReadPackagesWithCreationTimestamps(%s)", packageType)
+
+	var unitType string
+	switch packageType {
+	case adminv1.PackageType_PACKAGE_TYPE_TRANSFER:
+		unitType = "unitTransfer"
+	case adminv1.PackageType_PACKAGE_TYPE_SIP:
+		unitType = "unitSIP"
+	default:
+		return nil, fmt.Errorf("unsupported package type: %s", packageType)
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	// The most recent job of each package, in the order they were created.
+	latest := map[uuid.UUID]*memoryJob{}
+	var ids []uuid.UUID
+	for _, job := range s.sortedJobs() {
+		if job.Unittype != unitType {
+			continue
+		}
+		if _, ok := latest[job.SIPID]; !ok {
+			ids = append(ids, job.SIPID)
+		}
+		latest[job.SIPID] = job
+	}
+
+	ret = make([]*adminv1.Package, 0, len(ids))
+	for _, id := range ids {
+		if !s.visible(id, packageType) {
+			continue
+		}
+		var status int
+		if packageType == adminv1.PackageType_PACKAGE_TYPE_TRANSFER {
+			status = s.transfers[id].status
+		} else {
+			status = s.sips[id].Status
+		}
+		pkg := &adminv1.Package{
+			Id:     id.String(),
+			Status: adminv1.PackageStatus(int32(status)),
+		}
+		job := latest[id]
+		if err := updateTimeWithFraction(&pkg.CreatedAt, job.CreatedAt, job.Createdtimedec); err != nil {
+			return nil, err
+		}
+		ret = append(ret, pkg)
+	}
+
+	return ret, nil
+}
+
+func (s *MemoryStore) UpdatePackageStatus(ctx context.Context, id uuid.UUID, packageType enums.PackageType, status enums.PackageStatus) error {
+	return s.RunInTx(ctx, func(tx Tx) error {
+		return tx.UpdatePackageStatus(ctx, id, packageType, status)
+	})
+}
+
+func (t *memoryTx) UpdatePackageStatus(ctx context.Context, id uuid.UUID, packageType enums.PackageType, status enums.PackageStatus) (err error) {
+	defer wrap(&err, "UpdatePackageStatus(%s, %s, %s)", id, packageType, status)
+
+	if !packageType.IsValid() {
+		return fmt.Errorf("invalid type: %q", packageType)
+	}
+	if !status.IsValid() {
+		return fmt.Errorf("invalid status: %d", status)
+	}
+
+	completed := status == enums.PackageStatusCompletedSuccessfully
+	now := time.Now()
+
+	switch packageType {
+	case enums.PackageTypeTransfer:
+		if transfer, ok := t.s.transfers[id]; ok {
+			prev := *transfer
+			t.onRollback(func() { *transfer = prev })
+			transfer.status = int(status)
+			if completed {
+				transfer.completedAt = sql.NullTime{Time: now, Valid: true}
+			}
+		}
+	case enums.PackageTypeDIP, enums.PackageTypeSIP:
+		if sip, ok := t.s.sips[id]; ok {
+			prev := *sip
+			t.onRollback(func() { *sip = prev })
+			sip.Status = int(status)
+			if completed {
+				sip.CompletedAt = now
+			}
+		}
+	default:
+		return fmt.Errorf("unknown unit type: %q", packageType)
+	}
+
+	return nil
+}
+
+func (s *MemoryStore) ReadTransferLocation(ctx context.Context, id uuid.UUID) (loc string, err error) {
+	defer wrap(&err, "ReadTransferLocation(%s)", id)
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	t, ok := s.transfers[id]
+	if !ok {
+		return "", ErrNotFound
+	}
+
+	return t.CurrentPath, nil
+}
+
+func (s *MemoryStore) ReadTransferWithIdempotencyKey(ctx context.Context, key string) (_ uuid.UUID, _ string, err error) {
+	defer wrap(&err, "ReadTransferWithIdempotencyKey(%s)", key)
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for id, vars := range s.unitVars {
+		var found bool
+		var digest string
+		for _, uv := range vars {
+			if uv.packageType != enums.PackageTypeTransfer {
+				continue
+			}
+			switch uv.name {
+			case "idempotencyKey":
+				found = found || (uv.value.Valid && uv.value.String == key)
+			case "idempotencyDigest":
+				digest = uv.value.String
+			}
+		}
+		if found {
+			return id, digest, nil
+		}
+	}
+
+	return uuid.Nil, "", ErrNotFound
+}
+
+func (s *MemoryStore) CreateTransfer(ctx context.Context, id uuid.UUID, accessionID, accessSystemID string, metadataSetID uuid.UUID) (err error) {
+	defer wrap(&err, "CreateTransfer(%s, %s, %s, %s)", id, accessionID, accessSystemID, metadataSetID)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.transfers[id]; ok {
+		return fmt.Errorf("transfer %s already exists", id)
+	}
+
+	t := &memoryTransfer{
+		Transfer:       Transfer{ID: id},
+		accessionID:    accessionID,
+		accessSystemID: accessSystemID,
+	}
+	if metadataSetID != uuid.Nil {
+		t.metadataSetID = uuid.NullUUID{UUID: metadataSetID, Valid: true}
+	}
+	s.transfers[id] = t
+
+	return nil
+}
+
+func (s *MemoryStore) ReadTransfer(ctx context.Context, id uuid.UUID) (_ Transfer, err error) {
+	defer wrap(&err, "ReadTransfer(%s)", id)
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	t, ok := s.transfers[id]
+	if !ok {
+		return Transfer{}, ErrNotFound
+	}
+
+	ret := t.Transfer
+	ret.Status = transferStatus(t.status)
+
+	return ret, nil
+}
+
+func (s *MemoryStore) UpsertTransfer(ctx context.Context, id uuid.UUID, path string) (_ bool, err error) {
+	defer wrap(&err, "UpsertTransfer(%s, %s)", id, path)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if t, ok := s.transfers[id]; ok {
+		t.CurrentPath = path
+		return false, nil
+	}
+
+	s.transfers[id] = &memoryTransfer{Transfer: Transfer{ID: id, CurrentPath: path}}
+
+	return true, nil
+}
+
+func (s *MemoryStore) EnsureTransfer(ctx context.Context, path string) (_ uuid.UUID, _ bool, err error) {
+	defer wrap(&err, "EnsureTransfer(%s)", path)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for id, t := range s.transfers {
+		if t.CurrentPath == path {
+			return id, false, nil // Transfer found!
+		}
+	}
+
+	id := uuid.New()
+	s.transfers[id] = &memoryTransfer{Transfer: Transfer{ID: id, CurrentPath: path}}
+
+	return id, true, nil
+}
+
+func (s *MemoryStore) UpdateTransferLocation(ctx context.Context, id uuid.UUID, path string) (err error) {
+	defer wrap(&err, "UpdateTransferLocation(%s, %s)", id, path)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if t, ok := s.transfers[id]; ok {
+		t.CurrentPath = path
+	}
+
+	return nil
+}
+
+func (s *MemoryStore) ReadSIP(ctx context.Context, id uuid.UUID) (_ SIP, err error) {
+	defer wrap(&err, "ReadSIP(%s)", id)
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	sip, ok := s.sips[id]
+	if !ok {
+		return SIP{}, ErrNotFound
+	}
+
+	return *sip, nil
+}
+
+func (s *MemoryStore) UpsertSIP(ctx context.Context, id uuid.UUID, path string) (_ bool, err error) {
+	defer wrap(&err, "UpsertSIP(%s, %s)", id, path)
+
+	return s.upsertSIP(id, path, "SIP", true), nil
+}
+
+func (s *MemoryStore) EnsureSIP(ctx context.Context, path string) (_ uuid.UUID, _ bool, err error) {
+	defer wrap(&err, "EnsureSIP(%s)", path)
+
+	id, created := s.ensureSIP(path, "SIP")
+
+	return id, created, nil
+}
+
+func (s *MemoryStore) ReadDIP(ctx context.Context, id uuid.UUID) (_ DIP, err error) {
+	defer wrap(&err, "ReadDIP(%s)", id)
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	sip, ok := s.sips[id]
+	if !ok {
+		return DIP{}, ErrNotFound
+	}
+
+	return DIP{
+		ID:          sip.ID,
+		CreatedAt:   sip.CreatedAt,
+		CurrentPath: sip.CurrentPath,
+		Hidden:      sip.Hidden,
+		AIPFilename: sip.AIPFilename,
+		DirIDs:      sip.DirIDs,
+		Status:      sip.Status,
+		CompletedAt: sip.CompletedAt,
+	}, nil
+}
+
+func (s *MemoryStore) UpsertDIP(ctx context.Context, id uuid.UUID, path string) (_ bool, err error) {
+	defer wrap(&err, "UpsertDIP(%s, %s)", id, path)
+
+	// Like mysqlStoreImpl, the location of an existing DIP is not updated.
+	return s.upsertSIP(id, path, "DIP", false), nil
+}
+
+func (s *MemoryStore) EnsureDIP(ctx context.Context, path string) (_ uuid.UUID, _ bool, err error) {
+	defer wrap(&err, "EnsureDIP(%s)", path)
+
+	id, created := s.ensureSIP(path, "DIP")
+
+	return id, created, nil
+}
+
+// upsertSIP creates a SIP or a DIP, they share the same table.
+func (s *MemoryStore) upsertSIP(id uuid.UUID, path, sipType string, update bool) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if sip, ok := s.sips[id]; ok {
+		if update {
+			sip.CurrentPath = path
+		}
+		return false
+	}
+
+	s.sips[id] = &SIP{ID: id, CreatedAt: time.Now(), CurrentPath: path, Type: sipType}
+
+	return true
+}
+
+func (s *MemoryStore) ensureSIP(path, sipType string) (uuid.UUID, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for id, sip := range s.sips {
+		if sip.CurrentPath == path {
+			return id, false
+		}
+	}
+
+	id := uuid.New()
+	s.sips[id] = &SIP{ID: id, CreatedAt: time.Now(), CurrentPath: path, Type: sipType}
+
+	return id, true
+}
+
+func (s *MemoryStore) ReadUnitVars(ctx context.Context, id uuid.UUID, packageType enums.PackageType, name string) (vars []UnitVar, err error) {
+	defer wrap(&err, "ReadUnitVars(%s, %s)", packageType, name)
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for _, item := range s.unitVars[id] {
+		if item.name != name {
+			continue
+		}
+		if packageType != "" && packageType != item.packageType {
+			continue // Filter by package type if requested.
+		}
+		uv := UnitVar{}
+		if item.value.Valid {
+			uv.Value = ref.New(item.value.String)
+		}
+		if item.linkID.Valid {
+			uv.LinkID = ref.New(item.linkID.UUID)
+		}
+		vars = append(vars, uv)
+	}
+
+	return vars, nil
+}
+
+func (s *MemoryStore) ReadUnitVar(ctx context.Context, id uuid.UUID, packageType enums.PackageType, name string) (_ string, err error) {
+	defer wrap(&err, "ReadUnitVar(%s, %s, %s)", id, packageType, name)
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	uv := s.unitVar(id, packageType, name)
+	if uv == nil {
+		return "", ErrNotFound
+	}
+
+	return uv.value.String, nil
+}
+
+func (s *MemoryStore) ReadUnitLinkID(ctx context.Context, id uuid.UUID, packageType enums.PackageType, name string) (_ uuid.UUID, err error) {
+	defer wrap(&err, "ReadUnitVarLinkID(%s, %s, %s)", id, packageType, name)
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	uv := s.unitVar(id, packageType, name)
+	if uv == nil {
+		return uuid.Nil, ErrNotFound
+	}
+
+	return uv.linkID.UUID, nil
+}
+
+func (s *MemoryStore) CreateUnitVar(ctx context.Context, id uuid.UUID, packageType enums.PackageType, name, value string, linkID uuid.UUID, updateExisting bool) error {
+	return s.RunInTx(ctx, func(tx Tx) error {
+		return tx.CreateUnitVar(ctx, id, packageType, name, value, linkID, updateExisting)
+	})
+}
+
+func (t *memoryTx) CreateUnitVar(ctx context.Context, id uuid.UUID, packageType enums.PackageType, name, value string, linkID uuid.UUID, updateExisting bool) (err error) {
+	defer wrap(&err, "CreateUnitVar(%s, %s, %s, %s)", id, packageType, name, value)
+
+	wantValue, wantLinkID, err := unitVarValue(value, linkID)
+	if err != nil {
+		return err
+	}
+
+	uv := t.s.unitVar(id, packageType, name)
+
+	// It exists but it does not require further updates.
+	if uv != nil && wantValue == uv.value && wantLinkID == uv.linkID {
+		return nil
+	}
+
+	// It exists and requires further updates but we rather raise an error.
+	if !updateExisting {
+		return errors.New("variable exists but with different propreties")
+	}
+
+	if uv != nil {
+		prev := *uv
+		t.onRollback(func() { *uv = prev })
+		uv.value, uv.linkID = wantValue, wantLinkID
+	} else {
+		prev := t.s.unitVars[id]
+		t.onRollback(func() {
+			if len(prev) == 0 {
+				delete(t.s.unitVars, id)
+			} else {
+				t.s.unitVars[id] = prev
+			}
+		})
+		t.s.unitVars[id] = append(slices.Clip(prev), &memoryUnitVar{
+			packageType: packageType,
+			name:        name,
+			value:       wantValue,
+			linkID:      wantLinkID,
+		})
+	}
+
+	return nil
+}
+
+func (s *MemoryStore) unitVar(id uuid.UUID, packageType enums.PackageType, name string) *memoryUnitVar {
+	for _, uv := range s.unitVars[id] {
+		if uv.packageType == packageType && uv.name == name {
+			return uv
+		}
+	}
+
+	return nil
+}
+
+func (s *MemoryStore) Files(ctx context.Context, id uuid.UUID, packageType enums.PackageType, filterFilenameEnd, filterSubdir, replacementPath string) iter.Seq2[File, error] {
+	return listFiles(func(after uuid.UUID) (_ []File, err error) {
+		defer wrap(&err, "Files(%s, %s, %s, %s, %s)", id, packageType, filterFilenameEnd, filterSubdir, replacementPath)
+
+		var owner func(*memoryFile) uuid.NullUUID
+		switch packageType {
+		case enums.PackageTypeTransfer:
+			owner = func(f *memoryFile) uuid.NullUUID { return f.transferID }
+		case enums.PackageTypeSIP, enums.PackageTypeDIP:
+			owner = func(f *memoryFile) uuid.NullUUID { return f.sipID }
+		default:
+			return nil, fmt.Errorf("unexpected package type: %q", packageType)
+		}
+
+		s.mu.RLock()
+		defer s.mu.RUnlock()
+
+		ret := []File{}
+		for _, f := range s.files {
+			if pkgID := owner(f); !pkgID.Valid || pkgID.UUID != id {
+				continue
+			}
+			if bytes.Compare(f.ID[:], after[:]) <= 0 {
+				continue
+			}
+			if filterFilenameEnd != "" && !strings.HasSuffix(f.CurrentLocation, filterFilenameEnd) {
+				continue
+			}
+			if filterSubdir != "" && !strings.HasPrefix(f.CurrentLocation, replacementPath+filterSubdir) {
+				continue
+			}
+			ret = append(ret, f.File)
+		}
+
+		// Sorted by identifier, like the other implementations.
+		slices.SortFunc(ret, func(a, b File) int {
+			return bytes.Compare(a.ID[:], b.ID[:])
+		})
+
+		return ret[:min(len(ret), filesPageSize)], nil
+	})
+}
+
+func (s *MemoryStore) KnownFileLocations(ctx context.Context, id uuid.UUID, packageType enums.PackageType, locations []string) (_ map[string]struct{}, err error) {
+	defer wrap(&err, "KnownFileLocations(%s, %s)", id, packageType)
+
+	var owner func(*memoryFile) uuid.NullUUID
+	switch packageType {
+	case enums.PackageTypeTransfer:
+		owner = func(f *memoryFile) uuid.NullUUID { return f.transferID }
+	case enums.PackageTypeSIP, enums.PackageTypeDIP:
+		owner = func(f *memoryFile) uuid.NullUUID { return f.sipID }
+	default:
+		return nil, fmt.Errorf("unexpected package type: %q", packageType)
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	ret := map[string]struct{}{}
+	for _, f := range s.files {
+		if pkgID := owner(f); !pkgID.Valid || pkgID.UUID != id {
+			continue
+		}
+		if slices.Contains(locations, f.CurrentLocation) {
+			ret[f.CurrentLocation] = struct{}{}
+		}
+	}
+
+	return ret, nil
+}
+
+func (s *MemoryStore) ReadPipelineID(ctx context.Context) (_ uuid.UUID, err error) {
+	defer wrap(&err, "ReadPipelineID()")
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for _, item := range s.settings {
+		if item.Name == "dashboard_uuid" {
+			return uuid.Parse(item.Value)
+		}
+	}
+
+	return uuid.Nil, ErrNotFound
+}
+
+func (s *MemoryStore) ReadDict(ctx context.Context, name string) (_ map[string]string, err error) {
+	defer wrap(&err, "ReadDict(%s)", name)
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	ret := map[string]string{}
+	for _, item := range s.settings {
+		if item.Scope == name {
+			ret[item.Name] = item.Value
+		}
+	}
+	if len(ret) == 0 {
+		return nil, ErrNotFound
+	}
+
+	return ret, nil
+}
+
+func (s *MemoryStore) ValidateUserAPIKey(ctx context.Context, username, key string) (_ *User, err error) {
+	defer wrap(&err, "ValidateUserAPIKey(%q, %q)", username, key)
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	u := s.userWithUsername(username)
+	if u == nil || !u.Active || u.apiKey == "" {
+		return nil, nil
+	}
+	if subtle.ConstantTimeCompare([]byte(u.apiKey), []byte(key)) != 1 {
+		return nil, nil
+	}
+
+	return u.user(), nil
+}
+
+func (s *MemoryStore) ReadUserWithUsername(ctx context.Context, username string) (_ *User, err error) {
+	defer wrap(&err, "ReadUserWithUsername(%q)", username)
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	u := s.userWithUsername(username)
+	if u == nil {
+		return nil, ErrNotFound
+	}
+
+	return u.user(), nil
+}
+
+func (s *MemoryStore) ValidateUserPassword(ctx context.Context, username, password string) (_ *User, err error) {
+	defer wrap(&err, "ValidateUserPassword(%q)", username)
+
+	s.mu.RLock()
+	u := s.userWithUsername(username)
+	s.mu.RUnlock()
+
+	if u == nil || !u.Active {
+		return nil, nil
+	}
+
+	// Checking the password is slow, the lock is not held meanwhile.
+	if !checkPassword(u.password, password) {
+		return nil, nil
+	}
+
+	return u.user(), nil
+}
+
+func (s *MemoryStore) userWithUsername(username string) *memoryUser {
+	for _, u := range s.users {
+		if u.Username == username {
+			return u
+		}
+	}
+
+	return nil
+}
+
+// user returns a copy of the user as returned by the other implementations,
+// which do not populate the groups.
+func (u *memoryUser) user() *User {
+	return &User{
+		ID:       u.ID,
+		Username: u.Username,
+		Email:    u.Email,
+		Active:   u.Active,
+		AgentID:  cloneRef(u.AgentID),
+	}
+}
+
+func (s *MemoryStore) ReadUserAPIKey(ctx context.Context, userID int) (_ string, err error) {
+	defer wrap(&err, "ReadUserAPIKey(%d)", userID)
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	u, ok := s.users[userID]
+	if !ok || u.apiKey == "" {
+		return "", ErrNotFound
+	}
+
+	return u.apiKey, nil
+}
+
+func (s *MemoryStore) ReadUserGroups(ctx context.Context, userID int) (_ []string, err error) {
+	defer wrap(&err, "ReadUserGroups(%d)", userID)
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	ret := []string{}
+	if u, ok := s.users[userID]; ok {
+		ret = append(ret, u.Groups...)
+	}
+	slices.Sort(ret)
+
+	return ret, nil
+}
+
+func (s *MemoryStore) CreateAuditEvent(ctx context.Context, event *AuditEvent) (err error) {
+	defer wrap(&err, "CreateAuditEvent(%s)", event.Procedure)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	event.ID = int64(len(s.events)) + 1
+	item := *event
+	s.events = append(s.events, &item)
+
+	return nil
+}
+
+func (s *MemoryStore) ListAuditEvents(ctx context.Context, params *ListAuditEventsParams) (_ []*AuditEvent, err error) {
+	defer wrap(&err, "ListAuditEvents(params)")
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	ret := []*AuditEvent{}
+	for i := len(s.events) - 1; i >= 0; i-- {
+		e := s.events[i]
+		switch {
+		case params.Username != nil && e.Username != *params.Username,
+			params.Procedure != nil && e.Procedure != *params.Procedure,
+			params.PackageID != nil && (!e.PackageID.Valid || e.PackageID.UUID != *params.PackageID),
+			params.DecisionID != nil && (!e.DecisionID.Valid || e.DecisionID.UUID != *params.DecisionID),
+			params.Outcome != nil && e.Outcome != *params.Outcome,
+			params.Since != nil && e.CreatedAt.Before(*params.Since),
+			params.Until != nil && !e.CreatedAt.Before(*params.Until),
+			params.BeforeID != nil && e.ID >= *params.BeforeID:
+			continue
+		}
+		item := *e
+		ret = append(ret, &item)
+		if params.Limit > 0 && uint(len(ret)) == params.Limit {
+			break
+		}
+	}
+
+	return ret, nil
+}
+
+func (s *MemoryStore) CreateQueueEntry(ctx context.Context, entry *QueueEntry) (err error) {
+	defer wrap(&err, "CreateQueueEntry(%s)", entry.PackageID)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// The entry of a package that is already queued keeps its identifier.
+	if item, ok := s.queue[entry.PackageID]; ok {
+		entry.ID = item.ID
+	} else {
+		s.queueSeq++
+		entry.ID = s.queueSeq
+	}
+	item := *entry
+	s.queue[entry.PackageID] = &item
+
+	return nil
+}
+
+func (s *MemoryStore) UpdateQueueEntryTag(ctx context.Context, pkgID uuid.UUID, tag float64) (err error) {
+	defer wrap(&err, "UpdateQueueEntryTag(%s, %f)", pkgID, tag)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if item, ok := s.queue[pkgID]; ok {
+		item.Tag = tag
+	}
+
+	return nil
+}
+
+func (s *MemoryStore) DeleteQueueEntry(ctx context.Context, pkgID uuid.UUID) (err error) {
+	defer wrap(&err, "DeleteQueueEntry(%s)", pkgID)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.queue, pkgID)
+
+	return nil
+}
+
+func (s *MemoryStore) ListQueueEntries(ctx context.Context) (_ []*QueueEntry, err error) {
+	defer wrap(&err, "ListQueueEntries()")
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	ret := make([]*QueueEntry, 0, len(s.queue))
+	for _, item := range s.queue {
+		entry := *item
+		ret = append(ret, &entry)
+	}
+	slices.SortFunc(ret, func(a, b *QueueEntry) int {
+		return cmp.Or(cmp.Compare(a.Tag, b.Tag), cmp.Compare(a.ID, b.ID))
+	})
+
+	return ret, nil
+}
+
+func (s *MemoryStore) AcquireLease(ctx context.Context, name, holder string, ttl time.Duration) (_ bool, err error) {
+	defer wrap(&err, "AcquireLease(%s, %s, %s)", name, holder, ttl)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	if l, ok := s.leases[name]; ok && l.holder != holder && l.expiresAt.After(now) {
+		return false, nil // Held by someone else.
+	}
+
+	s.leases[name] = &memoryLease{holder: holder, expiresAt: now.Add(ttl)}
+
+	return true, nil
+}
+
+func (s *MemoryStore) ReadLeaseHolder(ctx context.Context, name string) (_ string, err error) {
+	defer wrap(&err, "ReadLeaseHolder(%s)", name)
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	l, ok := s.leases[name]
+	if !ok || !l.expiresAt.After(time.Now()) {
+		return "", ErrNotFound
+	}
+
+	return l.holder, nil
+}
+
+func (s *MemoryStore) ReleaseLease(ctx context.Context, name, holder string) (err error) {
+	defer wrap(&err, "ReleaseLease(%s, %s)", name, holder)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if l, ok := s.leases[name]; ok && l.holder == holder {
+		delete(s.leases, name)
+	}
+
+	return nil
+}
+
+func (s *MemoryStore) Ping(ctx context.Context) error {
+	return nil
+}
+
+func (s *MemoryStore) Running() bool {
+	return s != nil
+}
+
+func (s *MemoryStore) Close() error {
+	return nil
+}
+
+// cloneRef returns a pointer to a copy of the value v points to, or nil.
+func cloneRef[T any](v *T) *T {
+	if v == nil {
+		return nil
+	}
+
+	return ref.New(*v)
+}
@@ -0,0 +1,81 @@
+package store
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/google/uuid"
+	"gotest.tools/v3/assert"
+
+	"github.com/artefactual-labs/ccp/internal/store/enums"
+)
+
+func TestMemoryStore(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Loads the seed", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		path := filepath.Join(t.TempDir(), "seed.json")
+		err := os.WriteFile(path, []byte(`{
+			"dashboardSettings": [
+				{"scope": "", "name": "dashboard_uuid", "value": "`+pipelineID.String()+`"}
+			],
+			"users": [
+				{"username": "demo", "email": "demo@example.com", "password": "`+encodedPassword+`", "apiKey": "demo-key", "active": true, "groups": ["admins"]}
+			]
+		}`), 0o600)
+		assert.NilError(t, err)
+
+		s, err := New(logr.Discard(), "memory", path)
+		assert.NilError(t, err)
+		defer s.Close()
+
+		id, err := s.ReadPipelineID(ctx)
+		assert.NilError(t, err)
+		assert.Equal(t, id, pipelineID)
+
+		user, err := s.ValidateUserPassword(ctx, "demo", "test")
+		assert.NilError(t, err)
+		assert.DeepEqual(t, user, &User{ID: 1, Username: "demo", Email: "demo@example.com", Active: true})
+
+		groups, err := s.ReadUserGroups(ctx, user.ID)
+		assert.NilError(t, err)
+		assert.DeepEqual(t, groups, []string{"admins"})
+	})
+
+	t.Run("Rejects an invalid seed", func(t *testing.T) {
+		t.Parallel()
+
+		path := filepath.Join(t.TempDir(), "seed.json")
+		assert.NilError(t, os.WriteFile(path, []byte("{"), 0o600))
+
+		_, err := New(logr.Discard(), "memory", path)
+		assert.ErrorContains(t, err, "new memory store: decode seed")
+	})
+
+	t.Run("Lists the files of a SIP", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		s := NewMemoryStore(logr.Discard())
+		sipID := uuid.New()
+		f := File{ID: uuid.New(), CurrentLocation: "%SIPDirectory%objects/a.txt", FileGrpUse: "original"}
+		assert.NilError(t, s.CreateFiles(sipID, enums.PackageTypeSIP, f))
+
+		files, err := collectFiles(s.Files(ctx, sipID, enums.PackageTypeSIP, "", "objects/", "%SIPDirectory%"))
+		assert.NilError(t, err)
+		assert.DeepEqual(t, files, []File{f})
+
+		files, err = collectFiles(s.Files(ctx, sipID, enums.PackageTypeTransfer, "", "", ""))
+		assert.NilError(t, err)
+		assert.Equal(t, len(files), 0)
+
+		err = s.CreateFiles(sipID, enums.PackageType("unknown"), f)
+		assert.ErrorContains(t, err, "unexpected package type")
+	})
+}
@@ -0,0 +1,451 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+)
+
+// migrationsFS holds the schema migrations of each driver, e.g.
+// migrations/mysql/0001_ccp_tables.up.sql. The migrations of PostgreSQL and
+// SQLite create the whole schema, in MySQL they only create the tables that
+// are not managed by the Dashboard.
+//
+//go:embed migrations
+var migrationsFS embed.FS
+
+// ErrSchemaVersion is returned when the database schema is not at the version
+// expected by this binary.
+var ErrSchemaVersion = errors.New("unexpected database schema version")
+
+// Migration is a versioned change of the database schema.
+type Migration struct {
+	Version   int
+	Name      string
+	AppliedAt time.Time // Zero value if the migration is pending.
+
+	up, down string
+}
+
+// Applied reports whether the migration has been applied to the database.
+func (m Migration) Applied() bool {
+	return !m.AppliedAt.IsZero()
+}
+
+// Known reports whether the migration is embedded in this binary, i.e. it is
+// false for the migrations applied by a newer version.
+func (m Migration) Known() bool {
+	return m.up != ""
+}
+
+func (m Migration) String() string {
+	return fmt.Sprintf("%04d_%s", m.Version, m.Name)
+}
+
+// migrationDialect holds the statements used to record the applied migrations
+// in the SchemaMigrations table, and to take the session lock that keeps two
+// instances from migrating the database at the same time.
+type migrationDialect struct {
+	lock        string
+	unlock      string
+	tableExists string
+	createTable string
+	list        string
+	insert      string
+	delete      string
+}
+
+var migrationDialects = map[string]migrationDialect{
+	"mysql": {
+		lock:        "SELECT GET_LOCK('ccp_migrations', -1)",
+		unlock:      "SELECT RELEASE_LOCK('ccp_migrations')",
+		tableExists: "SELECT COUNT(*) FROM information_schema.tables WHERE table_schema = DATABASE() AND table_name = 'SchemaMigrations'",
+		createTable: `CREATE TABLE IF NOT EXISTS SchemaMigrations (
+  version int(11) NOT NULL,
+  name varchar(255) NOT NULL,
+  appliedTime datetime(6) NOT NULL,
+  PRIMARY KEY (version)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8`,
+		list:   "SELECT version, name, appliedTime FROM SchemaMigrations ORDER BY version",
+		insert: "INSERT INTO SchemaMigrations (version, name, appliedTime) VALUES (?, ?, ?)",
+		delete: "DELETE FROM SchemaMigrations WHERE version = ?",
+	},
+	"postgres": {
+		lock:        "SELECT pg_advisory_lock(hashtext('ccp_migrations'))",
+		unlock:      "SELECT pg_advisory_unlock(hashtext('ccp_migrations'))",
+		tableExists: "SELECT COUNT(*) FROM information_schema.tables WHERE table_schema = current_schema() AND table_name = 'schemamigrations'",
+		createTable: `CREATE TABLE IF NOT EXISTS SchemaMigrations (
+  version integer NOT NULL,
+  name varchar(255) NOT NULL,
+  appliedTime timestamptz NOT NULL,
+  PRIMARY KEY (version)
+)`,
+		list:   "SELECT version, name, appliedTime FROM SchemaMigrations ORDER BY version",
+		insert: "INSERT INTO SchemaMigrations (version, name, appliedTime) VALUES ($1, $2, $3)",
+		delete: "DELETE FROM SchemaMigrations WHERE version = $1",
+	},
+	// A SQLite database is not shared by several instances, it needs no lock.
+	"sqlite": {
+		tableExists: "SELECT COUNT(*) FROM sqlite_master WHERE type = 'table' AND name = 'SchemaMigrations'",
+		createTable: `CREATE TABLE IF NOT EXISTS SchemaMigrations (
+  version integer NOT NULL,
+  name varchar(255) NOT NULL,
+  appliedTime datetime NOT NULL,
+  PRIMARY KEY (version)
+)`,
+		list:   "SELECT version, name, appliedTime FROM SchemaMigrations ORDER BY version",
+		insert: "INSERT INTO SchemaMigrations (version, name, appliedTime) VALUES (?, ?, ?)",
+		delete: "DELETE FROM SchemaMigrations WHERE version = ?",
+	},
+}
+
+// Migrator applies the schema migrations embedded in the binary and records
+// them in the SchemaMigrations table.
+//
+// Each migration runs in a transaction, but MySQL commits the DDL statements
+// implicitly: a MySQL migration that fails halfway needs manual intervention.
+// Up and Down hold a database lock, so instances started at the same time
+// apply the migrations once.
+type Migrator struct {
+	logger     logr.Logger
+	db         *sql.DB
+	dialect    migrationDialect
+	migrations []Migration
+	close      func() error
+}
+
+// NewMigrator connects to the database. The driver names are the same
+// accepted by New, except for the memory driver which has no schema.
+func NewMigrator(logger logr.Logger, driver, dsn string) (*Migrator, error) {
+	var (
+		db      *sql.DB
+		closeDB func() error
+		err     error
+	)
+
+	switch driver = strings.ToLower(driver); driver {
+	case "mysql":
+		db, err = connectToMySQL(logger, dsn)
+		if err != nil {
+			return nil, fmt.Errorf("connect to MySQL: %v", err)
+		}
+		closeDB = db.Close
+	case "postgres", "postgresql", "pgx":
+		driver = "postgres"
+		db, err = connectToPostgres(logger, dsn)
+		if err != nil {
+			return nil, fmt.Errorf("connect to PostgreSQL: %v", err)
+		}
+		closeDB = db.Close
+	case "sqlite", "sqlite3":
+		driver = "sqlite"
+		var reader *sql.DB
+		db, reader, err = connectToSQLite(logger, dsn)
+		if err != nil {
+			return nil, fmt.Errorf("connect to SQLite: %v", err)
+		}
+		closeDB = func() error { return errors.Join(reader.Close(), db.Close()) }
+	default:
+		return nil, fmt.Errorf("unsupported db driver: %q", driver)
+	}
+
+	m, err := newMigrator(logger, driver, db)
+	if err != nil {
+		return nil, errors.Join(err, closeDB())
+	}
+	m.close = closeDB
+
+	return m, nil
+}
+
+func newMigrator(logger logr.Logger, driver string, db *sql.DB) (*Migrator, error) {
+	migrations, err := loadMigrations(driver)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Migrator{
+		logger:     logger,
+		db:         db,
+		dialect:    migrationDialects[driver],
+		migrations: migrations,
+		close:      func() error { return nil },
+	}, nil
+}
+
+var migrationFilename = regexp.MustCompile(`^(\d+)_(\w+)\.(up|down)\.sql$`)
+
+// loadMigrations returns the migrations of the driver sorted by version.
+func loadMigrations(driver string) ([]Migration, error) {
+	dir := path.Join("migrations", driver)
+	entries, err := fs.ReadDir(migrationsFS, dir)
+	if err != nil {
+		return nil, fmt.Errorf("read migrations: %v", err)
+	}
+
+	byVersion := map[int]*Migration{}
+	for _, entry := range entries {
+		matches := migrationFilename.FindStringSubmatch(entry.Name())
+		if matches == nil {
+			return nil, fmt.Errorf("unexpected migration file: %s", entry.Name())
+		}
+		version, _ := strconv.Atoi(matches[1])
+		blob, err := fs.ReadFile(migrationsFS, path.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("read migration: %v", err)
+		}
+
+		m, ok := byVersion[version]
+		if !ok {
+			m = &Migration{Version: version, Name: matches[2]}
+			byVersion[version] = m
+		} else if m.Name != matches[2] {
+			return nil, fmt.Errorf("migration %d has two names: %s and %s", version, m.Name, matches[2])
+		}
+		if matches[3] == "up" {
+			m.up = string(blob)
+		} else {
+			m.down = string(blob)
+		}
+	}
+
+	ret := make([]Migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		if m.up == "" {
+			return nil, fmt.Errorf("migration %s has no up file", m)
+		}
+		ret = append(ret, *m)
+	}
+	slices.SortFunc(ret, func(a, b Migration) int { return a.Version - b.Version })
+
+	return ret, nil
+}
+
+// Status returns the migrations embedded in the binary and the migrations
+// applied to the database, sorted by version. Every migration is pending when
+// the SchemaMigrations table does not exist, it is not created until Up runs
+// so checking the status does not change the database.
+func (m *Migrator) Status(ctx context.Context) (_ []Migration, err error) {
+	defer wrap(&err, "Status")
+
+	ret := slices.Clone(m.migrations)
+
+	var tables int
+	if err := m.db.QueryRowContext(ctx, m.dialect.tableExists).Scan(&tables); err != nil {
+		return nil, fmt.Errorf("find migrations table: %v", err)
+	} else if tables == 0 {
+		return ret, nil
+	}
+
+	rows, err := m.db.QueryContext(ctx, m.dialect.list)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var applied Migration
+		if err := rows.Scan(&applied.Version, &applied.Name, &applied.AppliedAt); err != nil {
+			return nil, err
+		}
+		idx := slices.IndexFunc(ret, func(item Migration) bool { return item.Version == applied.Version })
+		if idx < 0 {
+			ret = append(ret, applied) // Applied by a newer version.
+		} else {
+			ret[idx].AppliedAt = applied.AppliedAt
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	slices.SortFunc(ret, func(a, b Migration) int { return a.Version - b.Version })
+
+	return ret, nil
+}
+
+// Check returns ErrSchemaVersion if there are pending migrations, or if the
+// database has migrations that are unknown to this binary.
+func (m *Migrator) Check(ctx context.Context) error {
+	migrations, err := m.Status(ctx)
+	if err != nil {
+		return err
+	}
+
+	var pending int
+	for _, item := range migrations {
+		if !item.Known() {
+			return fmt.Errorf("%w: migration %s is not known by this version of CCP", ErrSchemaVersion, item)
+		}
+		if !item.Applied() {
+			pending++
+		}
+	}
+	if pending > 0 {
+		return fmt.Errorf("%w: %d pending migration(s), run `ccp migrate up`", ErrSchemaVersion, pending)
+	}
+
+	return nil
+}
+
+// Up applies the pending migrations in order and returns them. It creates the
+// SchemaMigrations table if needed.
+func (m *Migrator) Up(ctx context.Context) (_ []Migration, err error) {
+	defer wrap(&err, "Up")
+
+	unlock, err := m.lock(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	if _, err := m.db.ExecContext(ctx, m.dialect.createTable); err != nil {
+		return nil, fmt.Errorf("create migrations table: %v", err)
+	}
+
+	migrations, err := m.Status(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var ret []Migration
+	for _, item := range migrations {
+		if item.Applied() {
+			continue
+		}
+		m.logger.V(1).Info("Applying migration.", "migration", item.String())
+		item.AppliedAt = time.Now().UTC()
+		if err := m.apply(ctx, item.up, m.dialect.insert, item.Version, item.Name, item.AppliedAt); err != nil {
+			return ret, fmt.Errorf("apply %s: %v", item, err)
+		}
+		ret = append(ret, item)
+	}
+
+	return ret, nil
+}
+
+// Down reverts the last steps migrations applied, most recent first, and
+// returns them.
+func (m *Migrator) Down(ctx context.Context, steps int) (_ []Migration, err error) {
+	defer wrap(&err, "Down(%d)", steps)
+
+	unlock, err := m.lock(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	migrations, err := m.Status(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var ret []Migration
+	for _, item := range slices.Backward(migrations) {
+		if len(ret) == steps {
+			break
+		}
+		if !item.Applied() {
+			continue
+		}
+		if !item.Known() {
+			return ret, fmt.Errorf("revert %s: migration is not known by this version of CCP", item)
+		}
+		if item.down == "" {
+			return ret, fmt.Errorf("revert %s: migration is irreversible", item)
+		}
+		m.logger.V(1).Info("Reverting migration.", "migration", item.String())
+		if err := m.apply(ctx, item.down, m.dialect.delete, item.Version); err != nil {
+			return ret, fmt.Errorf("revert %s: %v", item, err)
+		}
+		item.AppliedAt = time.Time{}
+		ret = append(ret, item)
+	}
+
+	return ret, nil
+}
+
+// lock waits for the lock of the migrations. It is a session lock, so it is
+// held by a connection of its own until unlock is called.
+func (m *Migrator) lock(ctx context.Context) (unlock func(), err error) {
+	if m.dialect.lock == "" {
+		return func() {}, nil
+	}
+
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("lock: %v", err)
+	}
+	if _, err := conn.ExecContext(ctx, m.dialect.lock); err != nil {
+		return nil, errors.Join(fmt.Errorf("lock: %v", err), conn.Close())
+	}
+
+	return func() {
+		if _, err := conn.ExecContext(context.Background(), m.dialect.unlock); err != nil {
+			m.logger.Error(err, "Failed to release the lock of the migrations.")
+		}
+		_ = conn.Close() // Closing the session releases the lock too.
+	}, nil
+}
+
+// apply runs the migration script and the statement that records it in a
+// transaction. MySQL commits the DDL statements of the script implicitly, so
+// if a MySQL migration fails its changes can be applied but not recorded.
+func (m *Migrator) apply(ctx context.Context, script, record string, args ...any) error {
+	tx, err := m.db.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if _, err := tx.ExecContext(ctx, script); err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, record, args...); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// Close closes the database connection opened by NewMigrator.
+func (m *Migrator) Close() error {
+	return m.close()
+}
+
+// checkSchema returns ErrSchemaVersion if the migrations of the driver have
+// not been applied to the database.
+func checkSchema(logger logr.Logger, driver string, db *sql.DB) error {
+	m, err := newMigrator(logger, driver, db)
+	if err != nil {
+		return err
+	}
+
+	return m.Check(context.Background())
+}
+
+// upgradeSchema applies the pending migrations of the driver to the database.
+func upgradeSchema(logger logr.Logger, driver string, db *sql.DB) error {
+	m, err := newMigrator(logger, driver, db)
+	if err != nil {
+		return err
+	}
+
+	applied, err := m.Up(context.Background())
+	for _, item := range applied {
+		logger.Info("Applied migration.", "migration", item.String())
+	}
+
+	return err
+}
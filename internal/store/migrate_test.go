@@ -0,0 +1,89 @@
+package store
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"gotest.tools/v3/assert"
+)
+
+func TestMigrations(t *testing.T) {
+	t.Parallel()
+
+	for _, driver := range []string{"mysql", "postgres", "sqlite"} {
+		t.Run("Loads the "+driver+" migrations", func(t *testing.T) {
+			t.Parallel()
+
+			migrations, err := loadMigrations(driver)
+			assert.NilError(t, err)
+			assert.Assert(t, len(migrations) > 0)
+			for i, m := range migrations {
+				assert.Equal(t, m.Version, i+1, "versions must be consecutive")
+				assert.Assert(t, m.Known())
+				assert.Assert(t, m.down != "", "migration %s is irreversible", m)
+			}
+		})
+	}
+}
+
+func TestMigrator(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "ccp.db")
+
+	m, err := NewMigrator(logr.Discard(), "sqlite", path)
+	assert.NilError(t, err)
+	defer m.Close()
+
+	// The check made when the MySQL or PostgreSQL stores start fails before
+	// the migrations are applied, and leaves the database untouched.
+	err = checkSchema(logr.Discard(), "sqlite", m.db)
+	assert.ErrorIs(t, err, ErrSchemaVersion)
+	assert.ErrorContains(t, err, "run `ccp migrate up`")
+	var tables int
+	assert.NilError(t, m.db.QueryRowContext(ctx, m.dialect.tableExists).Scan(&tables))
+	assert.Equal(t, tables, 0)
+
+	status, err := m.Status(ctx)
+	assert.NilError(t, err)
+	assert.Assert(t, len(status) > 0)
+	for _, item := range status {
+		assert.Assert(t, !item.Applied())
+	}
+
+	applied, err := m.Up(ctx)
+	assert.NilError(t, err)
+	assert.Equal(t, len(applied), len(status))
+	assert.NilError(t, m.Check(ctx))
+
+	// Nothing left to apply.
+	applied, err = m.Up(ctx)
+	assert.NilError(t, err)
+	assert.Equal(t, len(applied), 0)
+
+	s, err := New(logr.Discard(), "sqlite", path)
+	assert.NilError(t, err)
+	assert.NilError(t, s.Close())
+
+	reverted, err := m.Down(ctx, 1)
+	assert.NilError(t, err)
+	assert.Equal(t, len(reverted), 1)
+	assert.Equal(t, reverted[0].Version, status[len(status)-1].Version)
+	assert.ErrorIs(t, m.Check(ctx), ErrSchemaVersion)
+
+	_, err = m.Up(ctx)
+	assert.NilError(t, err)
+
+	// A migration applied by a newer version of CCP.
+	_, err = m.db.ExecContext(ctx, m.dialect.insert, 9999, "future", "2030-01-01 00:00:00+00:00")
+	assert.NilError(t, err)
+	err = m.Check(ctx)
+	assert.ErrorIs(t, err, ErrSchemaVersion)
+	assert.ErrorContains(t, err, "9999_future is not known")
+
+	_, err = m.Down(ctx, 1)
+	assert.ErrorContains(t, err, "migration is not known")
+}
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS Leases;
+DROP TABLE IF EXISTS PackageQueue;
+DROP TABLE IF EXISTS AuditEvents;
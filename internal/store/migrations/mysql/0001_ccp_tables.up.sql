@@ -0,0 +1,60 @@
+--
+-- Tables used by CCP that are not managed by the Dashboard. The rest of the
+-- schema is created by the Django migrations, see sqlc/mysql/schema.sql.
+--
+-- The tables may exist already since they used to be created when the store
+-- started.
+--
+
+--
+-- Audit trail
+--
+
+CREATE TABLE IF NOT EXISTS AuditEvents (
+  pk bigint(20) NOT NULL AUTO_INCREMENT,
+  createdTime datetime(6) NOT NULL,
+  userID int(11) DEFAULT NULL,
+  username varchar(150) NOT NULL,
+  procedureName varchar(255) NOT NULL,
+  summary longtext NOT NULL,
+  outcome varchar(50) NOT NULL,
+  message longtext NOT NULL,
+  packageUUID varchar(36) DEFAULT NULL,
+  decisionUUID varchar(36) DEFAULT NULL,
+  PRIMARY KEY (pk),
+  KEY AuditEvents_createdTime_idx (createdTime),
+  KEY AuditEvents_username_idx (username),
+  KEY AuditEvents_packageUUID_idx (packageUUID),
+  KEY AuditEvents_decisionUUID_idx (decisionUUID)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
+
+--
+-- Processing queue
+--
+
+CREATE TABLE IF NOT EXISTS PackageQueue (
+  pk bigint(20) NOT NULL AUTO_INCREMENT,
+  packageUUID varchar(36) NOT NULL,
+  packageType varchar(50) NOT NULL,
+  path longtext NOT NULL,
+  startChainUUID varchar(36) DEFAULT NULL,
+  startLinkUUID varchar(36) DEFAULT NULL,
+  priority int(11) NOT NULL,
+  size bigint(20) unsigned NOT NULL,
+  tag double NOT NULL,
+  queuedTime datetime(6) NOT NULL,
+  PRIMARY KEY (pk),
+  UNIQUE KEY PackageQueue_packageUUID_uniq (packageUUID),
+  KEY PackageQueue_tag_idx (tag)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
+
+--
+-- Leases
+--
+
+CREATE TABLE IF NOT EXISTS Leases (
+  name varchar(255) NOT NULL,
+  holder varchar(255) NOT NULL,
+  expiresTime datetime(6) NOT NULL,
+  PRIMARY KEY (name)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
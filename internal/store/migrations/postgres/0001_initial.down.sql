@@ -0,0 +1,15 @@
+DROP TABLE IF EXISTS Leases;
+DROP TABLE IF EXISTS PackageQueue;
+DROP TABLE IF EXISTS AuditEvents;
+DROP TABLE IF EXISTS main_userprofile;
+DROP TABLE IF EXISTS tastypie_apikey;
+DROP TABLE IF EXISTS auth_user_groups;
+DROP TABLE IF EXISTS auth_group;
+DROP TABLE IF EXISTS auth_user;
+DROP TABLE IF EXISTS DashboardSettings;
+DROP TABLE IF EXISTS UnitVariables;
+DROP TABLE IF EXISTS Files;
+DROP TABLE IF EXISTS SIPs;
+DROP TABLE IF EXISTS Transfers;
+DROP TABLE IF EXISTS Tasks;
+DROP TABLE IF EXISTS Jobs;
@@ -0,0 +1,261 @@
+--
+-- PostgreSQL translation of the tables in sqlc/mysql/schema.sql used by CCP.
+-- The identifiers are not quoted so they are folded to lowercase, e.g. the
+-- sipUUID column is named sipuuid.
+--
+-- It is the first migration, applied with `ccp migrate up`, see migrate.go.
+--
+
+--
+-- Jobs
+--
+
+CREATE TABLE IF NOT EXISTS Jobs (
+  jobUUID uuid NOT NULL,
+  jobType varchar(250) NOT NULL,
+  createdTime timestamptz NOT NULL,
+  createdTimeDec numeric(26,10) NOT NULL,
+  directory text NOT NULL,
+  SIPUUID uuid NOT NULL,
+  unitType varchar(50) NOT NULL,
+  currentStep integer NOT NULL,
+  microserviceGroup varchar(50) NOT NULL,
+  hidden boolean NOT NULL,
+  subJobOf varchar(36) NOT NULL,
+  MicroServiceChainLinksPK uuid DEFAULT NULL,
+  PRIMARY KEY (jobUUID)
+);
+
+CREATE INDEX IF NOT EXISTS Jobs_SIPUUID_idx ON Jobs (SIPUUID);
+CREATE INDEX IF NOT EXISTS Jobs_jobType_currentStep_idx ON Jobs (jobType, currentStep);
+CREATE INDEX IF NOT EXISTS Jobs_SIPUUID_currentStep_microserviceGroup_idx ON Jobs (SIPUUID, currentStep, microserviceGroup, MicroServiceChainLinksPK);
+CREATE INDEX IF NOT EXISTS Jobs_SIPUUID_createdTime_createdTimeDec_idx ON Jobs (SIPUUID, createdTime, createdTimeDec);
+CREATE INDEX IF NOT EXISTS Jobs_unitType_SIPUUID_createdTime_idx ON Jobs (unitType, SIPUUID, createdTime);
+
+--
+-- Tasks
+--
+
+CREATE TABLE IF NOT EXISTS Tasks (
+  taskUUID uuid NOT NULL,
+  createdTime timestamptz NOT NULL,
+  fileUUID uuid DEFAULT NULL,
+  fileName text NOT NULL,
+  exec varchar(250) NOT NULL,
+  arguments varchar(1000) NOT NULL,
+  startTime timestamptz DEFAULT NULL,
+  endTime timestamptz DEFAULT NULL,
+  client varchar(50) NOT NULL,
+  stdOut text NOT NULL,
+  stdError text NOT NULL,
+  exitCode bigint DEFAULT NULL,
+  jobuuid uuid NOT NULL REFERENCES Jobs (jobUUID),
+  PRIMARY KEY (taskUUID)
+);
+
+CREATE INDEX IF NOT EXISTS Tasks_jobuuid_idx ON Tasks (jobuuid);
+
+--
+-- Transfers
+--
+
+CREATE TABLE IF NOT EXISTS Transfers (
+  transferUUID uuid NOT NULL,
+  currentLocation text NOT NULL,
+  type varchar(50) NOT NULL,
+  accessionID text NOT NULL,
+  sourceOfAcquisition text NOT NULL,
+  typeOfTransfer text NOT NULL,
+  description text NOT NULL,
+  notes text NOT NULL,
+  hidden boolean NOT NULL,
+  transferMetadataSetRowUUID uuid DEFAULT NULL,
+  dirUUIDs boolean NOT NULL,
+  access_system_id text NOT NULL,
+  completed_at timestamptz DEFAULT NULL,
+  status smallint NOT NULL,
+  PRIMARY KEY (transferUUID)
+);
+
+CREATE INDEX IF NOT EXISTS Transfers_currentLocation_idx ON Transfers (currentLocation);
+
+--
+-- SIPs
+--
+
+CREATE TABLE IF NOT EXISTS SIPs (
+  sipUUID uuid NOT NULL,
+  createdTime timestamptz NOT NULL,
+  currentPath text,
+  hidden boolean NOT NULL,
+  aipFilename text,
+  sipType varchar(8) NOT NULL,
+  dirUUIDs boolean NOT NULL,
+  completed_at timestamptz DEFAULT NULL,
+  status smallint NOT NULL,
+  PRIMARY KEY (sipUUID)
+);
+
+CREATE INDEX IF NOT EXISTS SIPs_currentPath_idx ON SIPs (currentPath);
+
+--
+-- Files
+--
+
+CREATE TABLE IF NOT EXISTS Files (
+  fileUUID uuid NOT NULL,
+  originalLocation bytea NOT NULL,
+  currentLocation bytea,
+  fileGrpUse varchar(50) NOT NULL,
+  fileGrpUUID varchar(36) NOT NULL,
+  checksum varchar(128) NOT NULL,
+  fileSize bigint DEFAULT NULL,
+  label text NOT NULL,
+  enteredSystem timestamptz NOT NULL,
+  removedTime timestamptz DEFAULT NULL,
+  sipUUID uuid DEFAULT NULL REFERENCES SIPs (sipUUID),
+  transferUUID uuid DEFAULT NULL REFERENCES Transfers (transferUUID),
+  checksumType varchar(36) NOT NULL,
+  modificationTime timestamptz,
+  PRIMARY KEY (fileUUID)
+);
+
+CREATE INDEX IF NOT EXISTS Files_sipUUID_fileUUID_idx ON Files (sipUUID, fileUUID);
+CREATE INDEX IF NOT EXISTS Files_transferUUID_fileUUID_idx ON Files (transferUUID, fileUUID);
+CREATE INDEX IF NOT EXISTS Files_sipUUID_fileGrpUse_idx ON Files (sipUUID, fileGrpUse);
+
+--
+-- Unit variables
+--
+
+CREATE TABLE IF NOT EXISTS UnitVariables (
+  pk uuid NOT NULL,
+  unitType varchar(50) DEFAULT NULL,
+  unitUUID uuid DEFAULT NULL,
+  variable text,
+  variableValue text,
+  createdTime timestamptz NOT NULL,
+  updatedTime timestamptz NOT NULL,
+  microServiceChainLink uuid DEFAULT NULL,
+  PRIMARY KEY (pk)
+);
+
+CREATE INDEX IF NOT EXISTS UnitVariables_unitUUID_unitType_variable_idx ON UnitVariables (unitUUID, unitType, variable);
+
+--
+-- Dashboard settings
+--
+
+CREATE TABLE IF NOT EXISTS DashboardSettings (
+  pk integer GENERATED BY DEFAULT AS IDENTITY,
+  name varchar(255) NOT NULL,
+  value text NOT NULL,
+  lastModified timestamptz NOT NULL,
+  scope varchar(255) NOT NULL,
+  PRIMARY KEY (pk)
+);
+
+--
+-- Authorization
+--
+
+CREATE TABLE IF NOT EXISTS auth_user (
+  id integer GENERATED BY DEFAULT AS IDENTITY,
+  password varchar(128) NOT NULL,
+  last_login timestamptz DEFAULT NULL,
+  is_superuser boolean NOT NULL,
+  username varchar(150) NOT NULL UNIQUE,
+  first_name varchar(30) NOT NULL,
+  last_name varchar(30) NOT NULL,
+  email varchar(254) NOT NULL,
+  is_staff boolean NOT NULL,
+  is_active boolean NOT NULL,
+  date_joined timestamptz NOT NULL,
+  PRIMARY KEY (id)
+);
+
+CREATE TABLE IF NOT EXISTS auth_group (
+  id integer GENERATED BY DEFAULT AS IDENTITY,
+  name varchar(80) NOT NULL UNIQUE,
+  PRIMARY KEY (id)
+);
+
+CREATE TABLE IF NOT EXISTS auth_user_groups (
+  id integer GENERATED BY DEFAULT AS IDENTITY,
+  user_id integer NOT NULL REFERENCES auth_user (id),
+  group_id integer NOT NULL REFERENCES auth_group (id),
+  PRIMARY KEY (id),
+  UNIQUE (user_id, group_id)
+);
+
+CREATE TABLE IF NOT EXISTS tastypie_apikey (
+  id integer GENERATED BY DEFAULT AS IDENTITY,
+  key varchar(128) NOT NULL,
+  created timestamptz NOT NULL,
+  user_id integer NOT NULL UNIQUE REFERENCES auth_user (id),
+  PRIMARY KEY (id)
+);
+
+CREATE INDEX IF NOT EXISTS tastypie_apikey_key_idx ON tastypie_apikey (key);
+
+CREATE TABLE IF NOT EXISTS main_userprofile (
+  id integer GENERATED BY DEFAULT AS IDENTITY,
+  agent_id integer NOT NULL UNIQUE,
+  user_id integer NOT NULL UNIQUE REFERENCES auth_user (id),
+  system_emails boolean NOT NULL,
+  PRIMARY KEY (id)
+);
+
+--
+-- Audit trail
+--
+
+CREATE TABLE IF NOT EXISTS AuditEvents (
+  pk bigint GENERATED BY DEFAULT AS IDENTITY,
+  createdTime timestamptz NOT NULL,
+  userID integer DEFAULT NULL,
+  username varchar(150) NOT NULL,
+  procedureName varchar(255) NOT NULL,
+  summary text NOT NULL,
+  outcome varchar(50) NOT NULL,
+  message text NOT NULL,
+  packageUUID uuid DEFAULT NULL,
+  decisionUUID uuid DEFAULT NULL,
+  PRIMARY KEY (pk)
+);
+
+CREATE INDEX IF NOT EXISTS AuditEvents_createdTime_idx ON AuditEvents (createdTime);
+CREATE INDEX IF NOT EXISTS AuditEvents_username_idx ON AuditEvents (username);
+CREATE INDEX IF NOT EXISTS AuditEvents_packageUUID_idx ON AuditEvents (packageUUID);
+CREATE INDEX IF NOT EXISTS AuditEvents_decisionUUID_idx ON AuditEvents (decisionUUID);
+
+--
+-- Processing queue
+--
+
+CREATE TABLE IF NOT EXISTS PackageQueue (
+  pk bigint GENERATED BY DEFAULT AS IDENTITY,
+  packageUUID uuid NOT NULL UNIQUE,
+  packageType varchar(50) NOT NULL,
+  path text NOT NULL,
+  startChainUUID uuid DEFAULT NULL,
+  startLinkUUID uuid DEFAULT NULL,
+  priority integer NOT NULL,
+  size bigint NOT NULL,
+  tag double precision NOT NULL,
+  queuedTime timestamptz NOT NULL,
+  PRIMARY KEY (pk)
+);
+
+CREATE INDEX IF NOT EXISTS PackageQueue_tag_idx ON PackageQueue (tag);
+
+--
+-- Leases
+--
+
+CREATE TABLE IF NOT EXISTS Leases (
+  name varchar(255) NOT NULL,
+  holder varchar(255) NOT NULL,
+  expiresTime timestamptz NOT NULL,
+  PRIMARY KEY (name)
+);
@@ -0,0 +1,253 @@
+--
+-- SQLite translation of the tables in sqlc/mysql/schema.sql used by CCP. UUIDs
+-- are stored as text and times as text in the format written by the driver,
+-- in UTC so they can be compared.
+--
+-- It is the first migration, applied with `ccp migrate up`, see migrate.go.
+--
+
+--
+-- Jobs
+--
+
+CREATE TABLE IF NOT EXISTS Jobs (
+  jobUUID varchar(36) NOT NULL,
+  jobType varchar(250) NOT NULL,
+  createdTime datetime NOT NULL,
+  createdTimeDec text NOT NULL,
+  directory text NOT NULL,
+  SIPUUID varchar(36) NOT NULL,
+  unitType varchar(50) NOT NULL,
+  currentStep integer NOT NULL,
+  microserviceGroup varchar(50) NOT NULL,
+  hidden boolean NOT NULL,
+  subJobOf varchar(36) NOT NULL,
+  MicroServiceChainLinksPK varchar(36) DEFAULT NULL,
+  PRIMARY KEY (jobUUID)
+);
+
+CREATE INDEX IF NOT EXISTS Jobs_SIPUUID_idx ON Jobs (SIPUUID);
+CREATE INDEX IF NOT EXISTS Jobs_jobType_currentStep_idx ON Jobs (jobType, currentStep);
+CREATE INDEX IF NOT EXISTS Jobs_SIPUUID_currentStep_microserviceGroup_idx ON Jobs (SIPUUID, currentStep, microserviceGroup, MicroServiceChainLinksPK);
+CREATE INDEX IF NOT EXISTS Jobs_SIPUUID_createdTime_createdTimeDec_idx ON Jobs (SIPUUID, createdTime, createdTimeDec);
+CREATE INDEX IF NOT EXISTS Jobs_unitType_SIPUUID_createdTime_idx ON Jobs (unitType, SIPUUID, createdTime);
+
+--
+-- Tasks
+--
+
+CREATE TABLE IF NOT EXISTS Tasks (
+  taskUUID varchar(36) NOT NULL,
+  createdTime datetime NOT NULL,
+  fileUUID varchar(36) DEFAULT NULL,
+  fileName text NOT NULL,
+  exec varchar(250) NOT NULL,
+  arguments varchar(1000) NOT NULL,
+  startTime datetime DEFAULT NULL,
+  endTime datetime DEFAULT NULL,
+  client varchar(50) NOT NULL,
+  stdOut text NOT NULL,
+  stdError text NOT NULL,
+  exitCode integer DEFAULT NULL,
+  jobuuid varchar(36) NOT NULL REFERENCES Jobs (jobUUID),
+  PRIMARY KEY (taskUUID)
+);
+
+CREATE INDEX IF NOT EXISTS Tasks_jobuuid_idx ON Tasks (jobuuid);
+
+--
+-- Transfers
+--
+
+CREATE TABLE IF NOT EXISTS Transfers (
+  transferUUID varchar(36) NOT NULL,
+  currentLocation text NOT NULL,
+  type varchar(50) NOT NULL,
+  accessionID text NOT NULL,
+  sourceOfAcquisition text NOT NULL,
+  typeOfTransfer text NOT NULL,
+  description text NOT NULL,
+  notes text NOT NULL,
+  hidden boolean NOT NULL,
+  transferMetadataSetRowUUID varchar(36) DEFAULT NULL,
+  dirUUIDs boolean NOT NULL,
+  access_system_id text NOT NULL,
+  completed_at datetime DEFAULT NULL,
+  status integer NOT NULL,
+  PRIMARY KEY (transferUUID)
+);
+
+CREATE INDEX IF NOT EXISTS Transfers_currentLocation_idx ON Transfers (currentLocation);
+
+--
+-- SIPs
+--
+
+CREATE TABLE IF NOT EXISTS SIPs (
+  sipUUID varchar(36) NOT NULL,
+  createdTime datetime NOT NULL,
+  currentPath text,
+  hidden boolean NOT NULL,
+  aipFilename text,
+  sipType varchar(8) NOT NULL,
+  dirUUIDs boolean NOT NULL,
+  completed_at datetime DEFAULT NULL,
+  status integer NOT NULL,
+  PRIMARY KEY (sipUUID)
+);
+
+CREATE INDEX IF NOT EXISTS SIPs_currentPath_idx ON SIPs (currentPath);
+
+--
+-- Files
+--
+
+CREATE TABLE IF NOT EXISTS Files (
+  fileUUID varchar(36) NOT NULL,
+  originalLocation blob NOT NULL,
+  currentLocation blob,
+  fileGrpUse varchar(50) NOT NULL,
+  fileGrpUUID varchar(36) NOT NULL,
+  checksum varchar(128) NOT NULL,
+  fileSize integer DEFAULT NULL,
+  label text NOT NULL,
+  enteredSystem datetime NOT NULL,
+  removedTime datetime DEFAULT NULL,
+  sipUUID varchar(36) DEFAULT NULL REFERENCES SIPs (sipUUID),
+  transferUUID varchar(36) DEFAULT NULL REFERENCES Transfers (transferUUID),
+  checksumType varchar(36) NOT NULL,
+  modificationTime datetime,
+  PRIMARY KEY (fileUUID)
+);
+
+CREATE INDEX IF NOT EXISTS Files_sipUUID_fileUUID_idx ON Files (sipUUID, fileUUID);
+CREATE INDEX IF NOT EXISTS Files_transferUUID_fileUUID_idx ON Files (transferUUID, fileUUID);
+CREATE INDEX IF NOT EXISTS Files_sipUUID_fileGrpUse_idx ON Files (sipUUID, fileGrpUse);
+
+--
+-- Unit variables
+--
+
+CREATE TABLE IF NOT EXISTS UnitVariables (
+  pk varchar(36) NOT NULL,
+  unitType varchar(50) DEFAULT NULL,
+  unitUUID varchar(36) DEFAULT NULL,
+  variable text,
+  variableValue text,
+  createdTime datetime NOT NULL,
+  updatedTime datetime NOT NULL,
+  microServiceChainLink varchar(36) DEFAULT NULL,
+  PRIMARY KEY (pk)
+);
+
+CREATE INDEX IF NOT EXISTS UnitVariables_unitUUID_unitType_variable_idx ON UnitVariables (unitUUID, unitType, variable);
+
+--
+-- Dashboard settings
+--
+
+CREATE TABLE IF NOT EXISTS DashboardSettings (
+  pk integer PRIMARY KEY AUTOINCREMENT,
+  name varchar(255) NOT NULL,
+  value text NOT NULL,
+  lastModified datetime NOT NULL,
+  scope varchar(255) NOT NULL
+);
+
+--
+-- Authorization
+--
+
+CREATE TABLE IF NOT EXISTS auth_user (
+  id integer PRIMARY KEY AUTOINCREMENT,
+  password varchar(128) NOT NULL,
+  last_login datetime DEFAULT NULL,
+  is_superuser boolean NOT NULL,
+  username varchar(150) NOT NULL UNIQUE,
+  first_name varchar(30) NOT NULL,
+  last_name varchar(30) NOT NULL,
+  email varchar(254) NOT NULL,
+  is_staff boolean NOT NULL,
+  is_active boolean NOT NULL,
+  date_joined datetime NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS auth_group (
+  id integer PRIMARY KEY AUTOINCREMENT,
+  name varchar(80) NOT NULL UNIQUE
+);
+
+CREATE TABLE IF NOT EXISTS auth_user_groups (
+  id integer PRIMARY KEY AUTOINCREMENT,
+  user_id integer NOT NULL REFERENCES auth_user (id),
+  group_id integer NOT NULL REFERENCES auth_group (id),
+  UNIQUE (user_id, group_id)
+);
+
+CREATE TABLE IF NOT EXISTS tastypie_apikey (
+  id integer PRIMARY KEY AUTOINCREMENT,
+  key varchar(128) NOT NULL,
+  created datetime NOT NULL,
+  user_id integer NOT NULL UNIQUE REFERENCES auth_user (id)
+);
+
+CREATE INDEX IF NOT EXISTS tastypie_apikey_key_idx ON tastypie_apikey (key);
+
+CREATE TABLE IF NOT EXISTS main_userprofile (
+  id integer PRIMARY KEY AUTOINCREMENT,
+  agent_id integer NOT NULL UNIQUE,
+  user_id integer NOT NULL UNIQUE REFERENCES auth_user (id),
+  system_emails boolean NOT NULL
+);
+
+--
+-- Audit trail
+--
+
+CREATE TABLE IF NOT EXISTS AuditEvents (
+  pk integer PRIMARY KEY AUTOINCREMENT,
+  createdTime datetime NOT NULL,
+  userID integer DEFAULT NULL,
+  username varchar(150) NOT NULL,
+  procedureName varchar(255) NOT NULL,
+  summary text NOT NULL,
+  outcome varchar(50) NOT NULL,
+  message text NOT NULL,
+  packageUUID varchar(36) DEFAULT NULL,
+  decisionUUID varchar(36) DEFAULT NULL
+);
+
+CREATE INDEX IF NOT EXISTS AuditEvents_createdTime_idx ON AuditEvents (createdTime);
+CREATE INDEX IF NOT EXISTS AuditEvents_username_idx ON AuditEvents (username);
+CREATE INDEX IF NOT EXISTS AuditEvents_packageUUID_idx ON AuditEvents (packageUUID);
+CREATE INDEX IF NOT EXISTS AuditEvents_decisionUUID_idx ON AuditEvents (decisionUUID);
+
+--
+-- Processing queue
+--
+
+CREATE TABLE IF NOT EXISTS PackageQueue (
+  pk integer PRIMARY KEY AUTOINCREMENT,
+  packageUUID varchar(36) NOT NULL UNIQUE,
+  packageType varchar(50) NOT NULL,
+  path text NOT NULL,
+  startChainUUID varchar(36) DEFAULT NULL,
+  startLinkUUID varchar(36) DEFAULT NULL,
+  priority integer NOT NULL,
+  size integer NOT NULL,
+  tag real NOT NULL,
+  queuedTime datetime NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS PackageQueue_tag_idx ON PackageQueue (tag);
+
+--
+-- Leases
+--
+
+CREATE TABLE IF NOT EXISTS Leases (
+  name varchar(255) NOT NULL,
+  holder varchar(255) NOT NULL,
+  expiresTime datetime NOT NULL,
+  PRIMARY KEY (name)
+);
@@ -5,7 +5,9 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"iter"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/doug-martin/goqu/v9"
@@ -22,8 +24,10 @@ import (
 )
 
 var (
-	myJobsTable  = "Jobs"
-	myFilesTable = "Files"
+	myJobsTable         = "Jobs"
+	myFilesTable        = "Files"
+	myAuditEventsTable  = "AuditEvents"
+	myPackageQueueTable = "PackageQueue"
 )
 
 func connectToMySQL(logger logr.Logger, dsn string) (*sql.DB, error) {
@@ -88,6 +92,30 @@ func newMySQLStore(logger logr.Logger, pool *sql.DB) (*mysqlStoreImpl, error) {
 	}, nil
 }
 
+// mysqlTx implements the Tx interface, using the connection pool or a
+// transaction started by RunInTx.
+type mysqlTx struct {
+	queries *sqlc.Queries
+	goqu    interface {
+		Update(table any) *goqu.UpdateDataset
+	}
+}
+
+var _ Tx = (*mysqlTx)(nil)
+
+func (s *mysqlStoreImpl) tx() *mysqlTx {
+	return &mysqlTx{queries: s.queries, goqu: s.goqu}
+}
+
+func (s *mysqlStoreImpl) RunInTx(ctx context.Context, fn func(tx Tx) error) error {
+	return runInTx(ctx, s.logger, s.pool, func(tx *sql.Tx) error {
+		return fn(&mysqlTx{
+			queries: s.queries.WithTx(tx),
+			goqu:    goqu.NewTx("mysql", tx),
+		})
+	})
+}
+
 func (s *mysqlStoreImpl) RemoveTransientData(ctx context.Context) (err error) {
 	defer wrap(&err, "RemoveTransientData")
 
@@ -131,26 +159,19 @@ func (s *mysqlStoreImpl) CreateJob(ctx context.Context, params *sqlc.CreateJobPa
 	return s.queries.CreateJob(ctx, params)
 }
 
-func (s *mysqlStoreImpl) UpdateJobStatus(ctx context.Context, id uuid.UUID, status string) (err error) {
+func (s *mysqlStoreImpl) UpdateJobStatus(ctx context.Context, id uuid.UUID, status string) error {
+	return s.tx().UpdateJobStatus(ctx, id, status)
+}
+
+func (t *mysqlTx) UpdateJobStatus(ctx context.Context, id uuid.UUID, status string) (err error) {
 	defer wrap(&err, "UpdateJobStatus(%s, %s)", id, status)
 
-	var step int32
-	switch status {
-	case "Unknown", "STATUS_UNKNOWN", "":
-		step = 0
-	case "Awaiting decision", "STATUS_AWAITING_DECISION":
-		step = 1
-	case "Completed successfully", "STATUS_COMPLETED_SUCCESSFULLY":
-		step = 2
-	case "Executing command(s)", "STATUS_EXECUTING_COMMANDS":
-		step = 3
-	case "Failed", "STATUS_FAILED":
-		step = 4
-	default:
-		return fmt.Errorf("unknown status: %q", status)
+	step, err := jobStep(status)
+	if err != nil {
+		return err
 	}
 
-	return s.queries.UpdateJobStatus(ctx, &sqlc.UpdateJobStatusParams{
+	return t.queries.UpdateJobStatus(ctx, &sqlc.UpdateJobStatusParams{
 		ID:          id,
 		Currentstep: step,
 	})
@@ -196,52 +217,108 @@ func (s *mysqlStoreImpl) ListJobs(ctx context.Context, pkgID uuid.UUID) (_ []*ad
 		return nil, err
 	}
 
-	convert := func(j *sqlc.Job) (*adminv1.Job, error) {
-		ret := &adminv1.Job{
-			Id:              j.ID.String(),
-			PackageId:       j.SIPID.String(),
-			Directory:       j.Directory,
-			LinkId:          j.LinkID.UUID.String(),
-			LinkDescription: j.Type,
-			Hidden:          j.Hidden,
-			Group:           j.Microservicegroup,
-			Status:          adminv1.JobStatus(j.Currentstep),
+	ret := make([]*adminv1.Job, 0, len(jobs))
+	for _, j := range jobs {
+		job, err := mysqlJob(j)
+		if err != nil {
+			return nil, fmt.Errorf("convert: %v", err)
 		}
+		ret = append(ret, job)
+	}
 
-		switch j.Unittype {
-		case "unitDIP":
-			ret.PackageType = adminv1.PackageType_PACKAGE_TYPE_DIP
-		case "unitSIP":
-			ret.PackageType = adminv1.PackageType_PACKAGE_TYPE_SIP
-		case "unitTransfer":
-			ret.PackageType = adminv1.PackageType_PACKAGE_TYPE_TRANSFER
-		}
+	return ret, nil
+}
 
-		if err := updateTimeWithFraction(&ret.CreatedAt, j.CreatedAt, j.Createdtimedec); err != nil {
-			return nil, err
-		}
+func (s *mysqlStoreImpl) ListPackageJobs(ctx context.Context, packageType adminv1.PackageType) (_ map[uuid.UUID][]*adminv1.Job, err error) {
+	defer wrap(&err, "ListPackageJobs(%s)", packageType)
 
-		return ret, nil
+	var jobs []*sqlc.Job
+	switch packageType {
+	case adminv1.PackageType_PACKAGE_TYPE_TRANSFER:
+		jobs, err = s.queries.ListTransferJobs(ctx)
+	case adminv1.PackageType_PACKAGE_TYPE_SIP:
+		jobs, err = s.queries.ListSIPJobs(ctx)
+	default:
+		return nil, fmt.Errorf("unsupported package type: %s", packageType)
+	}
+	if err != nil {
+		return nil, err
 	}
 
-	ret := make([]*adminv1.Job, 0, len(jobs))
-	for _, item := range jobs {
-		if j, err := convert(item); err != nil {
+	ret := map[uuid.UUID][]*adminv1.Job{}
+	for _, j := range jobs {
+		job, err := mysqlJob(j)
+		if err != nil {
 			return nil, fmt.Errorf("convert: %v", err)
-		} else {
-			ret = append(ret, j)
 		}
+		ret[j.SIPID] = append(ret[j.SIPID], job)
 	}
 
 	return ret, nil
 }
 
+// mysqlJob converts a job returned by the queries.
+func mysqlJob(j *sqlc.Job) (*adminv1.Job, error) {
+	job := &adminv1.Job{
+		Id:              j.ID.String(),
+		PackageId:       j.SIPID.String(),
+		PackageType:     jobPackageType(j.Unittype),
+		Directory:       j.Directory,
+		LinkId:          j.LinkID.UUID.String(),
+		LinkDescription: j.Type,
+		Hidden:          j.Hidden,
+		Group:           j.Microservicegroup,
+		Status:          adminv1.JobStatus(j.Currentstep),
+	}
+	if err := updateTimeWithFraction(&job.CreatedAt, j.CreatedAt, j.Createdtimedec); err != nil {
+		return nil, err
+	}
+
+	return job, nil
+}
+
 func (s *mysqlStoreImpl) CreateTasks(ctx context.Context, tasks []*Task) (err error) {
 	defer wrap(&err, "CreateTasks(tasks)")
 
-	insert := s.goqu.Insert("Tasks").Rows(tasks).Executor()
-	if _, err := insert.ExecContext(ctx); err != nil {
-		return err
+	if len(tasks) == 0 {
+		return nil
+	}
+
+	return runInTx(ctx, s.logger, s.pool, func(tx *sql.Tx) error {
+		gtx := goqu.NewTx("mysql", tx)
+		for chunk := range chunkTasks(tasks, taskSize) {
+			insert := gtx.Insert("Tasks").Rows(chunk).Executor()
+			if _, err := insert.ExecContext(ctx); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// UpdateTasks joins the tasks with a derived table built with the results of
+// each chunk, so a single statement updates all the rows of the chunk.
+func (s *mysqlStoreImpl) UpdateTasks(ctx context.Context, results []*TaskResult) (err error) {
+	defer wrap(&err, "UpdateTasks(results)")
+
+	for chunk := range chunkTasks(results, taskResultSize) {
+		var b strings.Builder
+		args := make([]any, 0, len(chunk)*5)
+		b.WriteString("UPDATE Tasks AS t JOIN (")
+		for i, r := range chunk {
+			if i == 0 {
+				b.WriteString("SELECT ? AS taskUUID, ? AS endTime, ? AS exitCode, ? AS stdOut, ? AS stdError")
+			} else {
+				b.WriteString(" UNION ALL SELECT ?, ?, ?, ?, ?")
+			}
+			args = append(args, r.ID.String(), r.EndedAt.UTC(), r.ExitCode, r.Stdout, r.Stderr)
+		}
+		b.WriteString(") AS r ON r.taskUUID = t.taskUUID" +
+			" SET t.endTime = r.endTime, t.exitCode = r.exitCode, t.stdOut = r.stdOut, t.stdError = r.stdError")
+
+		if _, err := s.pool.ExecContext(ctx, b.String(), args...); err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -288,7 +365,11 @@ func (s *mysqlStoreImpl) ReadPackagesWithCreationTimestamps(ctx context.Context,
 	return ret, nil
 }
 
-func (s *mysqlStoreImpl) UpdatePackageStatus(ctx context.Context, id uuid.UUID, packageType enums.PackageType, status enums.PackageStatus) (err error) {
+func (s *mysqlStoreImpl) UpdatePackageStatus(ctx context.Context, id uuid.UUID, packageType enums.PackageType, status enums.PackageStatus) error {
+	return s.tx().UpdatePackageStatus(ctx, id, packageType, status)
+}
+
+func (t *mysqlTx) UpdatePackageStatus(ctx context.Context, id uuid.UUID, packageType enums.PackageType, status enums.PackageStatus) (err error) {
 	defer wrap(&err, "UpdatePackageStatus(%s, %s, %s)", id, packageType, status)
 
 	if !packageType.IsValid() {
@@ -320,7 +401,7 @@ func (s *mysqlStoreImpl) UpdatePackageStatus(ctx context.Context, id uuid.UUID,
 		values["completed_at"] = time.Now()
 	}
 
-	update := s.goqu.Update(table).
+	update := t.goqu.Update(table).
 		Where(goqu.Ex{idColumn: id.String()}).
 		Set(values).
 		Executor()
@@ -344,6 +425,23 @@ func (s *mysqlStoreImpl) ReadTransferLocation(ctx context.Context, id uuid.UUID)
 	return ret.Currentlocation, nil
 }
 
+func (s *mysqlStoreImpl) ReadTransferWithIdempotencyKey(ctx context.Context, key string) (_ uuid.UUID, _ string, err error) {
+	defer wrap(&err, "ReadTransferWithIdempotencyKey(%s)", key)
+
+	ret, err := s.queries.ReadTransferWithIdempotencyKey(ctx, sql.NullString{
+		String: key,
+		Valid:  true,
+	})
+	if err == sql.ErrNoRows {
+		return uuid.Nil, "", ErrNotFound
+	}
+	if err != nil {
+		return uuid.Nil, "", err
+	}
+
+	return ret.Unituuid, ret.Variablevalue.String, nil
+}
+
 func (s *mysqlStoreImpl) CreateTransfer(ctx context.Context, id uuid.UUID, accessionID, accessSystemID string, metadataSetID uuid.UUID) (err error) {
 	defer wrap(&err, "CreateTransfer(%s, %s, %s, %d)", id, accessionID, accessSystemID, metadataSetID)
 
@@ -385,18 +483,7 @@ func (s *mysqlStoreImpl) ReadTransfer(ctx context.Context, id uuid.UUID) (_ Tran
 		transfer.Type = adminv1.TransferType_TRANSFER_TYPE_STANDARD
 	}
 
-	switch row.Status {
-	case uint16(enums.PackageStatusUnknown):
-		transfer.Status = adminv1.PackageStatus_PACKAGE_STATUS_UNSPECIFIED
-	case uint16(enums.PackageStatusProcessing):
-		transfer.Status = adminv1.PackageStatus_PACKAGE_STATUS_PROCESSING
-	case uint16(enums.PackageStatusDone):
-		transfer.Status = adminv1.PackageStatus_PACKAGE_STATUS_DONE
-	case uint16(enums.PackageStatusCompletedSuccessfully):
-		transfer.Status = adminv1.PackageStatus_PACKAGE_STATUS_COMPLETED_SUCCESSFULLY
-	case uint16(enums.PackageStatusFailed):
-		transfer.Status = adminv1.PackageStatus_PACKAGE_STATUS_FAILED
-	}
+	transfer.Status = transferStatus(int(row.Status))
 
 	return transfer, nil
 }
@@ -770,16 +857,16 @@ func (s *mysqlStoreImpl) ReadUnitLinkID(ctx context.Context, id uuid.UUID, packa
 	return ret.LinkID.UUID, nil
 }
 
-func (s *mysqlStoreImpl) CreateUnitVar(ctx context.Context, id uuid.UUID, packageType enums.PackageType, name, value string, linkID uuid.UUID, updateExisting bool) (err error) {
-	defer wrap(&err, "CreateUnitVar(%s, %s, %s, %s)", id, packageType, name, value)
+func (s *mysqlStoreImpl) CreateUnitVar(ctx context.Context, id uuid.UUID, packageType enums.PackageType, name, value string, linkID uuid.UUID, updateExisting bool) error {
+	return s.RunInTx(ctx, func(tx Tx) error {
+		return tx.CreateUnitVar(ctx, id, packageType, name, value, linkID, updateExisting)
+	})
+}
 
-	tx, err := s.pool.BeginTx(ctx, &sql.TxOptions{})
-	if err != nil {
-		return err
-	}
-	defer func() { _ = tx.Rollback() }()
+func (t *mysqlTx) CreateUnitVar(ctx context.Context, id uuid.UUID, packageType enums.PackageType, name, value string, linkID uuid.UUID, updateExisting bool) (err error) {
+	defer wrap(&err, "CreateUnitVar(%s, %s, %s, %s)", id, packageType, name, value)
 
-	q := s.queries.WithTx(tx)
+	q := t.queries
 
 	exists := false
 	uv, err := q.ReadUnitVar(ctx, &sqlc.ReadUnitVarParams{
@@ -801,29 +888,9 @@ func (s *mysqlStoreImpl) CreateUnitVar(ctx context.Context, id uuid.UUID, packag
 		exists = true
 	}
 
-	var (
-		wantValue  sql.NullString
-		wantLinkID uuid.NullUUID
-	)
-	{
-		switch {
-		case value == "" && linkID == uuid.Nil:
-			return errors.New("both value and linkID are zero")
-		case value != "" && linkID != uuid.Nil:
-			return errors.New("both value and linkID are non-zero")
-		case value != "":
-			// MCPServer sets "link_id" to NULL when a "value" is given, e.g.:
-			// 	name="processingConfiguration", value="automated", link_id=NULL
-			wantValue.String = value
-			wantValue.Valid = true
-			wantLinkID.Valid = false
-		case linkID != uuid.Nil:
-			// MCPServer sets "value" to empty string when a "link_id" is given, e.g.:
-			//	name="reNormalize", value="", link_id="8ba83807-2832-4e41-843c-2e55ad10ea0b"/
-			wantValue.Valid = true
-			wantLinkID.UUID = linkID
-			wantLinkID.Valid = true
-		}
+	wantValue, wantLinkID, err := unitVarValue(value, linkID)
+	if err != nil {
+		return err
 	}
 
 	// It exists but it does not require further updates.
@@ -846,12 +913,10 @@ func (s *mysqlStoreImpl) CreateUnitVar(ctx context.Context, id uuid.UUID, packag
 			Name:     sql.NullString{String: name, Valid: true},
 		})
 		if err != nil {
-			return fmt.Errorf("update: %v", err)
-		} else {
-			return tx.Commit()
+			return fmt.Errorf("update: %w", err)
 		}
 	} else {
-		if err := s.queries.CreateUnitVar(ctx, &sqlc.CreateUnitVarParams{
+		if err := q.CreateUnitVar(ctx, &sqlc.CreateUnitVarParams{
 			UnitID: id,
 			UnitType: sql.NullString{
 				String: packageType.String(),
@@ -864,23 +929,55 @@ func (s *mysqlStoreImpl) CreateUnitVar(ctx context.Context, id uuid.UUID, packag
 			Value:  wantValue,
 			LinkID: wantLinkID,
 		}); err != nil {
-			return fmt.Errorf("create: %v", err)
-		} else {
-			return tx.Commit()
+			return fmt.Errorf("create: %w", err)
 		}
 	}
+
+	return nil
 }
 
-func (s *mysqlStoreImpl) Files(ctx context.Context, id uuid.UUID, packageType enums.PackageType, filterFilenameEnd, filterSubdir, replacementPath string) (_ []File, err error) {
-	defer wrap(&err, "Files(%s, %s, %s, %s, %s)", id, packageType, filterFilenameEnd, filterSubdir, replacementPath)
+func (s *mysqlStoreImpl) Files(ctx context.Context, id uuid.UUID, packageType enums.PackageType, filterFilenameEnd, filterSubdir, replacementPath string) iter.Seq2[File, error] {
+	return listFiles(func(after uuid.UUID) (_ []File, err error) {
+		defer wrap(&err, "Files(%s, %s, %s, %s, %s)", id, packageType, filterFilenameEnd, filterSubdir, replacementPath)
 
-	sel := s.goqu.Select().From(myFilesTable)
-	if filterFilenameEnd != "" {
-		sel = sel.Where(goqu.Ex{"currentLocation": goqu.Op{"like": "%" + filterFilenameEnd}})
-	}
-	if filterSubdir != "" {
-		sel = sel.Where(goqu.Ex{"currentLocation": goqu.Op{"like": replacementPath + filterSubdir + "%"}})
+		sel := s.goqu.Select().From(myFilesTable).
+			Where(goqu.Ex{"fileUUID": goqu.Op{"gt": after.String()}}).
+			Order(goqu.I("fileUUID").Asc()).
+			Limit(filesPageSize)
+		if filterFilenameEnd != "" {
+			sel = sel.Where(goqu.Ex{"currentLocation": goqu.Op{"like": "%" + filterFilenameEnd}})
+		}
+		if filterSubdir != "" {
+			sel = sel.Where(goqu.Ex{"currentLocation": goqu.Op{"like": replacementPath + filterSubdir + "%"}})
+		}
+		switch packageType {
+		case enums.PackageTypeTransfer:
+			sel = sel.Where(goqu.Ex{"transferUUID": id.String()})
+		case enums.PackageTypeSIP, enums.PackageTypeDIP:
+			sel = sel.Where(goqu.Ex{"sipUUID": id.String()})
+		default:
+			return nil, fmt.Errorf("unexpected package type: %q", packageType)
+		}
+
+		ret := make([]File, 0, filesPageSize)
+		if err := sel.ScanStructsContext(ctx, &ret); err != nil {
+			return nil, fmt.Errorf("scan structs: %v", err)
+		}
+
+		return ret, nil
+	})
+}
+
+func (s *mysqlStoreImpl) KnownFileLocations(ctx context.Context, id uuid.UUID, packageType enums.PackageType, locations []string) (_ map[string]struct{}, err error) {
+	defer wrap(&err, "KnownFileLocations(%s, %s)", id, packageType)
+
+	ret := map[string]struct{}{}
+	if len(locations) == 0 {
+		return ret, nil
 	}
+
+	sel := s.goqu.Select("currentLocation").From(myFilesTable).
+		Where(goqu.Ex{"currentLocation": locations})
 	switch packageType {
 	case enums.PackageTypeTransfer:
 		sel = sel.Where(goqu.Ex{"transferUUID": id.String()})
@@ -890,22 +987,12 @@ func (s *mysqlStoreImpl) Files(ctx context.Context, id uuid.UUID, packageType en
 		return nil, fmt.Errorf("unexpected package type: %q", packageType)
 	}
 
-	ret := []File{}
-
-	const batchSize = 250
-	offset := uint(0)
-	for {
-		sel = sel.Limit(batchSize).Offset(offset)
-		files := make([]File, 0, batchSize)
-		if err := sel.ScanStructsContext(ctx, &files); err != nil {
-			return nil, fmt.Errorf("scan structs: %v", err)
-		}
-		if len(files) == 0 {
-			break
-		} else {
-			ret = append(ret, files...)
-			offset += uint(len(files))
-		}
+	var rows []string
+	if err := sel.ScanValsContext(ctx, &rows); err != nil {
+		return nil, fmt.Errorf("scan vals: %v", err)
+	}
+	for _, loc := range rows {
+		ret[loc] = struct{}{}
 	}
 
 	return ret, nil
@@ -944,7 +1031,7 @@ func (s *mysqlStoreImpl) ReadDict(ctx context.Context, name string) (_ map[strin
 
 	ret := make(map[string]string, ln)
 	for _, row := range rows {
-		ret[row.Name] = ret[row.Value]
+		ret[row.Name] = row.Value
 	}
 
 	return ret, nil
@@ -977,6 +1064,253 @@ func (s *mysqlStoreImpl) ValidateUserAPIKey(ctx context.Context, username, key s
 	return ret, nil
 }
 
+func (s *mysqlStoreImpl) ReadUserWithUsername(ctx context.Context, username string) (_ *User, err error) {
+	defer wrap(&err, "ReadUserWithUsername(%q)", username)
+
+	row, err := s.queries.ReadUserWithUsername(ctx, username)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &User{
+		ID:       int(row.ID),
+		Username: row.Username,
+		Email:    row.Email,
+		Active:   row.IsActive,
+	}
+	if row.AgentID.Valid {
+		ret.AgentID = ref.New(int(row.AgentID.Int32))
+	}
+
+	return ret, nil
+}
+
+func (s *mysqlStoreImpl) ValidateUserPassword(ctx context.Context, username, password string) (_ *User, err error) {
+	defer wrap(&err, "ValidateUserPassword(%q)", username)
+
+	row, err := s.queries.ReadUserWithPassword(ctx, username)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if !checkPassword(row.Password, password) {
+		return nil, nil
+	}
+
+	ret := &User{
+		ID:       int(row.ID),
+		Username: row.Username,
+		Email:    row.Email,
+		Active:   row.IsActive,
+	}
+	if row.AgentID.Valid {
+		ret.AgentID = ref.New(int(row.AgentID.Int32))
+	}
+
+	return ret, nil
+}
+
+func (s *mysqlStoreImpl) ReadUserAPIKey(ctx context.Context, userID int) (_ string, err error) {
+	defer wrap(&err, "ReadUserAPIKey(%d)", userID)
+
+	key, err := s.queries.ReadUserAPIKey(ctx, int32(userID))
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", ErrNotFound
+	}
+	if err != nil {
+		return "", err
+	}
+
+	return key, nil
+}
+
+func (s *mysqlStoreImpl) ReadUserGroups(ctx context.Context, userID int) (_ []string, err error) {
+	defer wrap(&err, "ReadUserGroups(%d)", userID)
+
+	return s.queries.ReadUserGroups(ctx, int32(userID))
+}
+
+func (s *mysqlStoreImpl) CreateAuditEvent(ctx context.Context, event *AuditEvent) (err error) {
+	defer wrap(&err, "CreateAuditEvent(%s)", event.Procedure)
+
+	insert := s.goqu.Insert(myAuditEventsTable).Rows(event).Executor()
+	res, err := insert.ExecContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	if id, err := res.LastInsertId(); err == nil {
+		event.ID = id
+	}
+
+	return nil
+}
+
+func (s *mysqlStoreImpl) ListAuditEvents(ctx context.Context, params *ListAuditEventsParams) (_ []*AuditEvent, err error) {
+	defer wrap(&err, "ListAuditEvents(params)")
+
+	ex := goqu.Ex{}
+	if params.Username != nil {
+		ex["username"] = *params.Username
+	}
+	if params.Procedure != nil {
+		ex["procedureName"] = *params.Procedure
+	}
+	if params.PackageID != nil {
+		ex["packageUUID"] = params.PackageID.String()
+	}
+	if params.DecisionID != nil {
+		ex["decisionUUID"] = params.DecisionID.String()
+	}
+	if params.Outcome != nil {
+		ex["outcome"] = *params.Outcome
+	}
+
+	sel := s.goqu.Select().From(myAuditEventsTable).Where(ex)
+	if params.Since != nil {
+		sel = sel.Where(goqu.C("createdTime").Gte(*params.Since))
+	}
+	if params.Until != nil {
+		sel = sel.Where(goqu.C("createdTime").Lt(*params.Until))
+	}
+	if params.BeforeID != nil {
+		sel = sel.Where(goqu.C("pk").Lt(*params.BeforeID))
+	}
+	sel = sel.Order(goqu.C("pk").Desc())
+	if params.Limit > 0 {
+		sel = sel.Limit(params.Limit)
+	}
+
+	ret := []*AuditEvent{}
+	if err := sel.ScanStructsContext(ctx, &ret); err != nil {
+		return nil, fmt.Errorf("scan structs: %v", err)
+	}
+
+	return ret, nil
+}
+
+func (s *mysqlStoreImpl) CreateQueueEntry(ctx context.Context, entry *QueueEntry) (err error) {
+	defer wrap(&err, "CreateQueueEntry(%s)", entry.PackageID)
+
+	insert := s.goqu.Insert(myPackageQueueTable).Rows(entry).OnConflict(
+		goqu.DoUpdate("packageUUID", goqu.Record{
+			"packageType":    entry.PackageType,
+			"path":           entry.Path,
+			"startChainUUID": entry.StartChainID,
+			"startLinkUUID":  entry.StartLinkID,
+			"priority":       entry.Priority,
+			"size":           entry.Size,
+			"tag":            entry.Tag,
+			"queuedTime":     entry.QueuedAt,
+		}),
+	).Executor()
+	res, err := insert.ExecContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	if id, err := res.LastInsertId(); err == nil {
+		entry.ID = id
+	}
+
+	return nil
+}
+
+func (s *mysqlStoreImpl) UpdateQueueEntryTag(ctx context.Context, pkgID uuid.UUID, tag float64) (err error) {
+	defer wrap(&err, "UpdateQueueEntryTag(%s, %f)", pkgID, tag)
+
+	update := s.goqu.Update(myPackageQueueTable).
+		Set(goqu.Record{"tag": tag}).
+		Where(goqu.Ex{"packageUUID": pkgID.String()}).
+		Executor()
+	_, err = update.ExecContext(ctx)
+
+	return err
+}
+
+func (s *mysqlStoreImpl) DeleteQueueEntry(ctx context.Context, pkgID uuid.UUID) (err error) {
+	defer wrap(&err, "DeleteQueueEntry(%s)", pkgID)
+
+	del := s.goqu.Delete(myPackageQueueTable).
+		Where(goqu.Ex{"packageUUID": pkgID.String()}).
+		Executor()
+	_, err = del.ExecContext(ctx)
+
+	return err
+}
+
+func (s *mysqlStoreImpl) ListQueueEntries(ctx context.Context) (_ []*QueueEntry, err error) {
+	defer wrap(&err, "ListQueueEntries()")
+
+	sel := s.goqu.Select().From(myPackageQueueTable).Order(
+		goqu.C("tag").Asc(),
+		goqu.C("pk").Asc(),
+	)
+
+	ret := []*QueueEntry{}
+	if err := sel.ScanStructsContext(ctx, &ret); err != nil {
+		return nil, fmt.Errorf("scan structs: %v", err)
+	}
+
+	return ret, nil
+}
+
+func (s *mysqlStoreImpl) AcquireLease(ctx context.Context, name, holder string, ttl time.Duration) (_ bool, err error) {
+	defer wrap(&err, "AcquireLease(%s, %s, %s)", name, holder, ttl)
+
+	// The expiration is computed with the clock of the database so it does
+	// not depend on the clocks of the instances competing for the lease.
+	err = s.queries.AcquireLease(ctx, &sqlc.AcquireLeaseParams{
+		Name:   name,
+		Holder: holder,
+		Ttl:    ttl.Microseconds(),
+	})
+	if err != nil {
+		return false, err
+	}
+
+	cur, err := s.queries.ReadLeaseHolder(ctx, name)
+	if err == sql.ErrNoRows {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	return cur == holder, nil
+}
+
+func (s *mysqlStoreImpl) ReadLeaseHolder(ctx context.Context, name string) (_ string, err error) {
+	defer wrap(&err, "ReadLeaseHolder(%s)", name)
+
+	holder, err := s.queries.ReadLeaseHolder(ctx, name)
+	if err == sql.ErrNoRows {
+		return "", ErrNotFound
+	} else if err != nil {
+		return "", err
+	}
+
+	return holder, nil
+}
+
+func (s *mysqlStoreImpl) ReleaseLease(ctx context.Context, name, holder string) (err error) {
+	defer wrap(&err, "ReleaseLease(%s, %s)", name, holder)
+
+	return s.queries.ReleaseLease(ctx, &sqlc.ReleaseLeaseParams{
+		Name:   name,
+		Holder: holder,
+	})
+}
+
+func (s *mysqlStoreImpl) Ping(ctx context.Context) error {
+	return s.pool.PingContext(ctx)
+}
+
 func (s *mysqlStoreImpl) Running() bool {
 	return s != nil
 }
@@ -1003,7 +1337,7 @@ func wrap(errp *error, format string, args ...any) {
 		errfmt  string
 		message = fmt.Sprintf(format, args...)
 	)
-	if *errp == ErrNotFound {
+	if *errp == ErrNotFound || isTransient(*errp) {
 		errfmt = "%s: %w"
 	} else {
 		errfmt = "%s: %v"
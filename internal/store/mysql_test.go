@@ -1,12 +1,19 @@
 package store
 
 import (
+	"context"
+	"fmt"
+	"os"
 	"testing"
 	"time"
 
+	"github.com/go-logr/logr"
+	mysqldriver "github.com/go-sql-driver/mysql"
+	"github.com/google/uuid"
 	"gotest.tools/v3/assert"
 
 	adminv1 "github.com/artefactual-labs/ccp/internal/api/gen/archivematica/ccp/admin/v1beta1"
+	"github.com/artefactual-labs/ccp/internal/store/enums"
 )
 
 func TestAddDecimalPrecision(t *testing.T) {
@@ -21,3 +28,84 @@ func TestAddDecimalPrecision(t *testing.T) {
 	assert.NilError(t, err)
 	assert.Equal(t, pkg.CreatedAt.AsTime().Format(time.RFC3339Nano), "2011-01-05T12:21:40.123456789Z")
 }
+
+// TestMySQLStore covers bugs of the MySQL store. It uses its own database,
+// named after the one in CCP_TEST_MYSQL_DSN, and is skipped when it is empty.
+func TestMySQLStore(t *testing.T) {
+	dsn := os.Getenv("CCP_TEST_MYSQL_DSN")
+	if dsn == "" {
+		t.Skip("Skipping MySQL store tests (CCP_TEST_MYSQL_DSN is empty).")
+	}
+
+	config, err := mysqldriver.ParseDSN(dsn)
+	assert.NilError(t, err)
+	dbName := config.DBName + "_mysql"
+
+	config.DBName = ""
+	pool, err := connectToMySQL(logr.Discard(), config.FormatDSN())
+	assert.NilError(t, err)
+	_, err = pool.Exec(fmt.Sprintf("DROP DATABASE IF EXISTS `%[1]s`; CREATE DATABASE `%[1]s`", dbName))
+	assert.NilError(t, err)
+	assert.NilError(t, pool.Close())
+
+	schema, err := os.ReadFile("sqlc/mysql/schema.sql")
+	assert.NilError(t, err)
+
+	config.DBName = dbName
+	pool, err = connectToMySQL(logr.Discard(), config.FormatDSN())
+	assert.NilError(t, err)
+	_, err = pool.Exec(string(schema))
+	assert.NilError(t, err)
+
+	s, err := newMySQLStore(logr.Discard(), pool)
+	assert.NilError(t, err)
+	t.Cleanup(func() { s.Close() })
+
+	ctx := context.Background()
+
+	t.Run("Reads the values of a dictionary", func(t *testing.T) {
+		_, err := pool.Exec("INSERT INTO `DashboardSettings` (`name`, `value`, `lastModified`, `scope`) VALUES " +
+			"('upload-qubit_v0.0', 'http://atom', '2024-01-01 00:00:00', 'upload-qubit_v0.0'), " +
+			"('upload-qubit_v0.0.email', 'demo@example.com', '2024-01-01 00:00:00', 'upload-qubit_v0.0')")
+		assert.NilError(t, err)
+
+		dict, err := s.ReadDict(ctx, "upload-qubit_v0.0")
+		assert.NilError(t, err)
+		assert.DeepEqual(t, dict, map[string]string{
+			"upload-qubit_v0.0":       "http://atom",
+			"upload-qubit_v0.0.email": "demo@example.com",
+		})
+	})
+
+	t.Run("Creates unit variables in its transaction", func(t *testing.T) {
+		// A statement run outside the transaction would wait for a second
+		// connection until the deadline.
+		pool.SetMaxOpenConns(1)
+		t.Cleanup(func() { pool.SetMaxOpenConns(0) })
+
+		ctx, cancel := context.WithTimeout(ctx, time.Second*5)
+		defer cancel()
+
+		id := uuid.New()
+		assert.NilError(t, s.CreateUnitVar(ctx, id, enums.PackageTypeSIP, "processingConfiguration", "automated", uuid.Nil, true))
+
+		value, err := s.ReadUnitVar(ctx, id, enums.PackageTypeSIP, "processingConfiguration")
+		assert.NilError(t, err)
+		assert.Equal(t, value, "automated")
+	})
+}
+
+func BenchmarkMySQLTasks(b *testing.B) {
+	dsn := os.Getenv("CCP_TEST_MYSQL_DSN")
+	if dsn == "" {
+		b.Skip("Skipping MySQL store benchmarks (CCP_TEST_MYSQL_DSN is empty).")
+	}
+
+	s, _ := openMySQLTestStore(b, dsn)
+
+	worker, err := connectToMySQL(logr.Discard(), dsn)
+	assert.NilError(b, err)
+	b.Cleanup(func() { worker.Close() })
+
+	benchmarkTasks(b, s, worker)
+}
@@ -0,0 +1,48 @@
+package store
+
+import (
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"hash"
+	"strconv"
+	"strings"
+
+	"golang.org/x/crypto/pbkdf2"
+)
+
+// checkPassword reports whether password matches the encoded password stored
+// by Django in auth_user, e.g. "pbkdf2_sha256$<iterations>$<salt>$<hash>".
+// Only the PBKDF2 hashers are supported, other algorithms never match.
+func checkPassword(encoded, password string) bool {
+	parts := strings.Split(encoded, "$")
+	if len(parts) != 4 {
+		return false
+	}
+	algorithm, salt := parts[0], parts[2]
+
+	var h func() hash.Hash
+	switch algorithm {
+	case "pbkdf2_sha256":
+		h = sha256.New
+	case "pbkdf2_sha1":
+		h = sha1.New
+	default:
+		return false
+	}
+
+	iterations, err := strconv.Atoi(parts[1])
+	if err != nil || iterations < 1 {
+		return false
+	}
+
+	want, err := base64.StdEncoding.DecodeString(parts[3])
+	if err != nil || len(want) == 0 {
+		return false
+	}
+
+	got := pbkdf2.Key([]byte(password), []byte(salt), iterations, len(want), h)
+
+	return subtle.ConstantTimeCompare(got, want) == 1
+}
@@ -0,0 +1,34 @@
+package store
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestCheckPassword(t *testing.T) {
+	t.Parallel()
+
+	// Django's make_password("test", salt="mvBl4X1CJtrPVsL3u8uZHt").
+	const encoded = "pbkdf2_sha256$720000$mvBl4X1CJtrPVsL3u8uZHt$sTIyXq3C0XG9jqQxIenKBWN7NZqa2puSVDMxN16cgPU="
+
+	for _, tc := range []struct {
+		name     string
+		encoded  string
+		password string
+		want     bool
+	}{
+		{"Matches", encoded, "test", true},
+		{"Rejects wrong password", encoded, "TEST", false},
+		{"Rejects empty password", encoded, "", false},
+		{"Rejects unusable password", "!GMrMmgYCXJQGzZuCxsNdhAtnx7YdRbAlXYfKnV5i", "test", false},
+		{"Rejects unsupported algorithm", "argon2$argon2id$v=19$m=102400,t=2,p=8$c2FsdA$aGFzaA", "test", false},
+		{"Rejects malformed hash", "pbkdf2_sha256$abc$salt$hash", "test", false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, checkPassword(tc.encoded, tc.password), tc.want)
+		})
+	}
+}
@@ -0,0 +1,1267 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"iter"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/google/uuid"
+	_ "github.com/jackc/pgx/v5/stdlib"
+	"go.artefactual.dev/tools/ref"
+
+	adminv1 "github.com/artefactual-labs/ccp/internal/api/gen/archivematica/ccp/admin/v1beta1"
+	"github.com/artefactual-labs/ccp/internal/store/enums"
+	"github.com/artefactual-labs/ccp/internal/store/sqlcmysql"
+	sqlc "github.com/artefactual-labs/ccp/internal/store/sqlcpostgres"
+)
+
+func connectToPostgres(logger logr.Logger, dsn string) (*sql.DB, error) {
+	db, err := sql.Open("pgx", dsn)
+	if err != nil {
+		return nil, fmt.Errorf("error opening database: %v", err)
+	}
+
+	// Set reasonable sizes on the built-in pool.
+	db.SetMaxOpenConns(30)
+	db.SetMaxIdleConns(30)
+	db.SetConnMaxLifetime(time.Minute)
+
+	var version string
+	err = db.QueryRow("SELECT version()").Scan(&version)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.V(2).Info("Connected to PostgreSQL.", "version", version)
+
+	return db, nil
+}
+
+// postgresStoreImpl implements the Store interface on PostgreSQL. All the
+// queries are built using sqlc, see sqlc/postgres/query.sql.
+type postgresStoreImpl struct {
+	logger  logr.Logger
+	pool    *sql.DB
+	queries *sqlc.Queries
+}
+
+var _ Store = (*postgresStoreImpl)(nil)
+
+func newPostgresStore(logger logr.Logger, pool *sql.DB) (*postgresStoreImpl, error) {
+	queries, err := sqlc.Prepare(context.Background(), pool)
+	if err != nil {
+		return nil, err
+	}
+
+	return &postgresStoreImpl{
+		logger:  logger,
+		pool:    pool,
+		queries: queries,
+	}, nil
+}
+
+// postgresTx implements the Tx interface, using the connection pool or a
+// transaction started by RunInTx.
+type postgresTx struct {
+	queries *sqlc.Queries
+}
+
+var _ Tx = (*postgresTx)(nil)
+
+func (s *postgresStoreImpl) tx() *postgresTx {
+	return &postgresTx{queries: s.queries}
+}
+
+func (s *postgresStoreImpl) RunInTx(ctx context.Context, fn func(tx Tx) error) error {
+	return runInTx(ctx, s.logger, s.pool, func(tx *sql.Tx) error {
+		return fn(&postgresTx{queries: s.queries.WithTx(tx)})
+	})
+}
+
+func (s *postgresStoreImpl) RemoveTransientData(ctx context.Context) (err error) {
+	defer wrap(&err, "RemoveTransientData")
+
+	tx, err := s.pool.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	q := s.queries.WithTx(tx)
+
+	if err := q.CleanUpActiveTasks(ctx); err != nil {
+		return err
+	}
+
+	if err := q.CleanUpActiveTransfers(ctx); err != nil {
+		return err
+	}
+
+	if err := q.CleanUpTasksWithAwaitingJobs(ctx); err != nil {
+		return err
+	}
+
+	if err := q.CleanUpAwaitingJobs(ctx); err != nil {
+		return err
+	}
+
+	if err := q.CleanUpActiveSIPs(ctx); err != nil {
+		return err
+	}
+
+	if err := q.CleanUpActiveJobs(ctx); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (s *postgresStoreImpl) CreateJob(ctx context.Context, params *sqlcmysql.CreateJobParams) (err error) {
+	defer wrap(&err, "CreateJob")
+
+	return s.queries.CreateJob(ctx, (*sqlc.CreateJobParams)(params))
+}
+
+func (s *postgresStoreImpl) UpdateJobStatus(ctx context.Context, id uuid.UUID, status string) error {
+	return s.tx().UpdateJobStatus(ctx, id, status)
+}
+
+func (t *postgresTx) UpdateJobStatus(ctx context.Context, id uuid.UUID, status string) (err error) {
+	defer wrap(&err, "UpdateJobStatus(%s, %s)", id, status)
+
+	step, err := jobStep(status)
+	if err != nil {
+		return err
+	}
+
+	return t.queries.UpdateJobStatus(ctx, &sqlc.UpdateJobStatusParams{
+		ID:          id,
+		Currentstep: step,
+	})
+}
+
+func (s *postgresStoreImpl) FindAwaitingJob(ctx context.Context, params *FindAwaitingJobParams) (_ *adminv1.Job, err error) {
+	defer wrap(&err, "FindAwaitingJob(ctx, params)")
+
+	p := &sqlc.FindAwaitingJobParams{}
+	if params.Directory != nil { // ApproveTransferByPath
+		p.Directory = sql.NullString{String: *params.Directory, Valid: true}
+	} else if params.PackageID != nil { // ApprovePartialReingest
+		p.PackageID = uuid.NullUUID{UUID: *params.PackageID, Valid: true}
+		p.MicroserviceGroup = ref.DerefZero(params.Group)
+	}
+
+	row, err := s.queries.FindAwaitingJob(ctx, p)
+	if err == sql.ErrNoRows {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &adminv1.Job{
+		Id:        row.ID.String(),
+		PackageId: row.SIPID.String(),
+	}
+
+	return ret, nil
+}
+
+func (s *postgresStoreImpl) ListJobs(ctx context.Context, pkgID uuid.UUID) (_ []*adminv1.Job, err error) {
+	defer wrap(&err, "ListJobs(%s)", pkgID)
+
+	jobs, err := s.queries.ListJobs(ctx, pkgID)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make([]*adminv1.Job, 0, len(jobs))
+	for _, j := range jobs {
+		job, err := pgJob(j)
+		if err != nil {
+			return nil, fmt.Errorf("convert: %v", err)
+		}
+		ret = append(ret, job)
+	}
+
+	return ret, nil
+}
+
+func (s *postgresStoreImpl) ListPackageJobs(ctx context.Context, packageType adminv1.PackageType) (_ map[uuid.UUID][]*adminv1.Job, err error) {
+	defer wrap(&err, "ListPackageJobs(%s)", packageType)
+
+	var jobs []*sqlc.Job
+	switch packageType {
+	case adminv1.PackageType_PACKAGE_TYPE_TRANSFER:
+		jobs, err = s.queries.ListTransferJobs(ctx)
+	case adminv1.PackageType_PACKAGE_TYPE_SIP:
+		jobs, err = s.queries.ListSIPJobs(ctx)
+	default:
+		return nil, fmt.Errorf("unsupported package type: %s", packageType)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	ret := map[uuid.UUID][]*adminv1.Job{}
+	for _, j := range jobs {
+		job, err := pgJob(j)
+		if err != nil {
+			return nil, fmt.Errorf("convert: %v", err)
+		}
+		ret[j.SIPID] = append(ret[j.SIPID], job)
+	}
+
+	return ret, nil
+}
+
+// pgJob converts a job returned by the queries.
+func pgJob(j *sqlc.Job) (*adminv1.Job, error) {
+	job := &adminv1.Job{
+		Id:              j.ID.String(),
+		PackageId:       j.SIPID.String(),
+		PackageType:     jobPackageType(j.Unittype),
+		Directory:       j.Directory,
+		LinkId:          j.LinkID.UUID.String(),
+		LinkDescription: j.Type,
+		Hidden:          j.Hidden,
+		Group:           j.Microservicegroup,
+		Status:          adminv1.JobStatus(j.Currentstep),
+	}
+	if err := updateTimeWithFraction(&job.CreatedAt, j.CreatedAt, j.Createdtimedec); err != nil {
+		return nil, err
+	}
+
+	return job, nil
+}
+
+// pgTask is the JSON representation of a Task used by the CreateTasks query,
+// the keys match the columns of the record set.
+type pgTask struct {
+	ID        uuid.UUID  `json:"taskuuid"`
+	CreatedAt time.Time  `json:"createdtime"`
+	FileID    *uuid.UUID `json:"fileuuid"`
+	Filename  string     `json:"filename"`
+	Exec      string     `json:"exec"`
+	Arguments string     `json:"arguments"`
+	StartedAt *time.Time `json:"starttime"`
+	EndedAt   *time.Time `json:"endtime"`
+	Client    string     `json:"client"`
+	Stdout    string     `json:"stdout"`
+	Stderr    string     `json:"stderror"`
+	ExitCode  *int16     `json:"exitcode"`
+	JobID     uuid.UUID  `json:"jobuuid"`
+}
+
+func (s *postgresStoreImpl) CreateTasks(ctx context.Context, tasks []*Task) (err error) {
+	defer wrap(&err, "CreateTasks(tasks)")
+
+	if len(tasks) == 0 {
+		return nil
+	}
+
+	// PostgreSQL does not accept the NUL character in text columns.
+	nul := strings.NewReplacer("\x00", "")
+
+	items := make([]pgTask, 0, len(tasks))
+	for _, t := range tasks {
+		item := pgTask{
+			ID:        t.ID,
+			CreatedAt: t.CreatedAt,
+			Filename:  t.Filename,
+			Exec:      t.Exec,
+			Arguments: t.Arguments,
+			Client:    t.Client,
+			Stdout:    nul.Replace(t.Stdout),
+			Stderr:    nul.Replace(t.Stderr),
+			JobID:     t.JobID,
+		}
+		if t.FileID.Valid {
+			item.FileID = &t.FileID.UUID
+		}
+		if t.StartedAt.Valid {
+			item.StartedAt = &t.StartedAt.Time
+		}
+		if t.EndedAt.Valid {
+			item.EndedAt = &t.EndedAt.Time
+		}
+		if t.ExitCode.Valid {
+			item.ExitCode = &t.ExitCode.Int16
+		}
+		items = append(items, item)
+	}
+
+	return runInTx(ctx, s.logger, s.pool, func(tx *sql.Tx) error {
+		q := s.queries.WithTx(tx)
+		size := func(t pgTask) int { return len(t.Arguments) + len(t.Stdout) + len(t.Stderr) }
+		for chunk := range chunkTasks(items, size) {
+			blob, err := json.Marshal(chunk)
+			if err != nil {
+				return fmt.Errorf("encode: %v", err)
+			}
+			if err := q.CreateTasks(ctx, blob); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// pgTaskResult is the JSON representation of a TaskResult used by the
+// UpdateTasks query, the keys match the columns of the record set.
+type pgTaskResult struct {
+	ID       uuid.UUID `json:"taskuuid"`
+	EndedAt  time.Time `json:"endtime"`
+	ExitCode int16     `json:"exitcode"`
+	Stdout   string    `json:"stdout"`
+	Stderr   string    `json:"stderror"`
+}
+
+func (s *postgresStoreImpl) UpdateTasks(ctx context.Context, results []*TaskResult) (err error) {
+	defer wrap(&err, "UpdateTasks(results)")
+
+	// PostgreSQL does not accept the NUL character in text columns.
+	nul := strings.NewReplacer("\x00", "")
+
+	for chunk := range chunkTasks(results, taskResultSize) {
+		items := make([]pgTaskResult, 0, len(chunk))
+		for _, r := range chunk {
+			items = append(items, pgTaskResult{
+				ID:       r.ID,
+				EndedAt:  r.EndedAt,
+				ExitCode: r.ExitCode,
+				Stdout:   nul.Replace(r.Stdout),
+				Stderr:   nul.Replace(r.Stderr),
+			})
+		}
+
+		blob, err := json.Marshal(items)
+		if err != nil {
+			return fmt.Errorf("encode: %v", err)
+		}
+		if err := s.queries.UpdateTasks(ctx, blob); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *postgresStoreImpl) ReadPackagesWithCreationTimestamps(ctx context.Context, packageType adminv1.PackageType) (ret []*adminv1.Package, err error) {
+	defer wrap(&err, "ReadPackagesWithCreationTimestamps(%s)", packageType)
+
+	type row struct {
+		id        uuid.UUID
+		createdAt time.Time
+		dec       string
+		status    sql.NullInt16
+	}
+
+	var rows []row
+	switch packageType {
+	case adminv1.PackageType_PACKAGE_TYPE_TRANSFER:
+		items, err := s.queries.ListTransfersWithCreationTimestamps(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range items {
+			rows = append(rows, row{item.SIPID, item.CreatedAt, item.CreatedAtDec, item.Status})
+		}
+	case adminv1.PackageType_PACKAGE_TYPE_SIP:
+		items, err := s.queries.ListSIPsWithCreationTimestamps(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range items {
+			rows = append(rows, row{item.SIPID, item.CreatedAt, item.CreatedAtDec, item.Status})
+		}
+	default:
+		return nil, fmt.Errorf("unsupported package type: %s", packageType)
+	}
+
+	ret = make([]*adminv1.Package, 0, len(rows))
+	for _, r := range rows {
+		pkg := &adminv1.Package{
+			Id:     r.id.String(),
+			Status: adminv1.PackageStatus(int32(r.status.Int16)),
+		}
+		if err := updateTimeWithFraction(&pkg.CreatedAt, r.createdAt, r.dec); err != nil {
+			return nil, err
+		}
+		ret = append(ret, pkg)
+	}
+
+	return ret, nil
+}
+
+func (s *postgresStoreImpl) UpdatePackageStatus(ctx context.Context, id uuid.UUID, packageType enums.PackageType, status enums.PackageStatus) error {
+	return s.tx().UpdatePackageStatus(ctx, id, packageType, status)
+}
+
+func (t *postgresTx) UpdatePackageStatus(ctx context.Context, id uuid.UUID, packageType enums.PackageType, status enums.PackageStatus) (err error) {
+	defer wrap(&err, "UpdatePackageStatus(%s, %s, %s)", id, packageType, status)
+
+	if !packageType.IsValid() {
+		return fmt.Errorf("invalid type: %q", packageType)
+	}
+	if !status.IsValid() {
+		return fmt.Errorf("invalid status: %d", status)
+	}
+
+	var completedAt sql.NullTime
+	if status == enums.PackageStatusCompletedSuccessfully {
+		completedAt = sql.NullTime{Time: time.Now(), Valid: true}
+	}
+
+	switch packageType {
+	case enums.PackageTypeTransfer:
+		return t.queries.UpdateTransferStatus(ctx, &sqlc.UpdateTransferStatusParams{
+			Status:       int16(status),
+			CompletedAt:  completedAt,
+			Transferuuid: id,
+		})
+	case enums.PackageTypeDIP, enums.PackageTypeSIP:
+		return t.queries.UpdateSIPStatus(ctx, &sqlc.UpdateSIPStatusParams{
+			Status:      int16(status),
+			CompletedAt: completedAt,
+			SIPID:       id,
+		})
+	default:
+		return fmt.Errorf("unknown unit type: %q", packageType)
+	}
+}
+
+func (s *postgresStoreImpl) ReadTransferLocation(ctx context.Context, id uuid.UUID) (loc string, err error) {
+	defer wrap(&err, "ReadTransferLocation(%s)", id)
+
+	ret, err := s.queries.ReadTransferLocation(ctx, id)
+	if err == sql.ErrNoRows {
+		return "", ErrNotFound
+	}
+	if err != nil {
+		return "", err
+	}
+
+	return ret.Currentlocation, nil
+}
+
+func (s *postgresStoreImpl) ReadTransferWithIdempotencyKey(ctx context.Context, key string) (_ uuid.UUID, _ string, err error) {
+	defer wrap(&err, "ReadTransferWithIdempotencyKey(%s)", key)
+
+	ret, err := s.queries.ReadTransferWithIdempotencyKey(ctx, sql.NullString{
+		String: key,
+		Valid:  true,
+	})
+	if err == sql.ErrNoRows {
+		return uuid.Nil, "", ErrNotFound
+	}
+	if err != nil {
+		return uuid.Nil, "", err
+	}
+
+	return ret.Unituuid.UUID, ret.Variablevalue.String, nil
+}
+
+func (s *postgresStoreImpl) CreateTransfer(ctx context.Context, id uuid.UUID, accessionID, accessSystemID string, metadataSetID uuid.UUID) (err error) {
+	defer wrap(&err, "CreateTransfer(%s, %s, %s, %s)", id, accessionID, accessSystemID, metadataSetID)
+
+	params := &sqlc.CreateTransferParams{
+		Transferuuid:   id,
+		Accessionid:    accessionID,
+		AccessSystemID: accessSystemID,
+	}
+	if metadataSetID != uuid.Nil {
+		params.Transfermetadatasetrowuuid = uuid.NullUUID{
+			UUID:  metadataSetID,
+			Valid: true,
+		}
+	}
+
+	return s.queries.CreateTransfer(ctx, params)
+}
+
+func (s *postgresStoreImpl) ReadTransfer(ctx context.Context, id uuid.UUID) (_ Transfer, err error) {
+	defer wrap(&err, "ReadTransfer(%s)", id)
+
+	transfer := Transfer{}
+
+	row, err := s.queries.ReadTransfer(ctx, id)
+	if err == sql.ErrNoRows {
+		return transfer, ErrNotFound
+	}
+	if err != nil {
+		return transfer, err
+	}
+
+	transfer.ID = row.Transferuuid
+	transfer.Name = row.Description
+	transfer.CurrentPath = row.Currentlocation
+	transfer.Status = transferStatus(int(row.Status))
+
+	switch row.Type {
+	case "standard":
+		transfer.Type = adminv1.TransferType_TRANSFER_TYPE_STANDARD
+	}
+
+	return transfer, nil
+}
+
+func (s *postgresStoreImpl) UpsertTransfer(ctx context.Context, id uuid.UUID, path string) (_ bool, err error) {
+	defer wrap(&err, "UpsertTransfer(%s, %s)", id, path)
+
+	tx, err := s.pool.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return false, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	q := s.queries.WithTx(tx)
+
+	r, err := q.ReadTransferLocation(ctx, id)
+	if err == sql.ErrNoRows {
+		if err := q.CreateTransfer(ctx, &sqlc.CreateTransferParams{
+			Transferuuid:    id,
+			Currentlocation: path,
+		}); err != nil {
+			return false, fmt.Errorf("create transfer: %v", err)
+		}
+		return true, tx.Commit()
+	}
+	if err != nil {
+		return false, fmt.Errorf("read transfer: %v", err)
+	}
+
+	if r.Currentlocation == path {
+		return false, nil
+	}
+	if err := q.UpdateTransferLocation(ctx, &sqlc.UpdateTransferLocationParams{
+		Transferuuid:    id,
+		Currentlocation: path,
+	}); err != nil {
+		return false, fmt.Errorf("update transfer: %v", err)
+	}
+
+	return false, tx.Commit()
+}
+
+func (s *postgresStoreImpl) EnsureTransfer(ctx context.Context, path string) (_ uuid.UUID, _ bool, err error) {
+	defer wrap(&err, "EnsureTransfer(%s)", path)
+
+	tx, err := s.pool.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return uuid.Nil, false, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	q := s.queries.WithTx(tx)
+
+	id, err := q.ReadTransferWithLocation(ctx, path)
+	if err == sql.ErrNoRows {
+		id := uuid.New()
+		if err := q.CreateTransfer(ctx, &sqlc.CreateTransferParams{
+			Transferuuid:    id,
+			Currentlocation: path,
+		}); err != nil {
+			return uuid.Nil, false, fmt.Errorf("create transfer: %v", err)
+		}
+		return id, true, tx.Commit()
+	}
+	if err != nil {
+		return uuid.Nil, false, fmt.Errorf("read transfer: %v", err)
+	}
+
+	return id, false, nil // Transfer found!
+}
+
+func (s *postgresStoreImpl) UpdateTransferLocation(ctx context.Context, id uuid.UUID, path string) (err error) {
+	defer wrap(&err, "UpdateTransferLocation(%s, %s)", id, path)
+
+	return s.queries.UpdateTransferLocation(ctx, &sqlc.UpdateTransferLocationParams{
+		Transferuuid:    id,
+		Currentlocation: path,
+	})
+}
+
+func (s *postgresStoreImpl) ReadSIP(ctx context.Context, id uuid.UUID) (_ SIP, err error) {
+	defer wrap(&err, "ReadSIP(%s)", id)
+
+	row, err := s.queries.ReadSIP(ctx, id)
+	if err == sql.ErrNoRows {
+		return SIP{}, ErrNotFound
+	}
+	if err != nil {
+		return SIP{}, err
+	}
+
+	return SIP{
+		ID:          row.SIPID,
+		CreatedAt:   row.CreatedAt,
+		CurrentPath: row.Currentpath.String,
+		Hidden:      row.Hidden,
+		AIPFilename: row.Aipfilename.String,
+		Type:        row.Siptype,
+		DirIDs:      row.Diruuids,
+		Status:      int(row.Status),
+		CompletedAt: row.CompletedAt.Time,
+	}, nil
+}
+
+func (s *postgresStoreImpl) UpsertSIP(ctx context.Context, id uuid.UUID, path string) (_ bool, err error) {
+	defer wrap(&err, "UpsertSIP(%s, %s)", id, path)
+
+	return s.upsertSIP(ctx, id, path, "SIP", true)
+}
+
+func (s *postgresStoreImpl) EnsureSIP(ctx context.Context, path string) (_ uuid.UUID, _ bool, err error) {
+	defer wrap(&err, "EnsureSIP(%s)", path)
+
+	return s.ensureSIP(ctx, path, "SIP")
+}
+
+func (s *postgresStoreImpl) ReadDIP(ctx context.Context, id uuid.UUID) (_ DIP, err error) {
+	defer wrap(&err, "ReadDIP(%s)", id)
+
+	row, err := s.queries.ReadSIP(ctx, id)
+	if err == sql.ErrNoRows {
+		return DIP{}, ErrNotFound
+	}
+	if err != nil {
+		return DIP{}, err
+	}
+
+	return DIP{
+		ID:          row.SIPID,
+		CreatedAt:   row.CreatedAt,
+		CurrentPath: row.Currentpath.String,
+		Hidden:      row.Hidden,
+		AIPFilename: row.Aipfilename.String,
+		DirIDs:      row.Diruuids,
+		Status:      int(row.Status),
+		CompletedAt: row.CompletedAt.Time,
+	}, nil
+}
+
+func (s *postgresStoreImpl) UpsertDIP(ctx context.Context, id uuid.UUID, path string) (_ bool, err error) {
+	defer wrap(&err, "UpsertDIP(%s, %s)", id, path)
+
+	// Like mysqlStoreImpl, the location of an existing DIP is not updated.
+	return s.upsertSIP(ctx, id, path, "DIP", false)
+}
+
+func (s *postgresStoreImpl) EnsureDIP(ctx context.Context, path string) (_ uuid.UUID, _ bool, err error) {
+	defer wrap(&err, "EnsureDIP(%s)", path)
+
+	return s.ensureSIP(ctx, path, "DIP")
+}
+
+// upsertSIP creates a row in the SIPs table, which holds both SIPs and DIPs.
+func (s *postgresStoreImpl) upsertSIP(ctx context.Context, id uuid.UUID, path, sipType string, update bool) (bool, error) {
+	tx, err := s.pool.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return false, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	q := s.queries.WithTx(tx)
+
+	r, err := q.ReadSIPLocation(ctx, id)
+	if err == sql.ErrNoRows {
+		if err := q.CreateSIP(ctx, &sqlc.CreateSIPParams{
+			SIPID:       id,
+			Currentpath: sql.NullString{String: path, Valid: true},
+			Siptype:     sipType,
+		}); err != nil {
+			return false, fmt.Errorf("create %s: %v", sipType, err)
+		}
+		return true, tx.Commit()
+	}
+	if err != nil {
+		return false, fmt.Errorf("read %s: %v", sipType, err)
+	}
+
+	if !update || r.Currentpath.String == path {
+		return false, nil
+	}
+	if err := q.UpdateSIPLocation(ctx, &sqlc.UpdateSIPLocationParams{
+		SIPID:       id,
+		Currentpath: sql.NullString{String: path, Valid: true},
+	}); err != nil {
+		return false, fmt.Errorf("update %s: %v", sipType, err)
+	}
+
+	return false, tx.Commit()
+}
+
+func (s *postgresStoreImpl) ensureSIP(ctx context.Context, path, sipType string) (uuid.UUID, bool, error) {
+	tx, err := s.pool.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return uuid.Nil, false, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	q := s.queries.WithTx(tx)
+
+	id, err := q.ReadSIPWithLocation(ctx, sql.NullString{String: path, Valid: true})
+	if err == sql.ErrNoRows {
+		id := uuid.New()
+		if err := q.CreateSIP(ctx, &sqlc.CreateSIPParams{
+			SIPID:       id,
+			Currentpath: sql.NullString{String: path, Valid: true},
+			Siptype:     sipType,
+		}); err != nil {
+			return uuid.Nil, false, fmt.Errorf("create %s: %v", sipType, err)
+		}
+		return id, true, tx.Commit()
+	}
+	if err != nil {
+		return uuid.Nil, false, fmt.Errorf("read %s: %v", sipType, err)
+	}
+
+	return id, false, nil
+}
+
+func (s *postgresStoreImpl) ReadUnitVars(ctx context.Context, id uuid.UUID, packageType enums.PackageType, name string) (vars []UnitVar, err error) {
+	defer wrap(&err, "ReadUnitVars(%s, %s)", packageType, name)
+
+	ret, err := s.queries.ReadUnitVars(ctx, &sqlc.ReadUnitVarsParams{
+		UnitID: uuid.NullUUID{UUID: id, Valid: true},
+		Name:   sql.NullString{String: name, Valid: true},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, item := range ret {
+		if packageType != "" && packageType.String() != item.Unittype.String {
+			continue // Filter by package type if requested.
+		}
+		uv := UnitVar{}
+		if item.Variablevalue.Valid {
+			uv.Value = &item.Variablevalue.String
+		}
+		if item.LinkID.Valid {
+			uv.LinkID = &item.LinkID.UUID
+		}
+		vars = append(vars, uv)
+	}
+
+	return vars, nil
+}
+
+func (s *postgresStoreImpl) ReadUnitVar(ctx context.Context, id uuid.UUID, packageType enums.PackageType, name string) (_ string, err error) {
+	defer wrap(&err, "ReadUnitVar(%s, %s, %s)", id, packageType, name)
+
+	ret, err := s.queries.ReadUnitVar(ctx, pgUnitVarParams(id, packageType, name))
+	if err == sql.ErrNoRows {
+		return "", ErrNotFound
+	}
+	if err != nil {
+		return "", err
+	}
+
+	return ret.Variablevalue.String, nil
+}
+
+func (s *postgresStoreImpl) ReadUnitLinkID(ctx context.Context, id uuid.UUID, packageType enums.PackageType, name string) (_ uuid.UUID, err error) {
+	defer wrap(&err, "ReadUnitVarLinkID(%s, %s, %s)", id, packageType, name)
+
+	ret, err := s.queries.ReadUnitVar(ctx, pgUnitVarParams(id, packageType, name))
+	if err == sql.ErrNoRows {
+		return uuid.Nil, ErrNotFound
+	}
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	return ret.LinkID.UUID, nil
+}
+
+func (s *postgresStoreImpl) CreateUnitVar(ctx context.Context, id uuid.UUID, packageType enums.PackageType, name, value string, linkID uuid.UUID, updateExisting bool) error {
+	return s.RunInTx(ctx, func(tx Tx) error {
+		return tx.CreateUnitVar(ctx, id, packageType, name, value, linkID, updateExisting)
+	})
+}
+
+func (t *postgresTx) CreateUnitVar(ctx context.Context, id uuid.UUID, packageType enums.PackageType, name, value string, linkID uuid.UUID, updateExisting bool) (err error) {
+	defer wrap(&err, "CreateUnitVar(%s, %s, %s, %s)", id, packageType, name, value)
+
+	wantValue, wantLinkID, err := unitVarValue(value, linkID)
+	if err != nil {
+		return err
+	}
+
+	q := t.queries
+
+	exists := false
+	uv, err := q.ReadUnitVar(ctx, pgUnitVarParams(id, packageType, name))
+	switch {
+	case err == sql.ErrNoRows:
+	case err != nil:
+		return err
+	default:
+		exists = true
+	}
+
+	// It exists but it does not require further updates.
+	if exists && wantValue == uv.Variablevalue && wantLinkID == uv.LinkID {
+		return nil
+	}
+
+	// It exists and requires further updates but we rather raise an error.
+	if !updateExisting {
+		return errors.New("variable exists but with different propreties")
+	}
+
+	if exists {
+		if err := q.UpdateUnitVar(ctx, &sqlc.UpdateUnitVarParams{
+			Value:    wantValue,
+			LinkID:   wantLinkID,
+			UnitID:   uuid.NullUUID{UUID: id, Valid: true},
+			UnitType: sql.NullString{String: packageType.String(), Valid: true},
+			Name:     sql.NullString{String: name, Valid: true},
+		}); err != nil {
+			return fmt.Errorf("update: %w", err)
+		}
+	} else {
+		if err := q.CreateUnitVar(ctx, &sqlc.CreateUnitVarParams{
+			UnitID:   uuid.NullUUID{UUID: id, Valid: true},
+			UnitType: sql.NullString{String: packageType.String(), Valid: true},
+			Name:     sql.NullString{String: name, Valid: true},
+			Value:    wantValue,
+			LinkID:   wantLinkID,
+		}); err != nil {
+			return fmt.Errorf("create: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func pgUnitVarParams(id uuid.UUID, packageType enums.PackageType, name string) *sqlc.ReadUnitVarParams {
+	return &sqlc.ReadUnitVarParams{
+		UnitID:   uuid.NullUUID{UUID: id, Valid: true},
+		UnitType: sql.NullString{String: packageType.String(), Valid: true},
+		Name:     sql.NullString{String: name, Valid: true},
+	}
+}
+
+func (s *postgresStoreImpl) Files(ctx context.Context, id uuid.UUID, packageType enums.PackageType, filterFilenameEnd, filterSubdir, replacementPath string) iter.Seq2[File, error] {
+	return listFiles(func(after uuid.UUID) (_ []File, err error) {
+		defer wrap(&err, "Files(%s, %s, %s, %s, %s)", id, packageType, filterFilenameEnd, filterSubdir, replacementPath)
+
+		params := &sqlc.ListFilesParams{After: after, BatchSize: filesPageSize}
+		if filterFilenameEnd != "" {
+			params.LocationLike = []byte("%" + filterFilenameEnd)
+		}
+		if filterSubdir != "" {
+			params.PrefixLike = []byte(replacementPath + filterSubdir + "%")
+		}
+		switch packageType {
+		case enums.PackageTypeTransfer:
+			params.TransferID = uuid.NullUUID{UUID: id, Valid: true}
+		case enums.PackageTypeSIP, enums.PackageTypeDIP:
+			params.SipID = uuid.NullUUID{UUID: id, Valid: true}
+		default:
+			return nil, fmt.Errorf("unexpected package type: %q", packageType)
+		}
+
+		rows, err := s.queries.ListFiles(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+		ret := make([]File, 0, len(rows))
+		for _, row := range rows {
+			ret = append(ret, File{
+				ID:               row.Fileuuid,
+				CurrentLocation:  string(row.Currentlocation),
+				OriginalLocation: string(row.Originallocation),
+				FileGrpUse:       row.Filegrpuse,
+			})
+		}
+
+		return ret, nil
+	})
+}
+
+// KnownFileLocations builds the list of parameters of the query, which sqlc
+// can only generate with the array types of lib/pq.
+func (s *postgresStoreImpl) KnownFileLocations(ctx context.Context, id uuid.UUID, packageType enums.PackageType, locations []string) (_ map[string]struct{}, err error) {
+	defer wrap(&err, "KnownFileLocations(%s, %s)", id, packageType)
+
+	ret := map[string]struct{}{}
+	if len(locations) == 0 {
+		return ret, nil
+	}
+
+	var column string
+	switch packageType {
+	case enums.PackageTypeTransfer:
+		column = "transferUUID"
+	case enums.PackageTypeSIP, enums.PackageTypeDIP:
+		column = "sipUUID"
+	default:
+		return nil, fmt.Errorf("unexpected package type: %q", packageType)
+	}
+
+	var b strings.Builder
+	args := make([]any, 0, len(locations)+1)
+	args = append(args, id)
+	b.WriteString("SELECT currentLocation FROM Files WHERE " + column + " = $1 AND currentLocation IN (")
+	for i, loc := range locations {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		fmt.Fprintf(&b, "$%d", i+2)
+		args = append(args, []byte(loc))
+	}
+	b.WriteString(")")
+
+	rows, err := s.pool.QueryContext(ctx, b.String(), args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var loc []byte
+		if err := rows.Scan(&loc); err != nil {
+			return nil, err
+		}
+		ret[string(loc)] = struct{}{}
+	}
+
+	return ret, rows.Err()
+}
+
+func (s *postgresStoreImpl) ReadPipelineID(ctx context.Context) (_ uuid.UUID, err error) {
+	defer wrap(&err, "ReadPipelineID()")
+
+	ret, err := s.queries.ReadDashboardSetting(ctx, "dashboard_uuid")
+	if err == sql.ErrNoRows {
+		return uuid.Nil, ErrNotFound
+	}
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	return uuid.Parse(ret.Value)
+}
+
+func (s *postgresStoreImpl) ReadDict(ctx context.Context, name string) (_ map[string]string, err error) {
+	defer wrap(&err, "ReadDict(%s)", name)
+
+	rows, err := s.queries.ReadDashboardSettingsWithScope(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, ErrNotFound
+	}
+
+	ret := make(map[string]string, len(rows))
+	for _, row := range rows {
+		ret[row.Name] = row.Value
+	}
+
+	return ret, nil
+}
+
+func (s *postgresStoreImpl) ValidateUserAPIKey(ctx context.Context, username, key string) (_ *User, err error) {
+	defer wrap(&err, "ValidateUserAPIKey(%q, %q)", username, key)
+
+	row, err := s.queries.ReadUserWithKey(ctx, &sqlc.ReadUserWithKeyParams{
+		Username: username,
+		Key:      key,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return pgUser(row.ID, row.Username, row.Email, row.IsActive, row.AgentID), nil
+}
+
+func (s *postgresStoreImpl) ReadUserWithUsername(ctx context.Context, username string) (_ *User, err error) {
+	defer wrap(&err, "ReadUserWithUsername(%q)", username)
+
+	row, err := s.queries.ReadUserWithUsername(ctx, username)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return pgUser(row.ID, row.Username, row.Email, row.IsActive, row.AgentID), nil
+}
+
+func (s *postgresStoreImpl) ValidateUserPassword(ctx context.Context, username, password string) (_ *User, err error) {
+	defer wrap(&err, "ValidateUserPassword(%q)", username)
+
+	row, err := s.queries.ReadUserWithPassword(ctx, username)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if !checkPassword(row.Password, password) {
+		return nil, nil
+	}
+
+	return pgUser(row.ID, row.Username, row.Email, row.IsActive, row.AgentID), nil
+}
+
+func pgUser(id int32, username, email string, active bool, agentID sql.NullInt32) *User {
+	ret := &User{
+		ID:       int(id),
+		Username: username,
+		Email:    email,
+		Active:   active,
+	}
+	if agentID.Valid {
+		ret.AgentID = ref.New(int(agentID.Int32))
+	}
+
+	return ret
+}
+
+func (s *postgresStoreImpl) ReadUserAPIKey(ctx context.Context, userID int) (_ string, err error) {
+	defer wrap(&err, "ReadUserAPIKey(%d)", userID)
+
+	key, err := s.queries.ReadUserAPIKey(ctx, int32(userID))
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", ErrNotFound
+	}
+	if err != nil {
+		return "", err
+	}
+
+	return key, nil
+}
+
+func (s *postgresStoreImpl) ReadUserGroups(ctx context.Context, userID int) (_ []string, err error) {
+	defer wrap(&err, "ReadUserGroups(%d)", userID)
+
+	return s.queries.ReadUserGroups(ctx, int32(userID))
+}
+
+func (s *postgresStoreImpl) CreateAuditEvent(ctx context.Context, event *AuditEvent) (err error) {
+	defer wrap(&err, "CreateAuditEvent(%s)", event.Procedure)
+
+	id, err := s.queries.CreateAuditEvent(ctx, &sqlc.CreateAuditEventParams{
+		CreatedAt:     event.CreatedAt,
+		Userid:        event.UserID,
+		Username:      event.Username,
+		Procedurename: event.Procedure,
+		Summary:       event.Summary,
+		Outcome:       event.Outcome,
+		Message:       event.Message,
+		Packageuuid:   event.PackageID,
+		Decisionuuid:  event.DecisionID,
+	})
+	if err != nil {
+		return err
+	}
+
+	event.ID = id
+
+	return nil
+}
+
+func (s *postgresStoreImpl) ListAuditEvents(ctx context.Context, params *ListAuditEventsParams) (_ []*AuditEvent, err error) {
+	defer wrap(&err, "ListAuditEvents(params)")
+
+	p := &sqlc.ListAuditEventsParams{}
+	if params.Username != nil {
+		p.Username = sql.NullString{String: *params.Username, Valid: true}
+	}
+	if params.Procedure != nil {
+		p.ProcedureName = sql.NullString{String: *params.Procedure, Valid: true}
+	}
+	if params.PackageID != nil {
+		p.PackageID = uuid.NullUUID{UUID: *params.PackageID, Valid: true}
+	}
+	if params.DecisionID != nil {
+		p.DecisionID = uuid.NullUUID{UUID: *params.DecisionID, Valid: true}
+	}
+	if params.Outcome != nil {
+		p.Outcome = sql.NullString{String: *params.Outcome, Valid: true}
+	}
+	if params.Since != nil {
+		p.Since = sql.NullTime{Time: *params.Since, Valid: true}
+	}
+	if params.Until != nil {
+		p.Until = sql.NullTime{Time: *params.Until, Valid: true}
+	}
+	if params.BeforeID != nil {
+		p.BeforeID = sql.NullInt64{Int64: *params.BeforeID, Valid: true}
+	}
+	if params.Limit > 0 {
+		p.MaxEvents = sql.NullInt64{Int64: int64(params.Limit), Valid: true}
+	}
+
+	rows, err := s.queries.ListAuditEvents(ctx, p)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make([]*AuditEvent, 0, len(rows))
+	for _, row := range rows {
+		ret = append(ret, &AuditEvent{
+			ID:         row.ID,
+			CreatedAt:  row.CreatedAt,
+			UserID:     row.Userid,
+			Username:   row.Username,
+			Procedure:  row.Procedurename,
+			Summary:    row.Summary,
+			Outcome:    row.Outcome,
+			Message:    row.Message,
+			PackageID:  row.Packageuuid,
+			DecisionID: row.Decisionuuid,
+		})
+	}
+
+	return ret, nil
+}
+
+func (s *postgresStoreImpl) CreateQueueEntry(ctx context.Context, entry *QueueEntry) (err error) {
+	defer wrap(&err, "CreateQueueEntry(%s)", entry.PackageID)
+
+	id, err := s.queries.CreateQueueEntry(ctx, &sqlc.CreateQueueEntryParams{
+		Packageuuid:    entry.PackageID,
+		Packagetype:    entry.PackageType.String(),
+		Path:           entry.Path,
+		Startchainuuid: entry.StartChainID,
+		Startlinkuuid:  entry.StartLinkID,
+		Priority:       entry.Priority,
+		Size:           int64(entry.Size),
+		Tag:            entry.Tag,
+		Queuedtime:     entry.QueuedAt,
+	})
+	if err != nil {
+		return err
+	}
+
+	entry.ID = id
+
+	return nil
+}
+
+func (s *postgresStoreImpl) UpdateQueueEntryTag(ctx context.Context, pkgID uuid.UUID, tag float64) (err error) {
+	defer wrap(&err, "UpdateQueueEntryTag(%s, %f)", pkgID, tag)
+
+	return s.queries.UpdateQueueEntryTag(ctx, &sqlc.UpdateQueueEntryTagParams{
+		Tag:         tag,
+		Packageuuid: pkgID,
+	})
+}
+
+func (s *postgresStoreImpl) DeleteQueueEntry(ctx context.Context, pkgID uuid.UUID) (err error) {
+	defer wrap(&err, "DeleteQueueEntry(%s)", pkgID)
+
+	return s.queries.DeleteQueueEntry(ctx, pkgID)
+}
+
+func (s *postgresStoreImpl) ListQueueEntries(ctx context.Context) (_ []*QueueEntry, err error) {
+	defer wrap(&err, "ListQueueEntries()")
+
+	rows, err := s.queries.ListQueueEntries(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make([]*QueueEntry, 0, len(rows))
+	for _, row := range rows {
+		packageType, err := enums.ParsePackageType(row.Packagetype)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, &QueueEntry{
+			ID:           row.ID,
+			PackageID:    row.Packageuuid,
+			PackageType:  packageType,
+			Path:         row.Path,
+			StartChainID: row.Startchainuuid,
+			StartLinkID:  row.Startlinkuuid,
+			Priority:     row.Priority,
+			Size:         uint64(row.Size),
+			Tag:          row.Tag,
+			QueuedAt:     row.Queuedtime,
+		})
+	}
+
+	return ret, nil
+}
+
+func (s *postgresStoreImpl) AcquireLease(ctx context.Context, name, holder string, ttl time.Duration) (_ bool, err error) {
+	defer wrap(&err, "AcquireLease(%s, %s, %s)", name, holder, ttl)
+
+	// The expiration is computed with the clock of the database so it does
+	// not depend on the clocks of the instances competing for the lease. No
+	// row is returned when the lease is held by someone else.
+	_, err = s.queries.AcquireLease(ctx, &sqlc.AcquireLeaseParams{
+		Name:   name,
+		Holder: holder,
+		Ttl:    ttl.Microseconds(),
+	})
+	if err == sql.ErrNoRows {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (s *postgresStoreImpl) ReadLeaseHolder(ctx context.Context, name string) (_ string, err error) {
+	defer wrap(&err, "ReadLeaseHolder(%s)", name)
+
+	holder, err := s.queries.ReadLeaseHolder(ctx, name)
+	if err == sql.ErrNoRows {
+		return "", ErrNotFound
+	} else if err != nil {
+		return "", err
+	}
+
+	return holder, nil
+}
+
+func (s *postgresStoreImpl) ReleaseLease(ctx context.Context, name, holder string) (err error) {
+	defer wrap(&err, "ReleaseLease(%s, %s)", name, holder)
+
+	return s.queries.ReleaseLease(ctx, &sqlc.ReleaseLeaseParams{
+		Name:   name,
+		Holder: holder,
+	})
+}
+
+func (s *postgresStoreImpl) Ping(ctx context.Context) error {
+	return s.pool.PingContext(ctx)
+}
+
+func (s *postgresStoreImpl) Running() bool {
+	return s != nil
+}
+
+func (s *postgresStoreImpl) Close() error {
+	var err error
+
+	if s.queries != nil {
+		err = errors.Join(err, s.queries.Close())
+	}
+
+	if s.pool != nil {
+		err = errors.Join(err, s.pool.Close())
+	}
+
+	return err
+}
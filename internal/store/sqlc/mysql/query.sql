@@ -11,6 +11,12 @@ UPDATE Jobs SET currentStep = ? WHERE jobUUID = ?;
 -- name: ListJobs :many
 SELECT * FROM Jobs WHERE SIPUUID = ? ORDER BY createdTime DESC;
 
+-- name: ListTransferJobs :many
+SELECT * FROM Jobs WHERE SIPUUID IN (SELECT transferUUID FROM Transfers WHERE hidden = 0) ORDER BY createdTime DESC;
+
+-- name: ListSIPJobs :many
+SELECT * FROM Jobs WHERE SIPUUID IN (SELECT sipUUID FROM SIPs WHERE hidden = 0) ORDER BY createdTime DESC;
+
 -- name: ListTransfersWithCreationTimestamps :many
 SELECT
     j.SIPUUID,
@@ -64,6 +70,13 @@ SELECT transferUUID, currentLocation FROM Transfers WHERE transferUUID = ?;
 -- name: ReadTransferWithLocation :one
 SELECT transferUUID FROM Transfers WHERE currentLocation = ?;
 
+-- name: ReadTransferWithIdempotencyKey :one
+SELECT k.unitUUID, d.variableValue
+FROM UnitVariables k
+LEFT JOIN UnitVariables d ON d.unitType = k.unitType AND d.unitUUID = k.unitUUID AND d.variable = 'idempotencyDigest'
+WHERE k.unitType = 'Transfer' AND k.variable = 'idempotencyKey' AND k.variableValue = sqlc.arg(idempotency_key)
+LIMIT 1;
+
 -- name: UpdateTransferLocation :exec
 UPDATE Transfers SET currentLocation = ? WHERE transferUUID = ?;
 
@@ -106,10 +119,10 @@ DELETE FROM Jobs WHERE currentStep = 1;
 UPDATE Jobs SET currentStep = 4 WHERE currentStep = 3;
 
 -- name: CleanUpActiveTransfers :exec
-UPDATE Transfers SET status = 4, completed_at = UTC_TIMESTAMP() WHERE status IN (0, 1);
+UPDATE Transfers SET status = 4, completed_at = UTC_TIMESTAMP() WHERE status IN (0, 1) AND transferUUID NOT IN (SELECT packageUUID FROM PackageQueue);
 
 -- name: CleanUpActiveSIPs :exec
-UPDATE SIPs SET status = 4, completed_at = UTC_TIMESTAMP() WHERE status IN (0, 1);
+UPDATE SIPs SET status = 4, completed_at = UTC_TIMESTAMP() WHERE status IN (0, 1) AND sipUUID NOT IN (SELECT packageUUID FROM PackageQueue);
 
 -- name: CleanUpActiveTasks :exec
 UPDATE Tasks SET exitCode = -1, stdError = "MCP shut down while processing." WHERE exitCode IS NULL;
@@ -173,3 +186,46 @@ JOIN tastypie_apikey ON auth_user.id = tastypie_apikey.user_id
 LEFT JOIN main_userprofile ON auth_user.id = main_userprofile.user_id
 WHERE auth_user.username = ? AND tastypie_apikey.key = ? AND auth_user.is_active = 1
 LIMIT 1;
+
+-- name: ReadUserWithPassword :one
+SELECT auth_user.id, auth_user.username, auth_user.email, auth_user.is_active, auth_user.password, main_userprofile.agent_id
+FROM auth_user
+LEFT JOIN main_userprofile ON auth_user.id = main_userprofile.user_id
+WHERE auth_user.username = ? AND auth_user.is_active = 1
+LIMIT 1;
+
+-- name: ReadUserWithUsername :one
+SELECT auth_user.id, auth_user.username, auth_user.email, auth_user.is_active, main_userprofile.agent_id
+FROM auth_user
+LEFT JOIN main_userprofile ON auth_user.id = main_userprofile.user_id
+WHERE auth_user.username = ?
+LIMIT 1;
+
+-- name: ReadUserAPIKey :one
+SELECT tastypie_apikey.key
+FROM tastypie_apikey
+WHERE tastypie_apikey.user_id = ?
+LIMIT 1;
+
+-- name: ReadUserGroups :many
+SELECT auth_group.name
+FROM auth_group
+JOIN auth_user_groups ON auth_group.id = auth_user_groups.group_id
+WHERE auth_user_groups.user_id = ?
+ORDER BY auth_group.name;
+
+--
+-- Leases
+--
+
+-- name: AcquireLease :exec
+INSERT INTO Leases (name, holder, expiresTime) VALUES (sqlc.arg(name), sqlc.arg(holder), NOW(6) + INTERVAL sqlc.arg(ttl) MICROSECOND)
+ON DUPLICATE KEY UPDATE
+  holder = IF(holder = VALUES(holder) OR expiresTime < NOW(6), VALUES(holder), holder),
+  expiresTime = IF(holder = VALUES(holder), VALUES(expiresTime), expiresTime);
+
+-- name: ReadLeaseHolder :one
+SELECT holder FROM Leases WHERE name = ? AND expiresTime > NOW(6);
+
+-- name: ReleaseLease :exec
+DELETE FROM Leases WHERE name = ? AND holder = ?;
@@ -0,0 +1,341 @@
+--
+-- Jobs
+--
+
+-- name: CreateJob :exec
+INSERT INTO Jobs (jobUUID, jobType, createdTime, createdTimeDec, directory, SIPUUID, unitType, currentStep, microserviceGroup, hidden, MicroServiceChainLinksPK, subJobOf) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12);
+
+-- name: UpdateJobStatus :exec
+UPDATE Jobs SET currentStep = $1 WHERE jobUUID = $2;
+
+-- name: FindAwaitingJob :one
+SELECT jobUUID, SIPUUID
+FROM Jobs
+WHERE currentStep = 1
+    AND (sqlc.narg(directory)::text IS NULL OR directory = sqlc.narg(directory)::text)
+    AND (sqlc.narg(package_id)::uuid IS NULL OR (SIPUUID = sqlc.narg(package_id)::uuid AND microserviceGroup = sqlc.arg(microservice_group)))
+LIMIT 1;
+
+-- name: ListJobs :many
+SELECT * FROM Jobs WHERE SIPUUID = $1 ORDER BY createdTime DESC;
+
+-- name: ListTransferJobs :many
+SELECT * FROM Jobs WHERE SIPUUID IN (SELECT transferUUID FROM Transfers WHERE hidden = false) ORDER BY createdTime DESC;
+
+-- name: ListSIPJobs :many
+SELECT * FROM Jobs WHERE SIPUUID IN (SELECT sipUUID FROM SIPs WHERE hidden = false) ORDER BY createdTime DESC;
+
+-- name: ListTransfersWithCreationTimestamps :many
+SELECT
+    j.SIPUUID,
+    j.createdTime AS created_at,
+    j.createdTimeDec AS created_at_dec,
+    t.status
+FROM Jobs j
+JOIN (
+    SELECT
+        SIPUUID,
+        MAX(createdTime) AS max_created_at
+    FROM Jobs
+    WHERE unitType = 'unitTransfer'
+    GROUP BY SIPUUID
+) AS latest_jobs ON j.SIPUUID = latest_jobs.SIPUUID AND j.createdTime = latest_jobs.max_created_at
+LEFT JOIN Transfers t ON t.transferUUID = j.SIPUUID
+WHERE j.unitType = 'unitTransfer' AND t.hidden = false;
+
+-- name: ListSIPsWithCreationTimestamps :many
+SELECT
+    j.SIPUUID,
+    j.createdTime AS created_at,
+    j.createdTimeDec AS created_at_dec,
+    s.status
+FROM Jobs j
+JOIN (
+    SELECT
+        SIPUUID,
+        MAX(createdTime) AS max_created_at
+    FROM Jobs
+    WHERE unitType = 'unitSIP'
+    GROUP BY SIPUUID
+) AS latest_jobs ON j.SIPUUID = latest_jobs.SIPUUID AND j.createdTime = latest_jobs.max_created_at
+LEFT JOIN SIPs s ON s.sipUUID = j.SIPUUID
+WHERE j.unitType = 'unitSIP' AND s.hidden = false;
+
+--
+-- Tasks
+--
+
+-- name: CreateTasks :exec
+INSERT INTO Tasks (taskUUID, createdTime, fileUUID, fileName, exec, arguments, startTime, endTime, client, stdOut, stdError, exitCode, jobuuid)
+SELECT t.taskUUID, t.createdTime, t.fileUUID, t.fileName, t.exec, t.arguments, t.startTime, t.endTime, t.client, t.stdOut, t.stdError, t.exitCode, t.jobuuid
+FROM json_to_recordset(sqlc.arg(tasks)::json) AS t(
+    taskUUID uuid,
+    createdTime timestamptz,
+    fileUUID uuid,
+    fileName text,
+    exec varchar(250),
+    arguments varchar(1000),
+    startTime timestamptz,
+    endTime timestamptz,
+    client varchar(50),
+    stdOut text,
+    stdError text,
+    exitCode bigint,
+    jobuuid uuid
+);
+
+-- name: UpdateTasks :exec
+UPDATE Tasks SET endTime = r.endTime, exitCode = r.exitCode, stdOut = r.stdOut, stdError = r.stdError
+FROM json_to_recordset(sqlc.arg(results)::json) AS r(
+    taskUUID uuid,
+    endTime timestamptz,
+    exitCode bigint,
+    stdOut text,
+    stdError text
+)
+WHERE Tasks.taskUUID = r.taskUUID;
+
+--
+-- Transfers
+--
+
+-- name: CreateTransfer :exec
+INSERT INTO Transfers (transferUUID, currentLocation, type, accessionID, sourceOfAcquisition, typeOfTransfer, description, notes, access_system_id, hidden, transferMetadataSetRowUUID, dirUUIDs, status, completed_at)
+VALUES ($1, $2, '', $3, '', '', '', '', $4, false, $5, false, 0, NULL);
+
+-- name: ReadTransfer :one
+SELECT transferUUID, currentLocation, type, accessionID, sourceOfAcquisition, typeOfTransfer, description, notes, access_system_id, hidden, transferMetadataSetRowUUID, dirUUIDs, status, completed_at FROM Transfers WHERE transferUUID = $1;
+
+-- name: ReadTransferLocation :one
+SELECT transferUUID, currentLocation FROM Transfers WHERE transferUUID = $1;
+
+-- name: ReadTransferWithLocation :one
+SELECT transferUUID FROM Transfers WHERE currentLocation = $1;
+
+-- name: ReadTransferWithIdempotencyKey :one
+SELECT k.unitUUID, d.variableValue
+FROM UnitVariables k
+LEFT JOIN UnitVariables d ON d.unitType = k.unitType AND d.unitUUID = k.unitUUID AND d.variable = 'idempotencyDigest'
+WHERE k.unitType = 'Transfer' AND k.variable = 'idempotencyKey' AND k.variableValue = sqlc.arg(idempotency_key)
+LIMIT 1;
+
+-- name: UpdateTransferLocation :exec
+UPDATE Transfers SET currentLocation = $1 WHERE transferUUID = $2;
+
+-- name: UpdateTransferStatus :exec
+UPDATE Transfers SET status = sqlc.arg(status), completed_at = COALESCE(sqlc.narg(completed_at), completed_at) WHERE transferUUID = sqlc.arg(transferuuid);
+
+--
+-- SIPs
+--
+
+-- name: CreateSIP :exec
+INSERT INTO SIPs (sipUUID, createdTime, currentPath, hidden, aipFilename, sipType, dirUUIDs, status, completed_at) VALUES ($1, now(), $2, false, '', $3, false, 0, NULL);
+
+-- name: ReadSIP :one
+SELECT sipUUID, createdTime, currentPath, hidden, aipFilename, sipType, dirUUIDs, status, completed_at FROM SIPs WHERE sipUUID = $1;
+
+-- name: ReadSIPLocation :one
+SELECT sipUUID, currentPath FROM SIPs WHERE sipUUID = $1;
+
+-- name: ReadSIPWithLocation :one
+SELECT sipUUID FROM SIPs WHERE currentPath = $1;
+
+-- name: UpdateSIPLocation :exec
+UPDATE SIPs SET currentPath = $1 WHERE sipUUID = $2;
+
+-- name: UpdateSIPStatus :exec
+UPDATE SIPs SET status = sqlc.arg(status), completed_at = COALESCE(sqlc.narg(completed_at), completed_at) WHERE sipUUID = sqlc.arg(sipuuid);
+
+--
+-- Clean-ups
+--
+
+-- name: CleanUpTasksWithAwaitingJobs :exec
+DELETE FROM Tasks WHERE jobuuid IN (SELECT jobUUID FROM Jobs WHERE currentStep = 1);
+
+-- name: CleanUpAwaitingJobs :exec
+DELETE FROM Jobs WHERE currentStep = 1;
+
+-- name: CleanUpActiveJobs :exec
+UPDATE Jobs SET currentStep = 4 WHERE currentStep = 3;
+
+-- name: CleanUpActiveTransfers :exec
+UPDATE Transfers SET status = 4, completed_at = now() WHERE status IN (0, 1) AND transferUUID NOT IN (SELECT packageUUID FROM PackageQueue);
+
+-- name: CleanUpActiveSIPs :exec
+UPDATE SIPs SET status = 4, completed_at = now() WHERE status IN (0, 1) AND sipUUID NOT IN (SELECT packageUUID FROM PackageQueue);
+
+-- name: CleanUpActiveTasks :exec
+UPDATE Tasks SET exitCode = -1, stdError = 'MCP shut down while processing.' WHERE exitCode IS NULL;
+
+--
+-- Unit variables
+--
+
+-- name: ReadUnitVar :one
+SELECT variableValue, microServiceChainLink FROM UnitVariables WHERE unitType = sqlc.arg(unit_type) AND unitUUID = sqlc.arg(unit_id) AND variable = sqlc.arg(name);
+
+-- name: ReadUnitVars :many
+SELECT unitType, unitUUID, variable, variableValue, microServiceChainLink FROM UnitVariables WHERE unitUUID = sqlc.arg(unit_id) AND variable = sqlc.arg(name);
+
+-- name: CreateUnitVar :exec
+INSERT INTO UnitVariables (pk, unitType, unitUUID, variable, variableValue, microServiceChainLink, createdTime, updatedTime)
+VALUES (
+    gen_random_uuid(),
+    sqlc.arg(unit_type),
+    sqlc.arg(unit_id),
+    sqlc.arg(name),
+    sqlc.arg(value),
+    sqlc.arg(link_id),
+    now(),
+    now()
+);
+
+-- name: UpdateUnitVar :exec
+UPDATE UnitVariables
+SET
+    variableValue = sqlc.arg(value),
+    microServiceChainLink = sqlc.arg(link_id),
+    updatedTime = now()
+WHERE
+    unitType = sqlc.arg(unit_type)
+    AND unitUUID = sqlc.arg(unit_id)
+    AND variable = sqlc.arg(name);
+
+--
+-- Files
+--
+
+-- name: ListFiles :many
+SELECT fileUUID, currentLocation, originalLocation, fileGrpUse
+FROM Files
+WHERE
+    (sqlc.narg(transfer_id)::uuid IS NULL OR transferUUID = sqlc.narg(transfer_id)::uuid)
+    AND (sqlc.narg(sip_id)::uuid IS NULL OR sipUUID = sqlc.narg(sip_id)::uuid)
+    AND (sqlc.narg(location_like)::bytea IS NULL OR currentLocation LIKE sqlc.narg(location_like)::bytea)
+    AND (sqlc.narg(prefix_like)::bytea IS NULL OR currentLocation LIKE sqlc.narg(prefix_like)::bytea)
+    AND fileUUID > sqlc.arg(after)
+ORDER BY fileUUID
+LIMIT sqlc.arg(batch_size);
+
+--
+-- Dashboard settings
+--
+
+-- name: ReadDashboardSettingsWithScope :many
+SELECT name, value, scope FROM DashboardSettings WHERE scope = $1;
+
+-- name: ReadDashboardSettingsWithNameLike :many
+SELECT name, value, scope FROM DashboardSettings WHERE name LIKE $1;
+
+-- name: ReadDashboardSetting :one
+SELECT name, value, scope FROM DashboardSettings WHERE name = $1;
+
+--
+-- Authorization
+--
+
+-- name: ReadUserWithKey :one
+SELECT auth_user.id, auth_user.username, auth_user.email, auth_user.is_active, main_userprofile.agent_id
+FROM auth_user
+JOIN tastypie_apikey ON auth_user.id = tastypie_apikey.user_id
+LEFT JOIN main_userprofile ON auth_user.id = main_userprofile.user_id
+WHERE auth_user.username = $1 AND tastypie_apikey.key = $2 AND auth_user.is_active = true
+LIMIT 1;
+
+-- name: ReadUserWithPassword :one
+SELECT auth_user.id, auth_user.username, auth_user.email, auth_user.is_active, auth_user.password, main_userprofile.agent_id
+FROM auth_user
+LEFT JOIN main_userprofile ON auth_user.id = main_userprofile.user_id
+WHERE auth_user.username = $1 AND auth_user.is_active = true
+LIMIT 1;
+
+-- name: ReadUserWithUsername :one
+SELECT auth_user.id, auth_user.username, auth_user.email, auth_user.is_active, main_userprofile.agent_id
+FROM auth_user
+LEFT JOIN main_userprofile ON auth_user.id = main_userprofile.user_id
+WHERE auth_user.username = $1
+LIMIT 1;
+
+-- name: ReadUserAPIKey :one
+SELECT tastypie_apikey.key
+FROM tastypie_apikey
+WHERE tastypie_apikey.user_id = $1
+LIMIT 1;
+
+-- name: ReadUserGroups :many
+SELECT auth_group.name
+FROM auth_group
+JOIN auth_user_groups ON auth_group.id = auth_user_groups.group_id
+WHERE auth_user_groups.user_id = $1
+ORDER BY auth_group.name;
+
+--
+-- Audit trail
+--
+
+-- name: CreateAuditEvent :one
+INSERT INTO AuditEvents (createdTime, userID, username, procedureName, summary, outcome, message, packageUUID, decisionUUID)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+RETURNING pk;
+
+-- name: ListAuditEvents :many
+SELECT pk, createdTime, userID, username, procedureName, summary, outcome, message, packageUUID, decisionUUID
+FROM AuditEvents
+WHERE
+    (sqlc.narg(username)::text IS NULL OR username = sqlc.narg(username)::text)
+    AND (sqlc.narg(procedure_name)::text IS NULL OR procedureName = sqlc.narg(procedure_name)::text)
+    AND (sqlc.narg(package_id)::uuid IS NULL OR packageUUID = sqlc.narg(package_id)::uuid)
+    AND (sqlc.narg(decision_id)::uuid IS NULL OR decisionUUID = sqlc.narg(decision_id)::uuid)
+    AND (sqlc.narg(outcome)::text IS NULL OR outcome = sqlc.narg(outcome)::text)
+    AND (sqlc.narg(since)::timestamptz IS NULL OR createdTime >= sqlc.narg(since)::timestamptz)
+    AND (sqlc.narg(until)::timestamptz IS NULL OR createdTime < sqlc.narg(until)::timestamptz)
+    AND (sqlc.narg(before_id)::bigint IS NULL OR pk < sqlc.narg(before_id)::bigint)
+ORDER BY pk DESC
+LIMIT sqlc.narg(max_events)::bigint;
+
+--
+-- Processing queue
+--
+
+-- name: CreateQueueEntry :one
+INSERT INTO PackageQueue (packageUUID, packageType, path, startChainUUID, startLinkUUID, priority, size, tag, queuedTime)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+ON CONFLICT (packageUUID) DO UPDATE SET
+    packageType = EXCLUDED.packageType,
+    path = EXCLUDED.path,
+    startChainUUID = EXCLUDED.startChainUUID,
+    startLinkUUID = EXCLUDED.startLinkUUID,
+    priority = EXCLUDED.priority,
+    size = EXCLUDED.size,
+    tag = EXCLUDED.tag,
+    queuedTime = EXCLUDED.queuedTime
+RETURNING pk;
+
+-- name: UpdateQueueEntryTag :exec
+UPDATE PackageQueue SET tag = $1 WHERE packageUUID = $2;
+
+-- name: DeleteQueueEntry :exec
+DELETE FROM PackageQueue WHERE packageUUID = $1;
+
+-- name: ListQueueEntries :many
+SELECT pk, packageUUID, packageType, path, startChainUUID, startLinkUUID, priority, size, tag, queuedTime FROM PackageQueue ORDER BY tag, pk;
+
+--
+-- Leases
+--
+
+-- name: AcquireLease :one
+INSERT INTO Leases (name, holder, expiresTime) VALUES (sqlc.arg(name), sqlc.arg(holder), now() + sqlc.arg(ttl)::bigint * interval '1 microsecond')
+ON CONFLICT (name) DO UPDATE SET
+    holder = EXCLUDED.holder,
+    expiresTime = EXCLUDED.expiresTime
+WHERE Leases.holder = EXCLUDED.holder OR Leases.expiresTime < now()
+RETURNING holder;
+
+-- name: ReadLeaseHolder :one
+SELECT holder FROM Leases WHERE name = $1 AND expiresTime > now();
+
+-- name: ReleaseLease :exec
+DELETE FROM Leases WHERE name = $1 AND holder = $2;
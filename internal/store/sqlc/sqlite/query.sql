@@ -0,0 +1,318 @@
+--
+-- Jobs
+--
+
+-- name: CreateJob :exec
+INSERT INTO Jobs (jobUUID, jobType, createdTime, createdTimeDec, directory, SIPUUID, unitType, currentStep, microserviceGroup, hidden, MicroServiceChainLinksPK, subJobOf) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?);
+
+-- name: UpdateJobStatus :exec
+UPDATE Jobs SET currentStep = ? WHERE jobUUID = ?;
+
+-- name: FindAwaitingJob :one
+SELECT jobUUID, SIPUUID
+FROM Jobs
+WHERE currentStep = 1
+    AND (CAST(sqlc.narg(directory) AS TEXT) IS NULL OR directory = sqlc.narg(directory))
+    AND (CAST(sqlc.narg(package_id) AS TEXT) IS NULL OR (SIPUUID = sqlc.narg(package_id) AND microserviceGroup = sqlc.arg(microservice_group)))
+LIMIT 1;
+
+-- name: ListJobs :many
+SELECT * FROM Jobs WHERE SIPUUID = ? ORDER BY createdTime DESC;
+
+-- name: ListTransferJobs :many
+SELECT * FROM Jobs WHERE SIPUUID IN (SELECT transferUUID FROM Transfers WHERE hidden = 0) ORDER BY createdTime DESC;
+
+-- name: ListSIPJobs :many
+SELECT * FROM Jobs WHERE SIPUUID IN (SELECT sipUUID FROM SIPs WHERE hidden = 0) ORDER BY createdTime DESC;
+
+-- name: ListTransfersWithCreationTimestamps :many
+SELECT
+    j.SIPUUID,
+    j.createdTime AS created_at,
+    j.createdTimeDec AS created_at_dec,
+    t.status
+FROM Jobs j
+JOIN (
+    SELECT
+        SIPUUID,
+        MAX(createdTime) AS max_created_at
+    FROM Jobs
+    WHERE unitType = 'unitTransfer'
+    GROUP BY SIPUUID
+) AS latest_jobs ON j.SIPUUID = latest_jobs.SIPUUID AND j.createdTime = latest_jobs.max_created_at
+LEFT JOIN Transfers t ON t.transferUUID = j.SIPUUID
+WHERE j.unitType = 'unitTransfer' AND t.hidden = 0;
+
+-- name: ListSIPsWithCreationTimestamps :many
+SELECT
+    j.SIPUUID,
+    j.createdTime AS created_at,
+    j.createdTimeDec AS created_at_dec,
+    s.status
+FROM Jobs j
+JOIN (
+    SELECT
+        SIPUUID,
+        MAX(createdTime) AS max_created_at
+    FROM Jobs
+    WHERE unitType = 'unitSIP'
+    GROUP BY SIPUUID
+) AS latest_jobs ON j.SIPUUID = latest_jobs.SIPUUID AND j.createdTime = latest_jobs.max_created_at
+LEFT JOIN SIPs s ON s.sipUUID = j.SIPUUID
+WHERE j.unitType = 'unitSIP' AND s.hidden = 0;
+
+--
+-- Tasks
+--
+
+-- name: CreateTask :exec
+INSERT INTO Tasks (taskUUID, createdTime, fileUUID, fileName, exec, arguments, startTime, endTime, client, stdOut, stdError, exitCode, jobuuid) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?);
+
+-- name: UpdateTask :exec
+UPDATE Tasks SET endTime = ?, exitCode = ?, stdOut = ?, stdError = ? WHERE taskUUID = ?;
+
+--
+-- Transfers
+--
+
+-- name: CreateTransfer :exec
+INSERT INTO Transfers (transferUUID, currentLocation, type, accessionID, sourceOfAcquisition, typeOfTransfer, description, notes, access_system_id, hidden, transferMetadataSetRowUUID, dirUUIDs, status, completed_at)
+VALUES (?, ?, '', ?, '', '', '', '', ?, 0, ?, 0, 0, NULL);
+
+-- name: ReadTransfer :one
+SELECT transferUUID, currentLocation, type, accessionID, sourceOfAcquisition, typeOfTransfer, description, notes, access_system_id, hidden, transferMetadataSetRowUUID, dirUUIDs, status, completed_at FROM Transfers WHERE transferUUID = ?;
+
+-- name: ReadTransferLocation :one
+SELECT transferUUID, currentLocation FROM Transfers WHERE transferUUID = ?;
+
+-- name: ReadTransferWithLocation :one
+SELECT transferUUID FROM Transfers WHERE currentLocation = ?;
+
+-- name: ReadTransferWithIdempotencyKey :one
+SELECT k.unitUUID, d.variableValue
+FROM UnitVariables k
+LEFT JOIN UnitVariables d ON d.unitType = k.unitType AND d.unitUUID = k.unitUUID AND d.variable = 'idempotencyDigest'
+WHERE k.unitType = 'Transfer' AND k.variable = 'idempotencyKey' AND k.variableValue = sqlc.arg(idempotency_key)
+LIMIT 1;
+
+-- name: UpdateTransferLocation :exec
+UPDATE Transfers SET currentLocation = ? WHERE transferUUID = ?;
+
+-- name: UpdateTransferStatus :exec
+UPDATE Transfers SET status = sqlc.arg(status), completed_at = COALESCE(sqlc.narg(completed_at), completed_at) WHERE transferUUID = sqlc.arg(transferuuid);
+
+--
+-- SIPs
+--
+
+-- name: CreateSIP :exec
+INSERT INTO SIPs (sipUUID, createdTime, currentPath, hidden, aipFilename, sipType, dirUUIDs, status, completed_at) VALUES (?, ?, ?, 0, '', ?, 0, 0, NULL);
+
+-- name: ReadSIP :one
+SELECT sipUUID, createdTime, currentPath, hidden, aipFilename, sipType, dirUUIDs, status, completed_at FROM SIPs WHERE sipUUID = ?;
+
+-- name: ReadSIPLocation :one
+SELECT sipUUID, currentPath FROM SIPs WHERE sipUUID = ?;
+
+-- name: ReadSIPWithLocation :one
+SELECT sipUUID FROM SIPs WHERE currentPath = ?;
+
+-- name: UpdateSIPLocation :exec
+UPDATE SIPs SET currentPath = ? WHERE sipUUID = ?;
+
+-- name: UpdateSIPStatus :exec
+UPDATE SIPs SET status = sqlc.arg(status), completed_at = COALESCE(sqlc.narg(completed_at), completed_at) WHERE sipUUID = sqlc.arg(sipuuid);
+
+--
+-- Clean-ups
+--
+
+-- name: CleanUpTasksWithAwaitingJobs :exec
+DELETE FROM Tasks WHERE jobuuid IN (SELECT jobUUID FROM Jobs WHERE currentStep = 1);
+
+-- name: CleanUpAwaitingJobs :exec
+DELETE FROM Jobs WHERE currentStep = 1;
+
+-- name: CleanUpActiveJobs :exec
+UPDATE Jobs SET currentStep = 4 WHERE currentStep = 3;
+
+-- name: CleanUpActiveTransfers :exec
+UPDATE Transfers SET status = 4, completed_at = sqlc.arg(completed_at) WHERE status IN (0, 1) AND transferUUID NOT IN (SELECT packageUUID FROM PackageQueue);
+
+-- name: CleanUpActiveSIPs :exec
+UPDATE SIPs SET status = 4, completed_at = sqlc.arg(completed_at) WHERE status IN (0, 1) AND sipUUID NOT IN (SELECT packageUUID FROM PackageQueue);
+
+-- name: CleanUpActiveTasks :exec
+UPDATE Tasks SET exitCode = -1, stdError = 'MCP shut down while processing.' WHERE exitCode IS NULL;
+
+--
+-- Unit variables
+--
+
+-- name: ReadUnitVar :one
+SELECT variableValue, microServiceChainLink FROM UnitVariables WHERE unitType = sqlc.arg(unit_type) AND unitUUID = sqlc.arg(unit_id) AND variable = sqlc.arg(name);
+
+-- name: ReadUnitVars :many
+SELECT unitType, unitUUID, variable, variableValue, microServiceChainLink FROM UnitVariables WHERE unitUUID = sqlc.arg(unit_id) AND variable = sqlc.arg(name);
+
+-- name: CreateUnitVar :exec
+INSERT INTO UnitVariables (pk, unitType, unitUUID, variable, variableValue, microServiceChainLink, createdTime, updatedTime)
+VALUES (
+    sqlc.arg(id),
+    sqlc.arg(unit_type),
+    sqlc.arg(unit_id),
+    sqlc.arg(name),
+    sqlc.arg(value),
+    sqlc.arg(link_id),
+    sqlc.arg(created_at),
+    sqlc.arg(created_at)
+);
+
+-- name: UpdateUnitVar :exec
+UPDATE UnitVariables
+SET
+    variableValue = sqlc.arg(value),
+    microServiceChainLink = sqlc.arg(link_id),
+    updatedTime = sqlc.arg(updated_at)
+WHERE
+    unitType = sqlc.arg(unit_type)
+    AND unitUUID = sqlc.arg(unit_id)
+    AND variable = sqlc.arg(name);
+
+--
+-- Files
+--
+
+-- name: ListFiles :many
+SELECT fileUUID, currentLocation, originalLocation, fileGrpUse
+FROM Files
+WHERE
+    (CAST(sqlc.narg(transfer_id) AS TEXT) IS NULL OR transferUUID = sqlc.narg(transfer_id))
+    AND (CAST(sqlc.narg(sip_id) AS TEXT) IS NULL OR sipUUID = sqlc.narg(sip_id))
+    AND (CAST(sqlc.narg(suffix) AS BLOB) IS NULL OR substr(CAST(currentLocation AS BLOB), length(CAST(currentLocation AS BLOB)) - length(sqlc.narg(suffix)) + 1) = sqlc.narg(suffix))
+    AND (CAST(sqlc.narg(prefix) AS BLOB) IS NULL OR substr(CAST(currentLocation AS BLOB), 1, length(sqlc.narg(prefix))) = sqlc.narg(prefix))
+    AND fileUUID > sqlc.arg(after)
+ORDER BY fileUUID
+LIMIT sqlc.arg(batch_size);
+
+--
+-- Dashboard settings
+--
+
+-- name: ReadDashboardSettingsWithScope :many
+SELECT name, value, scope FROM DashboardSettings WHERE scope = ?;
+
+-- name: ReadDashboardSettingsWithNameLike :many
+SELECT name, value, scope FROM DashboardSettings WHERE name LIKE ?;
+
+-- name: ReadDashboardSetting :one
+SELECT name, value, scope FROM DashboardSettings WHERE name = ?;
+
+--
+-- Authorization
+--
+
+-- name: ReadUserWithKey :one
+SELECT auth_user.id, auth_user.username, auth_user.email, auth_user.is_active, main_userprofile.agent_id
+FROM auth_user
+JOIN tastypie_apikey ON auth_user.id = tastypie_apikey.user_id
+LEFT JOIN main_userprofile ON auth_user.id = main_userprofile.user_id
+WHERE auth_user.username = ? AND tastypie_apikey.key = ? AND auth_user.is_active = 1
+LIMIT 1;
+
+-- name: ReadUserWithPassword :one
+SELECT auth_user.id, auth_user.username, auth_user.email, auth_user.is_active, auth_user.password, main_userprofile.agent_id
+FROM auth_user
+LEFT JOIN main_userprofile ON auth_user.id = main_userprofile.user_id
+WHERE auth_user.username = ? AND auth_user.is_active = 1
+LIMIT 1;
+
+-- name: ReadUserWithUsername :one
+SELECT auth_user.id, auth_user.username, auth_user.email, auth_user.is_active, main_userprofile.agent_id
+FROM auth_user
+LEFT JOIN main_userprofile ON auth_user.id = main_userprofile.user_id
+WHERE auth_user.username = ?
+LIMIT 1;
+
+-- name: ReadUserAPIKey :one
+SELECT tastypie_apikey.key
+FROM tastypie_apikey
+WHERE tastypie_apikey.user_id = ?
+LIMIT 1;
+
+-- name: ReadUserGroups :many
+SELECT auth_group.name
+FROM auth_group
+JOIN auth_user_groups ON auth_group.id = auth_user_groups.group_id
+WHERE auth_user_groups.user_id = ?
+ORDER BY auth_group.name;
+
+--
+-- Audit trail
+--
+
+-- name: CreateAuditEvent :one
+INSERT INTO AuditEvents (createdTime, userID, username, procedureName, summary, outcome, message, packageUUID, decisionUUID)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+RETURNING pk;
+
+-- name: ListAuditEvents :many
+SELECT pk, createdTime, userID, username, procedureName, summary, outcome, message, packageUUID, decisionUUID
+FROM AuditEvents
+WHERE
+    (CAST(sqlc.narg(username) AS TEXT) IS NULL OR username = sqlc.narg(username))
+    AND (CAST(sqlc.narg(procedure_name) AS TEXT) IS NULL OR procedureName = sqlc.narg(procedure_name))
+    AND (CAST(sqlc.narg(package_id) AS TEXT) IS NULL OR packageUUID = sqlc.narg(package_id))
+    AND (CAST(sqlc.narg(decision_id) AS TEXT) IS NULL OR decisionUUID = sqlc.narg(decision_id))
+    AND (CAST(sqlc.narg(outcome) AS TEXT) IS NULL OR outcome = sqlc.narg(outcome))
+    AND (CAST(sqlc.narg(since) AS TEXT) IS NULL OR createdTime >= sqlc.narg(since))
+    AND (CAST(sqlc.narg(until) AS TEXT) IS NULL OR createdTime < sqlc.narg(until))
+    AND (CAST(sqlc.narg(before_id) AS INTEGER) IS NULL OR pk < sqlc.narg(before_id))
+ORDER BY pk DESC
+LIMIT sqlc.arg(max_events);
+
+--
+-- Processing queue
+--
+
+-- name: CreateQueueEntry :one
+INSERT INTO PackageQueue (packageUUID, packageType, path, startChainUUID, startLinkUUID, priority, size, tag, queuedTime)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+ON CONFLICT (packageUUID) DO UPDATE SET
+    packageType = excluded.packageType,
+    path = excluded.path,
+    startChainUUID = excluded.startChainUUID,
+    startLinkUUID = excluded.startLinkUUID,
+    priority = excluded.priority,
+    size = excluded.size,
+    tag = excluded.tag,
+    queuedTime = excluded.queuedTime
+RETURNING pk;
+
+-- name: UpdateQueueEntryTag :exec
+UPDATE PackageQueue SET tag = ? WHERE packageUUID = ?;
+
+-- name: DeleteQueueEntry :exec
+DELETE FROM PackageQueue WHERE packageUUID = ?;
+
+-- name: ListQueueEntries :many
+SELECT pk, packageUUID, packageType, path, startChainUUID, startLinkUUID, priority, size, tag, queuedTime FROM PackageQueue ORDER BY tag, pk;
+
+--
+-- Leases
+--
+
+-- name: ReadLease :one
+SELECT holder, expiresTime FROM Leases WHERE name = ?;
+
+-- name: UpsertLease :exec
+INSERT INTO Leases (name, holder, expiresTime) VALUES (?, ?, ?)
+ON CONFLICT (name) DO UPDATE SET
+    holder = excluded.holder,
+    expiresTime = excluded.expiresTime;
+
+-- name: ReadLeaseHolder :one
+SELECT holder FROM Leases WHERE name = ? AND expiresTime > sqlc.arg(now);
+
+-- name: ReleaseLease :exec
+DELETE FROM Leases WHERE name = ? AND holder = ?;
@@ -24,6 +24,9 @@ func New(db DBTX) *Queries {
 func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	q := Queries{db: db}
 	var err error
+	if q.acquireLeaseStmt, err = db.PrepareContext(ctx, acquireLease); err != nil {
+		return nil, fmt.Errorf("error preparing query AcquireLease: %w", err)
+	}
 	if q.cleanUpActiveJobsStmt, err = db.PrepareContext(ctx, cleanUpActiveJobs); err != nil {
 		return nil, fmt.Errorf("error preparing query CleanUpActiveJobs: %w", err)
 	}
@@ -57,9 +60,15 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.listJobsStmt, err = db.PrepareContext(ctx, listJobs); err != nil {
 		return nil, fmt.Errorf("error preparing query ListJobs: %w", err)
 	}
+	if q.listSIPJobsStmt, err = db.PrepareContext(ctx, listSIPJobs); err != nil {
+		return nil, fmt.Errorf("error preparing query ListSIPJobs: %w", err)
+	}
 	if q.listSIPsWithCreationTimestampsStmt, err = db.PrepareContext(ctx, listSIPsWithCreationTimestamps); err != nil {
 		return nil, fmt.Errorf("error preparing query ListSIPsWithCreationTimestamps: %w", err)
 	}
+	if q.listTransferJobsStmt, err = db.PrepareContext(ctx, listTransferJobs); err != nil {
+		return nil, fmt.Errorf("error preparing query ListTransferJobs: %w", err)
+	}
 	if q.listTransfersWithCreationTimestampsStmt, err = db.PrepareContext(ctx, listTransfersWithCreationTimestamps); err != nil {
 		return nil, fmt.Errorf("error preparing query ListTransfersWithCreationTimestamps: %w", err)
 	}
@@ -72,6 +81,9 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.readDashboardSettingsWithScopeStmt, err = db.PrepareContext(ctx, readDashboardSettingsWithScope); err != nil {
 		return nil, fmt.Errorf("error preparing query ReadDashboardSettingsWithScope: %w", err)
 	}
+	if q.readLeaseHolderStmt, err = db.PrepareContext(ctx, readLeaseHolder); err != nil {
+		return nil, fmt.Errorf("error preparing query ReadLeaseHolder: %w", err)
+	}
 	if q.readSIPStmt, err = db.PrepareContext(ctx, readSIP); err != nil {
 		return nil, fmt.Errorf("error preparing query ReadSIP: %w", err)
 	}
@@ -87,6 +99,9 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.readTransferLocationStmt, err = db.PrepareContext(ctx, readTransferLocation); err != nil {
 		return nil, fmt.Errorf("error preparing query ReadTransferLocation: %w", err)
 	}
+	if q.readTransferWithIdempotencyKeyStmt, err = db.PrepareContext(ctx, readTransferWithIdempotencyKey); err != nil {
+		return nil, fmt.Errorf("error preparing query ReadTransferWithIdempotencyKey: %w", err)
+	}
 	if q.readTransferWithLocationStmt, err = db.PrepareContext(ctx, readTransferWithLocation); err != nil {
 		return nil, fmt.Errorf("error preparing query ReadTransferWithLocation: %w", err)
 	}
@@ -96,9 +111,24 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.readUnitVarsStmt, err = db.PrepareContext(ctx, readUnitVars); err != nil {
 		return nil, fmt.Errorf("error preparing query ReadUnitVars: %w", err)
 	}
+	if q.readUserAPIKeyStmt, err = db.PrepareContext(ctx, readUserAPIKey); err != nil {
+		return nil, fmt.Errorf("error preparing query ReadUserAPIKey: %w", err)
+	}
+	if q.readUserGroupsStmt, err = db.PrepareContext(ctx, readUserGroups); err != nil {
+		return nil, fmt.Errorf("error preparing query ReadUserGroups: %w", err)
+	}
 	if q.readUserWithKeyStmt, err = db.PrepareContext(ctx, readUserWithKey); err != nil {
 		return nil, fmt.Errorf("error preparing query ReadUserWithKey: %w", err)
 	}
+	if q.readUserWithPasswordStmt, err = db.PrepareContext(ctx, readUserWithPassword); err != nil {
+		return nil, fmt.Errorf("error preparing query ReadUserWithPassword: %w", err)
+	}
+	if q.readUserWithUsernameStmt, err = db.PrepareContext(ctx, readUserWithUsername); err != nil {
+		return nil, fmt.Errorf("error preparing query ReadUserWithUsername: %w", err)
+	}
+	if q.releaseLeaseStmt, err = db.PrepareContext(ctx, releaseLease); err != nil {
+		return nil, fmt.Errorf("error preparing query ReleaseLease: %w", err)
+	}
 	if q.updateJobStatusStmt, err = db.PrepareContext(ctx, updateJobStatus); err != nil {
 		return nil, fmt.Errorf("error preparing query UpdateJobStatus: %w", err)
 	}
@@ -122,6 +152,11 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 
 func (q *Queries) Close() error {
 	var err error
+	if q.acquireLeaseStmt != nil {
+		if cerr := q.acquireLeaseStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing acquireLeaseStmt: %w", cerr)
+		}
+	}
 	if q.cleanUpActiveJobsStmt != nil {
 		if cerr := q.cleanUpActiveJobsStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing cleanUpActiveJobsStmt: %w", cerr)
@@ -177,11 +212,21 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing listJobsStmt: %w", cerr)
 		}
 	}
+	if q.listSIPJobsStmt != nil {
+		if cerr := q.listSIPJobsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listSIPJobsStmt: %w", cerr)
+		}
+	}
 	if q.listSIPsWithCreationTimestampsStmt != nil {
 		if cerr := q.listSIPsWithCreationTimestampsStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing listSIPsWithCreationTimestampsStmt: %w", cerr)
 		}
 	}
+	if q.listTransferJobsStmt != nil {
+		if cerr := q.listTransferJobsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listTransferJobsStmt: %w", cerr)
+		}
+	}
 	if q.listTransfersWithCreationTimestampsStmt != nil {
 		if cerr := q.listTransfersWithCreationTimestampsStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing listTransfersWithCreationTimestampsStmt: %w", cerr)
@@ -202,6 +247,11 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing readDashboardSettingsWithScopeStmt: %w", cerr)
 		}
 	}
+	if q.readLeaseHolderStmt != nil {
+		if cerr := q.readLeaseHolderStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing readLeaseHolderStmt: %w", cerr)
+		}
+	}
 	if q.readSIPStmt != nil {
 		if cerr := q.readSIPStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing readSIPStmt: %w", cerr)
@@ -227,6 +277,11 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing readTransferLocationStmt: %w", cerr)
 		}
 	}
+	if q.readTransferWithIdempotencyKeyStmt != nil {
+		if cerr := q.readTransferWithIdempotencyKeyStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing readTransferWithIdempotencyKeyStmt: %w", cerr)
+		}
+	}
 	if q.readTransferWithLocationStmt != nil {
 		if cerr := q.readTransferWithLocationStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing readTransferWithLocationStmt: %w", cerr)
@@ -242,11 +297,36 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing readUnitVarsStmt: %w", cerr)
 		}
 	}
+	if q.readUserAPIKeyStmt != nil {
+		if cerr := q.readUserAPIKeyStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing readUserAPIKeyStmt: %w", cerr)
+		}
+	}
+	if q.readUserGroupsStmt != nil {
+		if cerr := q.readUserGroupsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing readUserGroupsStmt: %w", cerr)
+		}
+	}
 	if q.readUserWithKeyStmt != nil {
 		if cerr := q.readUserWithKeyStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing readUserWithKeyStmt: %w", cerr)
 		}
 	}
+	if q.readUserWithPasswordStmt != nil {
+		if cerr := q.readUserWithPasswordStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing readUserWithPasswordStmt: %w", cerr)
+		}
+	}
+	if q.readUserWithUsernameStmt != nil {
+		if cerr := q.readUserWithUsernameStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing readUserWithUsernameStmt: %w", cerr)
+		}
+	}
+	if q.releaseLeaseStmt != nil {
+		if cerr := q.releaseLeaseStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing releaseLeaseStmt: %w", cerr)
+		}
+	}
 	if q.updateJobStatusStmt != nil {
 		if cerr := q.updateJobStatusStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing updateJobStatusStmt: %w", cerr)
@@ -316,6 +396,7 @@ func (q *Queries) queryRow(ctx context.Context, stmt *sql.Stmt, query string, ar
 type Queries struct {
 	db                                      DBTX
 	tx                                      *sql.Tx
+	acquireLeaseStmt                        *sql.Stmt
 	cleanUpActiveJobsStmt                   *sql.Stmt
 	cleanUpActiveSIPsStmt                   *sql.Stmt
 	cleanUpActiveTasksStmt                  *sql.Stmt
@@ -327,20 +408,29 @@ type Queries struct {
 	createTransferStmt                      *sql.Stmt
 	createUnitVarStmt                       *sql.Stmt
 	listJobsStmt                            *sql.Stmt
+	listSIPJobsStmt                         *sql.Stmt
 	listSIPsWithCreationTimestampsStmt      *sql.Stmt
+	listTransferJobsStmt                    *sql.Stmt
 	listTransfersWithCreationTimestampsStmt *sql.Stmt
 	readDashboardSettingStmt                *sql.Stmt
 	readDashboardSettingsWithNameLikeStmt   *sql.Stmt
 	readDashboardSettingsWithScopeStmt      *sql.Stmt
+	readLeaseHolderStmt                     *sql.Stmt
 	readSIPStmt                             *sql.Stmt
 	readSIPLocationStmt                     *sql.Stmt
 	readSIPWithLocationStmt                 *sql.Stmt
 	readTransferStmt                        *sql.Stmt
 	readTransferLocationStmt                *sql.Stmt
+	readTransferWithIdempotencyKeyStmt      *sql.Stmt
 	readTransferWithLocationStmt            *sql.Stmt
 	readUnitVarStmt                         *sql.Stmt
 	readUnitVarsStmt                        *sql.Stmt
+	readUserAPIKeyStmt                      *sql.Stmt
+	readUserGroupsStmt                      *sql.Stmt
 	readUserWithKeyStmt                     *sql.Stmt
+	readUserWithPasswordStmt                *sql.Stmt
+	readUserWithUsernameStmt                *sql.Stmt
+	releaseLeaseStmt                        *sql.Stmt
 	updateJobStatusStmt                     *sql.Stmt
 	updateSIPLocationStmt                   *sql.Stmt
 	updateSIPStatusStmt                     *sql.Stmt
@@ -353,6 +443,7 @@ func (q *Queries) WithTx(tx *sql.Tx) *Queries {
 	return &Queries{
 		db:                                      tx,
 		tx:                                      tx,
+		acquireLeaseStmt:                        q.acquireLeaseStmt,
 		cleanUpActiveJobsStmt:                   q.cleanUpActiveJobsStmt,
 		cleanUpActiveSIPsStmt:                   q.cleanUpActiveSIPsStmt,
 		cleanUpActiveTasksStmt:                  q.cleanUpActiveTasksStmt,
@@ -364,20 +455,29 @@ func (q *Queries) WithTx(tx *sql.Tx) *Queries {
 		createTransferStmt:                      q.createTransferStmt,
 		createUnitVarStmt:                       q.createUnitVarStmt,
 		listJobsStmt:                            q.listJobsStmt,
+		listSIPJobsStmt:                         q.listSIPJobsStmt,
 		listSIPsWithCreationTimestampsStmt:      q.listSIPsWithCreationTimestampsStmt,
+		listTransferJobsStmt:                    q.listTransferJobsStmt,
 		listTransfersWithCreationTimestampsStmt: q.listTransfersWithCreationTimestampsStmt,
 		readDashboardSettingStmt:                q.readDashboardSettingStmt,
 		readDashboardSettingsWithNameLikeStmt:   q.readDashboardSettingsWithNameLikeStmt,
 		readDashboardSettingsWithScopeStmt:      q.readDashboardSettingsWithScopeStmt,
+		readLeaseHolderStmt:                     q.readLeaseHolderStmt,
 		readSIPStmt:                             q.readSIPStmt,
 		readSIPLocationStmt:                     q.readSIPLocationStmt,
 		readSIPWithLocationStmt:                 q.readSIPWithLocationStmt,
 		readTransferStmt:                        q.readTransferStmt,
 		readTransferLocationStmt:                q.readTransferLocationStmt,
+		readTransferWithIdempotencyKeyStmt:      q.readTransferWithIdempotencyKeyStmt,
 		readTransferWithLocationStmt:            q.readTransferWithLocationStmt,
 		readUnitVarStmt:                         q.readUnitVarStmt,
 		readUnitVarsStmt:                        q.readUnitVarsStmt,
+		readUserAPIKeyStmt:                      q.readUserAPIKeyStmt,
+		readUserGroupsStmt:                      q.readUserGroupsStmt,
 		readUserWithKeyStmt:                     q.readUserWithKeyStmt,
+		readUserWithPasswordStmt:                q.readUserWithPasswordStmt,
+		readUserWithUsernameStmt:                q.readUserWithUsernameStmt,
+		releaseLeaseStmt:                        q.releaseLeaseStmt,
 		updateJobStatusStmt:                     q.updateJobStatusStmt,
 		updateSIPLocationStmt:                   q.updateSIPLocationStmt,
 		updateSIPStatusStmt:                     q.updateSIPStatusStmt,
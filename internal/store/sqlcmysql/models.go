@@ -38,6 +38,19 @@ type Archivesspacedipobjectresourcepairing struct {
 	Resourceid string
 }
 
+type Auditevent struct {
+	ID            int64
+	CreatedAt     time.Time
+	Userid        sql.NullInt32
+	Username      string
+	Procedurename string
+	Summary       string
+	Outcome       string
+	Message       string
+	Packageuuid   uuid.UUID
+	Decisionuuid  uuid.UUID
+}
+
 type AuthGroup struct {
 	ID   int32
 	Name string
@@ -333,6 +346,12 @@ type Job struct {
 	LinkID            uuid.NullUUID
 }
 
+type Lease struct {
+	Name        string
+	Holder      string
+	Expirestime time.Time
+}
+
 type MainArchivesspacedigitalobject struct {
 	ID         int32
 	Resourceid string
@@ -389,6 +408,19 @@ type Metadataappliestotype struct {
 	Lastmodified time.Time
 }
 
+type Packagequeue struct {
+	ID             int64
+	Packageuuid    uuid.UUID
+	Packagetype    string
+	Path           string
+	Startchainuuid uuid.UUID
+	Startlinkuuid  uuid.UUID
+	Priority       int32
+	Size           uint64
+	Tag            float64
+	Queuedtime     time.Time
+}
+
 type Report struct {
 	ID             int32
 	Unittype       string
@@ -13,6 +13,26 @@ import (
 	uuid "github.com/google/uuid"
 )
 
+const acquireLease = `-- name: AcquireLease :exec
+
+INSERT INTO Leases (name, holder, expiresTime) VALUES (?, ?, NOW(6) + INTERVAL ? MICROSECOND)
+ON DUPLICATE KEY UPDATE
+  holder = IF(holder = VALUES(holder) OR expiresTime < NOW(6), VALUES(holder), holder),
+  expiresTime = IF(holder = VALUES(holder), VALUES(expiresTime), expiresTime)
+`
+
+type AcquireLeaseParams struct {
+	Name   string
+	Holder string
+	Ttl    interface{}
+}
+
+// Leases
+func (q *Queries) AcquireLease(ctx context.Context, arg *AcquireLeaseParams) error {
+	_, err := q.exec(ctx, q.acquireLeaseStmt, acquireLease, arg.Name, arg.Holder, arg.Ttl)
+	return err
+}
+
 const cleanUpActiveJobs = `-- name: CleanUpActiveJobs :exec
 UPDATE Jobs SET currentStep = 4 WHERE currentStep = 3
 `
@@ -23,7 +43,7 @@ func (q *Queries) CleanUpActiveJobs(ctx context.Context) error {
 }
 
 const cleanUpActiveSIPs = `-- name: CleanUpActiveSIPs :exec
-UPDATE SIPs SET status = 4, completed_at = UTC_TIMESTAMP() WHERE status IN (0, 1)
+UPDATE SIPs SET status = 4, completed_at = UTC_TIMESTAMP() WHERE status IN (0, 1) AND sipUUID NOT IN (SELECT packageUUID FROM PackageQueue)
 `
 
 func (q *Queries) CleanUpActiveSIPs(ctx context.Context) error {
@@ -41,7 +61,7 @@ func (q *Queries) CleanUpActiveTasks(ctx context.Context) error {
 }
 
 const cleanUpActiveTransfers = `-- name: CleanUpActiveTransfers :exec
-UPDATE Transfers SET status = 4, completed_at = UTC_TIMESTAMP() WHERE status IN (0, 1)
+UPDATE Transfers SET status = 4, completed_at = UTC_TIMESTAMP() WHERE status IN (0, 1) AND transferUUID NOT IN (SELECT packageUUID FROM PackageQueue)
 `
 
 func (q *Queries) CleanUpActiveTransfers(ctx context.Context) error {
@@ -224,6 +244,46 @@ func (q *Queries) ListJobs(ctx context.Context, sipuuid uuid.UUID) ([]*Job, erro
 	return items, nil
 }
 
+const listSIPJobs = `-- name: ListSIPJobs :many
+SELECT jobuuid, jobtype, createdtime, createdtimedec, directory, sipuuid, unittype, currentstep, microservicegroup, hidden, subjobof, microservicechainlinkspk FROM Jobs WHERE SIPUUID IN (SELECT sipUUID FROM SIPs WHERE hidden = 0) ORDER BY createdTime DESC
+`
+
+func (q *Queries) ListSIPJobs(ctx context.Context) ([]*Job, error) {
+	rows, err := q.query(ctx, q.listSIPJobsStmt, listSIPJobs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*Job{}
+	for rows.Next() {
+		var i Job
+		if err := rows.Scan(
+			&i.ID,
+			&i.Type,
+			&i.CreatedAt,
+			&i.Createdtimedec,
+			&i.Directory,
+			&i.SIPID,
+			&i.Unittype,
+			&i.Currentstep,
+			&i.Microservicegroup,
+			&i.Hidden,
+			&i.Subjobof,
+			&i.LinkID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listSIPsWithCreationTimestamps = `-- name: ListSIPsWithCreationTimestamps :many
 SELECT
     j.SIPUUID,
@@ -278,6 +338,46 @@ func (q *Queries) ListSIPsWithCreationTimestamps(ctx context.Context) ([]*ListSI
 	return items, nil
 }
 
+const listTransferJobs = `-- name: ListTransferJobs :many
+SELECT jobuuid, jobtype, createdtime, createdtimedec, directory, sipuuid, unittype, currentstep, microservicegroup, hidden, subjobof, microservicechainlinkspk FROM Jobs WHERE SIPUUID IN (SELECT transferUUID FROM Transfers WHERE hidden = 0) ORDER BY createdTime DESC
+`
+
+func (q *Queries) ListTransferJobs(ctx context.Context) ([]*Job, error) {
+	rows, err := q.query(ctx, q.listTransferJobsStmt, listTransferJobs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*Job{}
+	for rows.Next() {
+		var i Job
+		if err := rows.Scan(
+			&i.ID,
+			&i.Type,
+			&i.CreatedAt,
+			&i.Createdtimedec,
+			&i.Directory,
+			&i.SIPID,
+			&i.Unittype,
+			&i.Currentstep,
+			&i.Microservicegroup,
+			&i.Hidden,
+			&i.Subjobof,
+			&i.LinkID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listTransfersWithCreationTimestamps = `-- name: ListTransfersWithCreationTimestamps :many
 SELECT
     j.SIPUUID,
@@ -417,6 +517,17 @@ func (q *Queries) ReadDashboardSettingsWithScope(ctx context.Context, scope stri
 	return items, nil
 }
 
+const readLeaseHolder = `-- name: ReadLeaseHolder :one
+SELECT holder FROM Leases WHERE name = ? AND expiresTime > NOW(6)
+`
+
+func (q *Queries) ReadLeaseHolder(ctx context.Context, name string) (string, error) {
+	row := q.queryRow(ctx, q.readLeaseHolderStmt, readLeaseHolder, name)
+	var holder string
+	err := row.Scan(&holder)
+	return holder, err
+}
+
 const readSIP = `-- name: ReadSIP :one
 SELECT sipUUID, createdTime, currentPath, hidden, aipFilename, sipType, dirUUIDs, status, completed_at FROM SIPs WHERE sipUUID = ?
 `
@@ -536,6 +647,26 @@ func (q *Queries) ReadTransferLocation(ctx context.Context, transferuuid uuid.UU
 	return &i, err
 }
 
+const readTransferWithIdempotencyKey = `-- name: ReadTransferWithIdempotencyKey :one
+SELECT k.unitUUID, d.variableValue
+FROM UnitVariables k
+LEFT JOIN UnitVariables d ON d.unitType = k.unitType AND d.unitUUID = k.unitUUID AND d.variable = 'idempotencyDigest'
+WHERE k.unitType = 'Transfer' AND k.variable = 'idempotencyKey' AND k.variableValue = ?
+LIMIT 1
+`
+
+type ReadTransferWithIdempotencyKeyRow struct {
+	Unituuid      uuid.UUID
+	Variablevalue sql.NullString
+}
+
+func (q *Queries) ReadTransferWithIdempotencyKey(ctx context.Context, idempotencyKey sql.NullString) (*ReadTransferWithIdempotencyKeyRow, error) {
+	row := q.queryRow(ctx, q.readTransferWithIdempotencyKeyStmt, readTransferWithIdempotencyKey, idempotencyKey)
+	var i ReadTransferWithIdempotencyKeyRow
+	err := row.Scan(&i.Unituuid, &i.Variablevalue)
+	return &i, err
+}
+
 const readTransferWithLocation = `-- name: ReadTransferWithLocation :one
 SELECT transferUUID FROM Transfers WHERE currentLocation = ?
 `
@@ -617,6 +748,51 @@ func (q *Queries) ReadUnitVars(ctx context.Context, arg *ReadUnitVarsParams) ([]
 	return items, nil
 }
 
+const readUserAPIKey = `-- name: ReadUserAPIKey :one
+SELECT tastypie_apikey.key
+FROM tastypie_apikey
+WHERE tastypie_apikey.user_id = ?
+LIMIT 1
+`
+
+func (q *Queries) ReadUserAPIKey(ctx context.Context, userID int32) (string, error) {
+	row := q.queryRow(ctx, q.readUserAPIKeyStmt, readUserAPIKey, userID)
+	var key string
+	err := row.Scan(&key)
+	return key, err
+}
+
+const readUserGroups = `-- name: ReadUserGroups :many
+SELECT auth_group.name
+FROM auth_group
+JOIN auth_user_groups ON auth_group.id = auth_user_groups.group_id
+WHERE auth_user_groups.user_id = ?
+ORDER BY auth_group.name
+`
+
+func (q *Queries) ReadUserGroups(ctx context.Context, userID int32) ([]string, error) {
+	rows, err := q.query(ctx, q.readUserGroupsStmt, readUserGroups, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []string{}
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		items = append(items, name)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const readUserWithKey = `-- name: ReadUserWithKey :one
 
 SELECT auth_user.id, auth_user.username, auth_user.email, auth_user.is_active, main_userprofile.agent_id
@@ -654,6 +830,80 @@ func (q *Queries) ReadUserWithKey(ctx context.Context, arg *ReadUserWithKeyParam
 	return &i, err
 }
 
+const readUserWithPassword = `-- name: ReadUserWithPassword :one
+SELECT auth_user.id, auth_user.username, auth_user.email, auth_user.is_active, auth_user.password, main_userprofile.agent_id
+FROM auth_user
+LEFT JOIN main_userprofile ON auth_user.id = main_userprofile.user_id
+WHERE auth_user.username = ? AND auth_user.is_active = 1
+LIMIT 1
+`
+
+type ReadUserWithPasswordRow struct {
+	ID       int32
+	Username string
+	Email    string
+	IsActive bool
+	Password string
+	AgentID  sql.NullInt32
+}
+
+func (q *Queries) ReadUserWithPassword(ctx context.Context, username string) (*ReadUserWithPasswordRow, error) {
+	row := q.queryRow(ctx, q.readUserWithPasswordStmt, readUserWithPassword, username)
+	var i ReadUserWithPasswordRow
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.Email,
+		&i.IsActive,
+		&i.Password,
+		&i.AgentID,
+	)
+	return &i, err
+}
+
+const readUserWithUsername = `-- name: ReadUserWithUsername :one
+SELECT auth_user.id, auth_user.username, auth_user.email, auth_user.is_active, main_userprofile.agent_id
+FROM auth_user
+LEFT JOIN main_userprofile ON auth_user.id = main_userprofile.user_id
+WHERE auth_user.username = ?
+LIMIT 1
+`
+
+type ReadUserWithUsernameRow struct {
+	ID       int32
+	Username string
+	Email    string
+	IsActive bool
+	AgentID  sql.NullInt32
+}
+
+func (q *Queries) ReadUserWithUsername(ctx context.Context, username string) (*ReadUserWithUsernameRow, error) {
+	row := q.queryRow(ctx, q.readUserWithUsernameStmt, readUserWithUsername, username)
+	var i ReadUserWithUsernameRow
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.Email,
+		&i.IsActive,
+		&i.AgentID,
+	)
+	return &i, err
+}
+
+const releaseLease = `-- name: ReleaseLease :exec
+DELETE FROM Leases WHERE name = ? AND holder = ?
+`
+
+type ReleaseLeaseParams struct {
+	Name   string
+	Holder string
+}
+
+func (q *Queries) ReleaseLease(ctx context.Context, arg *ReleaseLeaseParams) error {
+	_, err := q.exec(ctx, q.releaseLeaseStmt, releaseLease, arg.Name, arg.Holder)
+	return err
+}
+
 const updateJobStatus = `-- name: UpdateJobStatus :exec
 UPDATE Jobs SET currentStep = ? WHERE jobUUID = ?
 `
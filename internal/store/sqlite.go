@@ -0,0 +1,1328 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"iter"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/google/uuid"
+	"go.artefactual.dev/tools/ref"
+	_ "modernc.org/sqlite"
+
+	adminv1 "github.com/artefactual-labs/ccp/internal/api/gen/archivematica/ccp/admin/v1beta1"
+	"github.com/artefactual-labs/ccp/internal/store/enums"
+	"github.com/artefactual-labs/ccp/internal/store/sqlcmysql"
+	sqlc "github.com/artefactual-labs/ccp/internal/store/sqlcsqlite"
+)
+
+// sqliteTimeFormat is the format used by the driver to write times, see the
+// _time_format parameter. Times are always written in UTC so the text values
+// sort in chronological order.
+const sqliteTimeFormat = "2006-01-02 15:04:05.999999999-07:00"
+
+// connectToSQLite opens the database file twice: a writer pool limited to a
+// single connection, so writes are serialized in the process instead of
+// failing with SQLITE_BUSY, and a reader pool that runs concurrently with the
+// writer thanks to the write-ahead log. The dsn is the path of the database
+// file, optionally as a "file:" URI with its own parameters.
+func connectToSQLite(logger logr.Logger, dsn string) (writer, reader *sql.DB, err error) {
+	if !strings.HasPrefix(dsn, "file:") {
+		dsn = "file:" + dsn
+	}
+	sep := "?"
+	if strings.Contains(dsn, "?") {
+		sep = "&"
+	}
+	params := []string{
+		"_pragma=busy_timeout(5000)",
+		"_pragma=foreign_keys(1)",
+		"_time_format=sqlite",
+	}
+
+	writer, err = sql.Open("sqlite", dsn+sep+strings.Join(append(params,
+		"_pragma=journal_mode(WAL)",
+		"_pragma=synchronous(NORMAL)",
+		"_txlock=immediate",
+	), "&"))
+	if err != nil {
+		return nil, nil, fmt.Errorf("error opening database: %v", err)
+	}
+	writer.SetMaxOpenConns(1)
+	writer.SetMaxIdleConns(1)
+	writer.SetConnMaxLifetime(0)
+
+	var version, mode string
+	err = writer.QueryRow("SELECT sqlite_version()").Scan(&version)
+	if err == nil {
+		err = writer.QueryRow("PRAGMA journal_mode").Scan(&mode)
+	}
+	if err != nil {
+		_ = writer.Close()
+		return nil, nil, err
+	}
+
+	reader, err = sql.Open("sqlite", dsn+sep+strings.Join(append(params,
+		"_pragma=query_only(1)",
+	), "&"))
+	if err != nil {
+		_ = writer.Close()
+		return nil, nil, fmt.Errorf("error opening database: %v", err)
+	}
+	reader.SetMaxOpenConns(max(4, runtime.NumCPU()))
+	reader.SetMaxIdleConns(max(4, runtime.NumCPU()))
+	reader.SetConnMaxLifetime(0)
+
+	logger.V(2).Info("Connected to SQLite.", "version", version, "journal_mode", mode)
+
+	return writer, reader, nil
+}
+
+// sqliteStoreImpl implements the Store interface on SQLite. All the queries
+// are built using sqlc, see sqlc/sqlite/query.sql. Writes and transactions go
+// through the writer pool, other reads through the reader pool.
+type sqliteStoreImpl struct {
+	logger  logr.Logger
+	writer  *sql.DB
+	reader  *sql.DB
+	queries *sqlc.Queries // Prepared on the writer pool.
+	reads   *sqlc.Queries // Prepared on the reader pool.
+}
+
+var _ Store = (*sqliteStoreImpl)(nil)
+
+func newSQLiteStore(logger logr.Logger, writer, reader *sql.DB) (*sqliteStoreImpl, error) {
+	queries, err := sqlc.Prepare(context.Background(), writer)
+	if err != nil {
+		return nil, err
+	}
+
+	reads, err := sqlc.Prepare(context.Background(), reader)
+	if err != nil {
+		return nil, errors.Join(err, queries.Close())
+	}
+
+	return &sqliteStoreImpl{
+		logger:  logger,
+		writer:  writer,
+		reader:  reader,
+		queries: queries,
+		reads:   reads,
+	}, nil
+}
+
+// sqliteTx implements the Tx interface, using the writer pool or a
+// transaction started by RunInTx.
+type sqliteTx struct {
+	queries *sqlc.Queries
+}
+
+var _ Tx = (*sqliteTx)(nil)
+
+func (s *sqliteStoreImpl) tx() *sqliteTx {
+	return &sqliteTx{queries: s.queries}
+}
+
+func (s *sqliteStoreImpl) RunInTx(ctx context.Context, fn func(tx Tx) error) error {
+	return runInTx(ctx, s.logger, s.writer, func(tx *sql.Tx) error {
+		return fn(&sqliteTx{queries: s.queries.WithTx(tx)})
+	})
+}
+
+func (s *sqliteStoreImpl) RemoveTransientData(ctx context.Context) (err error) {
+	defer wrap(&err, "RemoveTransientData")
+
+	tx, err := s.writer.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	q := s.queries.WithTx(tx)
+	now := sql.NullTime{Time: time.Now().UTC(), Valid: true}
+
+	if err := q.CleanUpActiveTasks(ctx); err != nil {
+		return err
+	}
+
+	if err := q.CleanUpActiveTransfers(ctx, now); err != nil {
+		return err
+	}
+
+	if err := q.CleanUpTasksWithAwaitingJobs(ctx); err != nil {
+		return err
+	}
+
+	if err := q.CleanUpAwaitingJobs(ctx); err != nil {
+		return err
+	}
+
+	if err := q.CleanUpActiveSIPs(ctx, now); err != nil {
+		return err
+	}
+
+	if err := q.CleanUpActiveJobs(ctx); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (s *sqliteStoreImpl) CreateJob(ctx context.Context, params *sqlcmysql.CreateJobParams) (err error) {
+	defer wrap(&err, "CreateJob")
+
+	return s.queries.CreateJob(ctx, &sqlc.CreateJobParams{
+		ID:                params.ID,
+		Type:              params.Type,
+		CreatedAt:         params.CreatedAt.UTC(),
+		Createdtimedec:    params.Createdtimedec,
+		Directory:         params.Directory,
+		SIPID:             params.SIPID,
+		Unittype:          params.Unittype,
+		Currentstep:       int64(params.Currentstep),
+		Microservicegroup: params.Microservicegroup,
+		Hidden:            params.Hidden,
+		LinkID:            params.LinkID,
+		Subjobof:          params.Subjobof,
+	})
+}
+
+func (s *sqliteStoreImpl) UpdateJobStatus(ctx context.Context, id uuid.UUID, status string) error {
+	return s.tx().UpdateJobStatus(ctx, id, status)
+}
+
+func (t *sqliteTx) UpdateJobStatus(ctx context.Context, id uuid.UUID, status string) (err error) {
+	defer wrap(&err, "UpdateJobStatus(%s, %s)", id, status)
+
+	step, err := jobStep(status)
+	if err != nil {
+		return err
+	}
+
+	return t.queries.UpdateJobStatus(ctx, &sqlc.UpdateJobStatusParams{
+		ID:          id,
+		Currentstep: int64(step),
+	})
+}
+
+func (s *sqliteStoreImpl) FindAwaitingJob(ctx context.Context, params *FindAwaitingJobParams) (_ *adminv1.Job, err error) {
+	defer wrap(&err, "FindAwaitingJob(ctx, params)")
+
+	p := &sqlc.FindAwaitingJobParams{}
+	if params.Directory != nil { // ApproveTransferByPath
+		p.Directory = sql.NullString{String: *params.Directory, Valid: true}
+	} else if params.PackageID != nil { // ApprovePartialReingest
+		p.PackageID = sql.NullString{String: params.PackageID.String(), Valid: true}
+		p.MicroserviceGroup = ref.DerefZero(params.Group)
+	}
+
+	row, err := s.reads.FindAwaitingJob(ctx, p)
+	if err == sql.ErrNoRows {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &adminv1.Job{
+		Id:        row.ID.String(),
+		PackageId: row.SIPID.String(),
+	}
+
+	return ret, nil
+}
+
+func (s *sqliteStoreImpl) ListJobs(ctx context.Context, pkgID uuid.UUID) (_ []*adminv1.Job, err error) {
+	defer wrap(&err, "ListJobs(%s)", pkgID)
+
+	jobs, err := s.reads.ListJobs(ctx, pkgID)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make([]*adminv1.Job, 0, len(jobs))
+	for _, j := range jobs {
+		job, err := sqliteJob(j)
+		if err != nil {
+			return nil, fmt.Errorf("convert: %v", err)
+		}
+		ret = append(ret, job)
+	}
+
+	return ret, nil
+}
+
+func (s *sqliteStoreImpl) ListPackageJobs(ctx context.Context, packageType adminv1.PackageType) (_ map[uuid.UUID][]*adminv1.Job, err error) {
+	defer wrap(&err, "ListPackageJobs(%s)", packageType)
+
+	var jobs []*sqlc.Job
+	switch packageType {
+	case adminv1.PackageType_PACKAGE_TYPE_TRANSFER:
+		jobs, err = s.reads.ListTransferJobs(ctx)
+	case adminv1.PackageType_PACKAGE_TYPE_SIP:
+		jobs, err = s.reads.ListSIPJobs(ctx)
+	default:
+		return nil, fmt.Errorf("unsupported package type: %s", packageType)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	ret := map[uuid.UUID][]*adminv1.Job{}
+	for _, j := range jobs {
+		job, err := sqliteJob(j)
+		if err != nil {
+			return nil, fmt.Errorf("convert: %v", err)
+		}
+		ret[j.SIPID] = append(ret[j.SIPID], job)
+	}
+
+	return ret, nil
+}
+
+// sqliteJob converts a job returned by the queries.
+func sqliteJob(j *sqlc.Job) (*adminv1.Job, error) {
+	job := &adminv1.Job{
+		Id:              j.ID.String(),
+		PackageId:       j.SIPID.String(),
+		PackageType:     jobPackageType(j.Unittype),
+		Directory:       j.Directory,
+		LinkId:          j.LinkID.UUID.String(),
+		LinkDescription: j.Type,
+		Hidden:          j.Hidden,
+		Group:           j.Microservicegroup,
+		Status:          adminv1.JobStatus(j.Currentstep),
+	}
+	if err := updateTimeWithFraction(&job.CreatedAt, j.CreatedAt, j.Createdtimedec); err != nil {
+		return nil, err
+	}
+
+	return job, nil
+}
+
+// CreateTasks inserts the tasks one by one in a single transaction, which in
+// SQLite is about as fast as a multi-row insert since there is no round trip.
+func (s *sqliteStoreImpl) CreateTasks(ctx context.Context, tasks []*Task) (err error) {
+	defer wrap(&err, "CreateTasks(tasks)")
+
+	if len(tasks) == 0 {
+		return nil
+	}
+
+	return runInTx(ctx, s.logger, s.writer, func(tx *sql.Tx) error {
+		q := s.queries.WithTx(tx)
+		for _, t := range tasks {
+			params := &sqlc.CreateTaskParams{
+				Taskuuid:  t.ID,
+				CreatedAt: t.CreatedAt.UTC(),
+				Fileuuid:  t.FileID,
+				Filename:  t.Filename,
+				Exec:      t.Exec,
+				Arguments: t.Arguments,
+				Client:    t.Client,
+				Stdout:    t.Stdout,
+				Stderror:  t.Stderr,
+				ID:        t.JobID,
+			}
+			if t.StartedAt.Valid {
+				params.Starttime = sql.NullTime{Time: t.StartedAt.Time.UTC(), Valid: true}
+			}
+			if t.EndedAt.Valid {
+				params.Endtime = sql.NullTime{Time: t.EndedAt.Time.UTC(), Valid: true}
+			}
+			if t.ExitCode.Valid {
+				params.Exitcode = sql.NullInt64{Int64: int64(t.ExitCode.Int16), Valid: true}
+			}
+			if err := q.CreateTask(ctx, params); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// UpdateTasks updates the tasks one by one in a single transaction, see
+// CreateTasks.
+func (s *sqliteStoreImpl) UpdateTasks(ctx context.Context, results []*TaskResult) (err error) {
+	defer wrap(&err, "UpdateTasks(results)")
+
+	if len(results) == 0 {
+		return nil
+	}
+
+	return runInTx(ctx, s.logger, s.writer, func(tx *sql.Tx) error {
+		q := s.queries.WithTx(tx)
+		for _, r := range results {
+			err := q.UpdateTask(ctx, &sqlc.UpdateTaskParams{
+				Endtime:  sql.NullTime{Time: r.EndedAt.UTC(), Valid: true},
+				Exitcode: sql.NullInt64{Int64: int64(r.ExitCode), Valid: true},
+				Stdout:   r.Stdout,
+				Stderror: r.Stderr,
+				Taskuuid: r.ID,
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (s *sqliteStoreImpl) ReadPackagesWithCreationTimestamps(ctx context.Context, packageType adminv1.PackageType) (ret []*adminv1.Package, err error) {
+	defer wrap(&err, "ReadPackagesWithCreationTimestamps(%s)", packageType)
+
+	type row struct {
+		id        uuid.UUID
+		createdAt time.Time
+		dec       string
+		status    sql.NullInt64
+	}
+
+	var rows []row
+	switch packageType {
+	case adminv1.PackageType_PACKAGE_TYPE_TRANSFER:
+		items, err := s.reads.ListTransfersWithCreationTimestamps(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range items {
+			rows = append(rows, row{item.SIPID, item.CreatedAt, item.CreatedAtDec, item.Status})
+		}
+	case adminv1.PackageType_PACKAGE_TYPE_SIP:
+		items, err := s.reads.ListSIPsWithCreationTimestamps(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range items {
+			rows = append(rows, row{item.SIPID, item.CreatedAt, item.CreatedAtDec, item.Status})
+		}
+	default:
+		return nil, fmt.Errorf("unsupported package type: %s", packageType)
+	}
+
+	ret = make([]*adminv1.Package, 0, len(rows))
+	for _, r := range rows {
+		pkg := &adminv1.Package{
+			Id:     r.id.String(),
+			Status: adminv1.PackageStatus(int32(r.status.Int64)),
+		}
+		if err := updateTimeWithFraction(&pkg.CreatedAt, r.createdAt, r.dec); err != nil {
+			return nil, err
+		}
+		ret = append(ret, pkg)
+	}
+
+	return ret, nil
+}
+
+func (s *sqliteStoreImpl) UpdatePackageStatus(ctx context.Context, id uuid.UUID, packageType enums.PackageType, status enums.PackageStatus) error {
+	return s.tx().UpdatePackageStatus(ctx, id, packageType, status)
+}
+
+func (t *sqliteTx) UpdatePackageStatus(ctx context.Context, id uuid.UUID, packageType enums.PackageType, status enums.PackageStatus) (err error) {
+	defer wrap(&err, "UpdatePackageStatus(%s, %s, %s)", id, packageType, status)
+
+	if !packageType.IsValid() {
+		return fmt.Errorf("invalid type: %q", packageType)
+	}
+	if !status.IsValid() {
+		return fmt.Errorf("invalid status: %d", status)
+	}
+
+	var completedAt sql.NullTime
+	if status == enums.PackageStatusCompletedSuccessfully {
+		completedAt = sql.NullTime{Time: time.Now().UTC(), Valid: true}
+	}
+
+	switch packageType {
+	case enums.PackageTypeTransfer:
+		return t.queries.UpdateTransferStatus(ctx, &sqlc.UpdateTransferStatusParams{
+			Status:       int64(status),
+			CompletedAt:  completedAt,
+			Transferuuid: id,
+		})
+	case enums.PackageTypeDIP, enums.PackageTypeSIP:
+		return t.queries.UpdateSIPStatus(ctx, &sqlc.UpdateSIPStatusParams{
+			Status:      int64(status),
+			CompletedAt: completedAt,
+			SIPID:       id,
+		})
+	default:
+		return fmt.Errorf("unknown unit type: %q", packageType)
+	}
+}
+
+func (s *sqliteStoreImpl) ReadTransferLocation(ctx context.Context, id uuid.UUID) (loc string, err error) {
+	defer wrap(&err, "ReadTransferLocation(%s)", id)
+
+	ret, err := s.reads.ReadTransferLocation(ctx, id)
+	if err == sql.ErrNoRows {
+		return "", ErrNotFound
+	}
+	if err != nil {
+		return "", err
+	}
+
+	return ret.Currentlocation, nil
+}
+
+func (s *sqliteStoreImpl) ReadTransferWithIdempotencyKey(ctx context.Context, key string) (_ uuid.UUID, _ string, err error) {
+	defer wrap(&err, "ReadTransferWithIdempotencyKey(%s)", key)
+
+	ret, err := s.reads.ReadTransferWithIdempotencyKey(ctx, sql.NullString{
+		String: key,
+		Valid:  true,
+	})
+	if err == sql.ErrNoRows {
+		return uuid.Nil, "", ErrNotFound
+	}
+	if err != nil {
+		return uuid.Nil, "", err
+	}
+
+	return ret.Unituuid.UUID, ret.Variablevalue.String, nil
+}
+
+func (s *sqliteStoreImpl) CreateTransfer(ctx context.Context, id uuid.UUID, accessionID, accessSystemID string, metadataSetID uuid.UUID) (err error) {
+	defer wrap(&err, "CreateTransfer(%s, %s, %s, %s)", id, accessionID, accessSystemID, metadataSetID)
+
+	params := &sqlc.CreateTransferParams{
+		Transferuuid:   id,
+		Accessionid:    accessionID,
+		AccessSystemID: accessSystemID,
+	}
+	if metadataSetID != uuid.Nil {
+		params.Transfermetadatasetrowuuid = uuid.NullUUID{
+			UUID:  metadataSetID,
+			Valid: true,
+		}
+	}
+
+	return s.queries.CreateTransfer(ctx, params)
+}
+
+func (s *sqliteStoreImpl) ReadTransfer(ctx context.Context, id uuid.UUID) (_ Transfer, err error) {
+	defer wrap(&err, "ReadTransfer(%s)", id)
+
+	transfer := Transfer{}
+
+	row, err := s.reads.ReadTransfer(ctx, id)
+	if err == sql.ErrNoRows {
+		return transfer, ErrNotFound
+	}
+	if err != nil {
+		return transfer, err
+	}
+
+	transfer.ID = row.Transferuuid
+	transfer.Name = row.Description
+	transfer.CurrentPath = row.Currentlocation
+	transfer.Status = transferStatus(int(row.Status))
+
+	switch row.Type {
+	case "standard":
+		transfer.Type = adminv1.TransferType_TRANSFER_TYPE_STANDARD
+	}
+
+	return transfer, nil
+}
+
+func (s *sqliteStoreImpl) UpsertTransfer(ctx context.Context, id uuid.UUID, path string) (_ bool, err error) {
+	defer wrap(&err, "UpsertTransfer(%s, %s)", id, path)
+
+	tx, err := s.writer.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return false, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	q := s.queries.WithTx(tx)
+
+	r, err := q.ReadTransferLocation(ctx, id)
+	if err == sql.ErrNoRows {
+		if err := q.CreateTransfer(ctx, &sqlc.CreateTransferParams{
+			Transferuuid:    id,
+			Currentlocation: path,
+		}); err != nil {
+			return false, fmt.Errorf("create transfer: %v", err)
+		}
+		return true, tx.Commit()
+	}
+	if err != nil {
+		return false, fmt.Errorf("read transfer: %v", err)
+	}
+
+	if r.Currentlocation == path {
+		return false, nil
+	}
+	if err := q.UpdateTransferLocation(ctx, &sqlc.UpdateTransferLocationParams{
+		Transferuuid:    id,
+		Currentlocation: path,
+	}); err != nil {
+		return false, fmt.Errorf("update transfer: %v", err)
+	}
+
+	return false, tx.Commit()
+}
+
+func (s *sqliteStoreImpl) EnsureTransfer(ctx context.Context, path string) (_ uuid.UUID, _ bool, err error) {
+	defer wrap(&err, "EnsureTransfer(%s)", path)
+
+	tx, err := s.writer.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return uuid.Nil, false, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	q := s.queries.WithTx(tx)
+
+	id, err := q.ReadTransferWithLocation(ctx, path)
+	if err == sql.ErrNoRows {
+		id := uuid.New()
+		if err := q.CreateTransfer(ctx, &sqlc.CreateTransferParams{
+			Transferuuid:    id,
+			Currentlocation: path,
+		}); err != nil {
+			return uuid.Nil, false, fmt.Errorf("create transfer: %v", err)
+		}
+		return id, true, tx.Commit()
+	}
+	if err != nil {
+		return uuid.Nil, false, fmt.Errorf("read transfer: %v", err)
+	}
+
+	return id, false, nil // Transfer found!
+}
+
+func (s *sqliteStoreImpl) UpdateTransferLocation(ctx context.Context, id uuid.UUID, path string) (err error) {
+	defer wrap(&err, "UpdateTransferLocation(%s, %s)", id, path)
+
+	return s.queries.UpdateTransferLocation(ctx, &sqlc.UpdateTransferLocationParams{
+		Transferuuid:    id,
+		Currentlocation: path,
+	})
+}
+
+func (s *sqliteStoreImpl) ReadSIP(ctx context.Context, id uuid.UUID) (_ SIP, err error) {
+	defer wrap(&err, "ReadSIP(%s)", id)
+
+	row, err := s.reads.ReadSIP(ctx, id)
+	if err == sql.ErrNoRows {
+		return SIP{}, ErrNotFound
+	}
+	if err != nil {
+		return SIP{}, err
+	}
+
+	return SIP{
+		ID:          row.SIPID,
+		CreatedAt:   row.CreatedAt,
+		CurrentPath: row.Currentpath.String,
+		Hidden:      row.Hidden,
+		AIPFilename: row.Aipfilename.String,
+		Type:        row.Siptype,
+		DirIDs:      row.Diruuids,
+		Status:      int(row.Status),
+		CompletedAt: row.CompletedAt.Time,
+	}, nil
+}
+
+func (s *sqliteStoreImpl) UpsertSIP(ctx context.Context, id uuid.UUID, path string) (_ bool, err error) {
+	defer wrap(&err, "UpsertSIP(%s, %s)", id, path)
+
+	return s.upsertSIP(ctx, id, path, "SIP", true)
+}
+
+func (s *sqliteStoreImpl) EnsureSIP(ctx context.Context, path string) (_ uuid.UUID, _ bool, err error) {
+	defer wrap(&err, "EnsureSIP(%s)", path)
+
+	return s.ensureSIP(ctx, path, "SIP")
+}
+
+func (s *sqliteStoreImpl) ReadDIP(ctx context.Context, id uuid.UUID) (_ DIP, err error) {
+	defer wrap(&err, "ReadDIP(%s)", id)
+
+	row, err := s.reads.ReadSIP(ctx, id)
+	if err == sql.ErrNoRows {
+		return DIP{}, ErrNotFound
+	}
+	if err != nil {
+		return DIP{}, err
+	}
+
+	return DIP{
+		ID:          row.SIPID,
+		CreatedAt:   row.CreatedAt,
+		CurrentPath: row.Currentpath.String,
+		Hidden:      row.Hidden,
+		AIPFilename: row.Aipfilename.String,
+		DirIDs:      row.Diruuids,
+		Status:      int(row.Status),
+		CompletedAt: row.CompletedAt.Time,
+	}, nil
+}
+
+func (s *sqliteStoreImpl) UpsertDIP(ctx context.Context, id uuid.UUID, path string) (_ bool, err error) {
+	defer wrap(&err, "UpsertDIP(%s, %s)", id, path)
+
+	// Like mysqlStoreImpl, the location of an existing DIP is not updated.
+	return s.upsertSIP(ctx, id, path, "DIP", false)
+}
+
+func (s *sqliteStoreImpl) EnsureDIP(ctx context.Context, path string) (_ uuid.UUID, _ bool, err error) {
+	defer wrap(&err, "EnsureDIP(%s)", path)
+
+	return s.ensureSIP(ctx, path, "DIP")
+}
+
+// upsertSIP creates a row in the SIPs table, which holds both SIPs and DIPs.
+func (s *sqliteStoreImpl) upsertSIP(ctx context.Context, id uuid.UUID, path, sipType string, update bool) (bool, error) {
+	tx, err := s.writer.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return false, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	q := s.queries.WithTx(tx)
+
+	r, err := q.ReadSIPLocation(ctx, id)
+	if err == sql.ErrNoRows {
+		if err := q.CreateSIP(ctx, &sqlc.CreateSIPParams{
+			SIPID:       id,
+			CreatedAt:   time.Now().UTC(),
+			Currentpath: sql.NullString{String: path, Valid: true},
+			Siptype:     sipType,
+		}); err != nil {
+			return false, fmt.Errorf("create %s: %v", sipType, err)
+		}
+		return true, tx.Commit()
+	}
+	if err != nil {
+		return false, fmt.Errorf("read %s: %v", sipType, err)
+	}
+
+	if !update || r.Currentpath.String == path {
+		return false, nil
+	}
+	if err := q.UpdateSIPLocation(ctx, &sqlc.UpdateSIPLocationParams{
+		SIPID:       id,
+		Currentpath: sql.NullString{String: path, Valid: true},
+	}); err != nil {
+		return false, fmt.Errorf("update %s: %v", sipType, err)
+	}
+
+	return false, tx.Commit()
+}
+
+func (s *sqliteStoreImpl) ensureSIP(ctx context.Context, path, sipType string) (uuid.UUID, bool, error) {
+	tx, err := s.writer.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return uuid.Nil, false, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	q := s.queries.WithTx(tx)
+
+	id, err := q.ReadSIPWithLocation(ctx, sql.NullString{String: path, Valid: true})
+	if err == sql.ErrNoRows {
+		id := uuid.New()
+		if err := q.CreateSIP(ctx, &sqlc.CreateSIPParams{
+			SIPID:       id,
+			CreatedAt:   time.Now().UTC(),
+			Currentpath: sql.NullString{String: path, Valid: true},
+			Siptype:     sipType,
+		}); err != nil {
+			return uuid.Nil, false, fmt.Errorf("create %s: %v", sipType, err)
+		}
+		return id, true, tx.Commit()
+	}
+	if err != nil {
+		return uuid.Nil, false, fmt.Errorf("read %s: %v", sipType, err)
+	}
+
+	return id, false, nil
+}
+
+func (s *sqliteStoreImpl) ReadUnitVars(ctx context.Context, id uuid.UUID, packageType enums.PackageType, name string) (vars []UnitVar, err error) {
+	defer wrap(&err, "ReadUnitVars(%s, %s)", packageType, name)
+
+	ret, err := s.reads.ReadUnitVars(ctx, &sqlc.ReadUnitVarsParams{
+		UnitID: uuid.NullUUID{UUID: id, Valid: true},
+		Name:   sql.NullString{String: name, Valid: true},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, item := range ret {
+		if packageType != "" && packageType.String() != item.Unittype.String {
+			continue // Filter by package type if requested.
+		}
+		uv := UnitVar{}
+		if item.Variablevalue.Valid {
+			uv.Value = &item.Variablevalue.String
+		}
+		if item.LinkID.Valid {
+			uv.LinkID = &item.LinkID.UUID
+		}
+		vars = append(vars, uv)
+	}
+
+	return vars, nil
+}
+
+func (s *sqliteStoreImpl) ReadUnitVar(ctx context.Context, id uuid.UUID, packageType enums.PackageType, name string) (_ string, err error) {
+	defer wrap(&err, "ReadUnitVar(%s, %s, %s)", id, packageType, name)
+
+	ret, err := s.reads.ReadUnitVar(ctx, sqliteUnitVarParams(id, packageType, name))
+	if err == sql.ErrNoRows {
+		return "", ErrNotFound
+	}
+	if err != nil {
+		return "", err
+	}
+
+	return ret.Variablevalue.String, nil
+}
+
+func (s *sqliteStoreImpl) ReadUnitLinkID(ctx context.Context, id uuid.UUID, packageType enums.PackageType, name string) (_ uuid.UUID, err error) {
+	defer wrap(&err, "ReadUnitVarLinkID(%s, %s, %s)", id, packageType, name)
+
+	ret, err := s.reads.ReadUnitVar(ctx, sqliteUnitVarParams(id, packageType, name))
+	if err == sql.ErrNoRows {
+		return uuid.Nil, ErrNotFound
+	}
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	return ret.LinkID.UUID, nil
+}
+
+func (s *sqliteStoreImpl) CreateUnitVar(ctx context.Context, id uuid.UUID, packageType enums.PackageType, name, value string, linkID uuid.UUID, updateExisting bool) error {
+	return s.RunInTx(ctx, func(tx Tx) error {
+		return tx.CreateUnitVar(ctx, id, packageType, name, value, linkID, updateExisting)
+	})
+}
+
+func (t *sqliteTx) CreateUnitVar(ctx context.Context, id uuid.UUID, packageType enums.PackageType, name, value string, linkID uuid.UUID, updateExisting bool) (err error) {
+	defer wrap(&err, "CreateUnitVar(%s, %s, %s, %s)", id, packageType, name, value)
+
+	wantValue, wantLinkID, err := unitVarValue(value, linkID)
+	if err != nil {
+		return err
+	}
+
+	q := t.queries
+
+	exists := false
+	uv, err := q.ReadUnitVar(ctx, sqliteUnitVarParams(id, packageType, name))
+	switch {
+	case err == sql.ErrNoRows:
+	case err != nil:
+		return err
+	default:
+		exists = true
+	}
+
+	// It exists but it does not require further updates.
+	if exists && wantValue == uv.Variablevalue && wantLinkID == uv.LinkID {
+		return nil
+	}
+
+	// It exists and requires further updates but we rather raise an error.
+	if !updateExisting {
+		return errors.New("variable exists but with different propreties")
+	}
+
+	now := time.Now().UTC()
+	if exists {
+		if err := q.UpdateUnitVar(ctx, &sqlc.UpdateUnitVarParams{
+			Value:     wantValue,
+			LinkID:    wantLinkID,
+			UpdatedAt: now,
+			UnitID:    uuid.NullUUID{UUID: id, Valid: true},
+			UnitType:  sql.NullString{String: packageType.String(), Valid: true},
+			Name:      sql.NullString{String: name, Valid: true},
+		}); err != nil {
+			return fmt.Errorf("update: %w", err)
+		}
+	} else {
+		if err := q.CreateUnitVar(ctx, &sqlc.CreateUnitVarParams{
+			ID:        uuid.New(),
+			UnitID:    uuid.NullUUID{UUID: id, Valid: true},
+			UnitType:  sql.NullString{String: packageType.String(), Valid: true},
+			Name:      sql.NullString{String: name, Valid: true},
+			Value:     wantValue,
+			LinkID:    wantLinkID,
+			CreatedAt: now,
+		}); err != nil {
+			return fmt.Errorf("create: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func sqliteUnitVarParams(id uuid.UUID, packageType enums.PackageType, name string) *sqlc.ReadUnitVarParams {
+	return &sqlc.ReadUnitVarParams{
+		UnitID:   uuid.NullUUID{UUID: id, Valid: true},
+		UnitType: sql.NullString{String: packageType.String(), Valid: true},
+		Name:     sql.NullString{String: name, Valid: true},
+	}
+}
+
+func (s *sqliteStoreImpl) Files(ctx context.Context, id uuid.UUID, packageType enums.PackageType, filterFilenameEnd, filterSubdir, replacementPath string) iter.Seq2[File, error] {
+	return listFiles(func(after uuid.UUID) (_ []File, err error) {
+		defer wrap(&err, "Files(%s, %s, %s, %s, %s)", id, packageType, filterFilenameEnd, filterSubdir, replacementPath)
+
+		// The filters compare bytes instead of using LIKE, which is not case
+		// sensitive in SQLite.
+		params := &sqlc.ListFilesParams{After: after, BatchSize: filesPageSize}
+		if filterFilenameEnd != "" {
+			params.Suffix = []byte(filterFilenameEnd)
+		}
+		if filterSubdir != "" {
+			params.Prefix = []byte(replacementPath + filterSubdir)
+		}
+		switch packageType {
+		case enums.PackageTypeTransfer:
+			params.TransferID = sql.NullString{String: id.String(), Valid: true}
+		case enums.PackageTypeSIP, enums.PackageTypeDIP:
+			params.SipID = sql.NullString{String: id.String(), Valid: true}
+		default:
+			return nil, fmt.Errorf("unexpected package type: %q", packageType)
+		}
+
+		rows, err := s.reads.ListFiles(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+		ret := make([]File, 0, len(rows))
+		for _, row := range rows {
+			ret = append(ret, File{
+				ID:               row.Fileuuid,
+				CurrentLocation:  string(row.Currentlocation),
+				OriginalLocation: string(row.Originallocation),
+				FileGrpUse:       row.Filegrpuse,
+			})
+		}
+
+		return ret, nil
+	})
+}
+
+// KnownFileLocations builds the list of parameters of the query, which sqlc
+// drops when the column is cast. The locations are compared as bytes, like in
+// Files, since they may be stored as text or as blobs.
+func (s *sqliteStoreImpl) KnownFileLocations(ctx context.Context, id uuid.UUID, packageType enums.PackageType, locations []string) (_ map[string]struct{}, err error) {
+	defer wrap(&err, "KnownFileLocations(%s, %s)", id, packageType)
+
+	ret := map[string]struct{}{}
+	if len(locations) == 0 {
+		return ret, nil
+	}
+
+	var column string
+	switch packageType {
+	case enums.PackageTypeTransfer:
+		column = "transferUUID"
+	case enums.PackageTypeSIP, enums.PackageTypeDIP:
+		column = "sipUUID"
+	default:
+		return nil, fmt.Errorf("unexpected package type: %q", packageType)
+	}
+
+	var b strings.Builder
+	args := make([]any, 0, len(locations)+1)
+	args = append(args, id.String())
+	b.WriteString("SELECT CAST(currentLocation AS BLOB) FROM Files WHERE " + column + " = ? AND CAST(currentLocation AS BLOB) IN (")
+	for i, loc := range locations {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		b.WriteString("?")
+		args = append(args, []byte(loc))
+	}
+	b.WriteString(")")
+
+	rows, err := s.reader.QueryContext(ctx, b.String(), args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var loc []byte
+		if err := rows.Scan(&loc); err != nil {
+			return nil, err
+		}
+		ret[string(loc)] = struct{}{}
+	}
+
+	return ret, rows.Err()
+}
+
+func (s *sqliteStoreImpl) ReadPipelineID(ctx context.Context) (_ uuid.UUID, err error) {
+	defer wrap(&err, "ReadPipelineID()")
+
+	ret, err := s.reads.ReadDashboardSetting(ctx, "dashboard_uuid")
+	if err == sql.ErrNoRows {
+		return uuid.Nil, ErrNotFound
+	}
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	return uuid.Parse(ret.Value)
+}
+
+func (s *sqliteStoreImpl) ReadDict(ctx context.Context, name string) (_ map[string]string, err error) {
+	defer wrap(&err, "ReadDict(%s)", name)
+
+	rows, err := s.reads.ReadDashboardSettingsWithScope(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, ErrNotFound
+	}
+
+	ret := make(map[string]string, len(rows))
+	for _, row := range rows {
+		ret[row.Name] = row.Value
+	}
+
+	return ret, nil
+}
+
+func (s *sqliteStoreImpl) ValidateUserAPIKey(ctx context.Context, username, key string) (_ *User, err error) {
+	defer wrap(&err, "ValidateUserAPIKey(%q, %q)", username, key)
+
+	row, err := s.reads.ReadUserWithKey(ctx, &sqlc.ReadUserWithKeyParams{
+		Username: username,
+		Key:      key,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return sqliteUser(row.ID, row.Username, row.Email, row.IsActive, row.AgentID), nil
+}
+
+func (s *sqliteStoreImpl) ReadUserWithUsername(ctx context.Context, username string) (_ *User, err error) {
+	defer wrap(&err, "ReadUserWithUsername(%q)", username)
+
+	row, err := s.reads.ReadUserWithUsername(ctx, username)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return sqliteUser(row.ID, row.Username, row.Email, row.IsActive, row.AgentID), nil
+}
+
+func (s *sqliteStoreImpl) ValidateUserPassword(ctx context.Context, username, password string) (_ *User, err error) {
+	defer wrap(&err, "ValidateUserPassword(%q)", username)
+
+	row, err := s.reads.ReadUserWithPassword(ctx, username)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if !checkPassword(row.Password, password) {
+		return nil, nil
+	}
+
+	return sqliteUser(row.ID, row.Username, row.Email, row.IsActive, row.AgentID), nil
+}
+
+func sqliteUser(id int64, username, email string, active bool, agentID sql.NullInt64) *User {
+	ret := &User{
+		ID:       int(id),
+		Username: username,
+		Email:    email,
+		Active:   active,
+	}
+	if agentID.Valid {
+		ret.AgentID = ref.New(int(agentID.Int64))
+	}
+
+	return ret
+}
+
+func (s *sqliteStoreImpl) ReadUserAPIKey(ctx context.Context, userID int) (_ string, err error) {
+	defer wrap(&err, "ReadUserAPIKey(%d)", userID)
+
+	key, err := s.reads.ReadUserAPIKey(ctx, int64(userID))
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", ErrNotFound
+	}
+	if err != nil {
+		return "", err
+	}
+
+	return key, nil
+}
+
+func (s *sqliteStoreImpl) ReadUserGroups(ctx context.Context, userID int) (_ []string, err error) {
+	defer wrap(&err, "ReadUserGroups(%d)", userID)
+
+	return s.reads.ReadUserGroups(ctx, int64(userID))
+}
+
+func (s *sqliteStoreImpl) CreateAuditEvent(ctx context.Context, event *AuditEvent) (err error) {
+	defer wrap(&err, "CreateAuditEvent(%s)", event.Procedure)
+
+	params := &sqlc.CreateAuditEventParams{
+		CreatedAt:     event.CreatedAt.UTC(),
+		Username:      event.Username,
+		Procedurename: event.Procedure,
+		Summary:       event.Summary,
+		Outcome:       event.Outcome,
+		Message:       event.Message,
+		Packageuuid:   event.PackageID,
+		Decisionuuid:  event.DecisionID,
+	}
+	if event.UserID.Valid {
+		params.Userid = sql.NullInt64{Int64: int64(event.UserID.Int32), Valid: true}
+	}
+
+	id, err := s.queries.CreateAuditEvent(ctx, params)
+	if err != nil {
+		return err
+	}
+
+	event.ID = id
+
+	return nil
+}
+
+func (s *sqliteStoreImpl) ListAuditEvents(ctx context.Context, params *ListAuditEventsParams) (_ []*AuditEvent, err error) {
+	defer wrap(&err, "ListAuditEvents(params)")
+
+	p := &sqlc.ListAuditEventsParams{MaxEvents: -1} // No limit.
+	if params.Username != nil {
+		p.Username = sql.NullString{String: *params.Username, Valid: true}
+	}
+	if params.Procedure != nil {
+		p.ProcedureName = sql.NullString{String: *params.Procedure, Valid: true}
+	}
+	if params.PackageID != nil {
+		p.PackageID = sql.NullString{String: params.PackageID.String(), Valid: true}
+	}
+	if params.DecisionID != nil {
+		p.DecisionID = sql.NullString{String: params.DecisionID.String(), Valid: true}
+	}
+	if params.Outcome != nil {
+		p.Outcome = sql.NullString{String: *params.Outcome, Valid: true}
+	}
+	if params.Since != nil {
+		p.Since = sql.NullString{String: params.Since.UTC().Format(sqliteTimeFormat), Valid: true}
+	}
+	if params.Until != nil {
+		p.Until = sql.NullString{String: params.Until.UTC().Format(sqliteTimeFormat), Valid: true}
+	}
+	if params.BeforeID != nil {
+		p.BeforeID = sql.NullInt64{Int64: *params.BeforeID, Valid: true}
+	}
+	if params.Limit > 0 {
+		p.MaxEvents = int64(params.Limit)
+	}
+
+	rows, err := s.reads.ListAuditEvents(ctx, p)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make([]*AuditEvent, 0, len(rows))
+	for _, row := range rows {
+		event := &AuditEvent{
+			ID:         row.ID,
+			CreatedAt:  row.CreatedAt,
+			Username:   row.Username,
+			Procedure:  row.Procedurename,
+			Summary:    row.Summary,
+			Outcome:    row.Outcome,
+			Message:    row.Message,
+			PackageID:  row.Packageuuid,
+			DecisionID: row.Decisionuuid,
+		}
+		if row.Userid.Valid {
+			event.UserID = sql.NullInt32{Int32: int32(row.Userid.Int64), Valid: true}
+		}
+		ret = append(ret, event)
+	}
+
+	return ret, nil
+}
+
+func (s *sqliteStoreImpl) CreateQueueEntry(ctx context.Context, entry *QueueEntry) (err error) {
+	defer wrap(&err, "CreateQueueEntry(%s)", entry.PackageID)
+
+	id, err := s.queries.CreateQueueEntry(ctx, &sqlc.CreateQueueEntryParams{
+		Packageuuid:    entry.PackageID,
+		Packagetype:    entry.PackageType.String(),
+		Path:           entry.Path,
+		Startchainuuid: entry.StartChainID,
+		Startlinkuuid:  entry.StartLinkID,
+		Priority:       int64(entry.Priority),
+		Size:           int64(entry.Size),
+		Tag:            entry.Tag,
+		Queuedtime:     entry.QueuedAt.UTC(),
+	})
+	if err != nil {
+		return err
+	}
+
+	entry.ID = id
+
+	return nil
+}
+
+func (s *sqliteStoreImpl) UpdateQueueEntryTag(ctx context.Context, pkgID uuid.UUID, tag float64) (err error) {
+	defer wrap(&err, "UpdateQueueEntryTag(%s, %f)", pkgID, tag)
+
+	return s.queries.UpdateQueueEntryTag(ctx, &sqlc.UpdateQueueEntryTagParams{
+		Tag:         tag,
+		Packageuuid: pkgID,
+	})
+}
+
+func (s *sqliteStoreImpl) DeleteQueueEntry(ctx context.Context, pkgID uuid.UUID) (err error) {
+	defer wrap(&err, "DeleteQueueEntry(%s)", pkgID)
+
+	return s.queries.DeleteQueueEntry(ctx, pkgID)
+}
+
+func (s *sqliteStoreImpl) ListQueueEntries(ctx context.Context) (_ []*QueueEntry, err error) {
+	defer wrap(&err, "ListQueueEntries()")
+
+	rows, err := s.reads.ListQueueEntries(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make([]*QueueEntry, 0, len(rows))
+	for _, row := range rows {
+		packageType, err := enums.ParsePackageType(row.Packagetype)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, &QueueEntry{
+			ID:           row.ID,
+			PackageID:    row.Packageuuid,
+			PackageType:  packageType,
+			Path:         row.Path,
+			StartChainID: row.Startchainuuid,
+			StartLinkID:  row.Startlinkuuid,
+			Priority:     int32(row.Priority),
+			Size:         uint64(row.Size),
+			Tag:          row.Tag,
+			QueuedAt:     row.Queuedtime,
+		})
+	}
+
+	return ret, nil
+}
+
+func (s *sqliteStoreImpl) AcquireLease(ctx context.Context, name, holder string, ttl time.Duration) (_ bool, err error) {
+	defer wrap(&err, "AcquireLease(%s, %s, %s)", name, holder, ttl)
+
+	// The upsert in the other drivers is not supported by sqlc for SQLite, but
+	// the transaction takes the write lock when it begins so reading the lease
+	// first is just as safe.
+	tx, err := s.writer.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return false, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	q := s.queries.WithTx(tx)
+
+	now := time.Now().UTC()
+	lease, err := q.ReadLease(ctx, name)
+	if err != nil && err != sql.ErrNoRows {
+		return false, err
+	}
+	if err == nil && lease.Holder != holder && lease.Expirestime.After(now) {
+		return false, nil // Held by someone else.
+	}
+
+	if err := q.UpsertLease(ctx, &sqlc.UpsertLeaseParams{
+		Name:        name,
+		Holder:      holder,
+		Expirestime: now.Add(ttl),
+	}); err != nil {
+		return false, err
+	}
+
+	return true, tx.Commit()
+}
+
+func (s *sqliteStoreImpl) ReadLeaseHolder(ctx context.Context, name string) (_ string, err error) {
+	defer wrap(&err, "ReadLeaseHolder(%s)", name)
+
+	holder, err := s.reads.ReadLeaseHolder(ctx, &sqlc.ReadLeaseHolderParams{
+		Name: name,
+		Now:  time.Now().UTC(),
+	})
+	if err == sql.ErrNoRows {
+		return "", ErrNotFound
+	} else if err != nil {
+		return "", err
+	}
+
+	return holder, nil
+}
+
+func (s *sqliteStoreImpl) ReleaseLease(ctx context.Context, name, holder string) (err error) {
+	defer wrap(&err, "ReleaseLease(%s, %s)", name, holder)
+
+	return s.queries.ReleaseLease(ctx, &sqlc.ReleaseLeaseParams{
+		Name:   name,
+		Holder: holder,
+	})
+}
+
+func (s *sqliteStoreImpl) Ping(ctx context.Context) error {
+	return errors.Join(s.writer.PingContext(ctx), s.reader.PingContext(ctx))
+}
+
+func (s *sqliteStoreImpl) Running() bool {
+	return s != nil
+}
+
+func (s *sqliteStoreImpl) Close() error {
+	var err error
+
+	if s.reads != nil {
+		err = errors.Join(err, s.reads.Close())
+	}
+
+	if s.queries != nil {
+		err = errors.Join(err, s.queries.Close())
+	}
+
+	if s.reader != nil {
+		err = errors.Join(err, s.reader.Close())
+	}
+
+	if s.writer != nil {
+		err = errors.Join(err, s.writer.Close())
+	}
+
+	return err
+}
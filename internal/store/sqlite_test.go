@@ -0,0 +1,83 @@
+package store
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/google/uuid"
+	"gotest.tools/v3/assert"
+)
+
+func TestSQLiteStore(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Applies the migrations to an empty database", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		path := filepath.Join(t.TempDir(), "ccp.db")
+
+		s, err := New(logr.Discard(), "sqlite", path)
+		assert.NilError(t, err)
+		defer s.Close()
+
+		_, err = s.UpsertTransfer(ctx, uuid.New(), "/tmp/transfer/")
+		assert.NilError(t, err)
+
+		m, err := NewMigrator(logr.Discard(), "sqlite", path)
+		assert.NilError(t, err)
+		defer m.Close()
+		assert.NilError(t, m.Check(ctx))
+	})
+
+	t.Run("Reopens an existing database", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		path := filepath.Join(t.TempDir(), "ccp.db")
+		id := uuid.New()
+
+		m, err := NewMigrator(logr.Discard(), "sqlite", path)
+		assert.NilError(t, err)
+		_, err = m.Up(ctx)
+		assert.NilError(t, err)
+		assert.NilError(t, m.Close())
+
+		s, err := New(logr.Discard(), "sqlite", path)
+		assert.NilError(t, err)
+		_, err = s.UpsertTransfer(ctx, id, "/tmp/transfer/")
+		assert.NilError(t, err)
+		assert.NilError(t, s.Close())
+
+		s, err = New(logr.Discard(), "sqlite", "file:"+path+"?cache=private")
+		assert.NilError(t, err)
+		defer s.Close()
+
+		loc, err := s.ReadTransferLocation(ctx, id)
+		assert.NilError(t, err)
+		assert.Equal(t, loc, "/tmp/transfer/")
+	})
+}
+
+// BenchmarkSQLiteTasks compares writing the tasks of a job one by one with
+// writing them in bulk, e.g.:
+//
+//	go test ./internal/store -run=^$ -bench=SQLiteTasks
+func BenchmarkSQLiteTasks(b *testing.B) {
+	path := filepath.Join(b.TempDir(), "ccp.db")
+
+	s, err := New(logr.Discard(), "sqlite", path)
+	assert.NilError(b, err)
+	b.Cleanup(func() { s.Close() })
+
+	worker, reader, err := connectToSQLite(logr.Discard(), path)
+	assert.NilError(b, err)
+	b.Cleanup(func() {
+		worker.Close()
+		reader.Close()
+	})
+
+	benchmarkTasks(b, s, worker)
+}
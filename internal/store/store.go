@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"iter"
 	"strings"
 	"time"
 
@@ -19,6 +20,14 @@ import (
 var ErrNotFound error = errors.New("object not found")
 
 type Store interface {
+	Tx
+
+	// RunInTx runs fn in a transaction that is committed if fn returns nil
+	// and rolled back otherwise. fn is run again if the transaction fails
+	// because of a deadlock or another transient error, so it should not have
+	// other side effects. The Store must not be used from fn, only tx.
+	RunInTx(ctx context.Context, fn func(tx Tx) error) error
+
 	// RemoveTransientData removes data from the store that the processing
 	// engine can't handle after the application is started.
 	RemoveTransientData(ctx context.Context) error
@@ -26,9 +35,6 @@ type Store interface {
 	// CreateJob creates a new Job.
 	CreateJob(ctx context.Context, params *sqlc.CreateJobParams) error
 
-	// UpdateJobStatus modifies the status of a Job.
-	UpdateJobStatus(ctx context.Context, id uuid.UUID, status string) error
-
 	// FindAwaitingJob returns the first job awaiting a decision.
 	FindAwaitingJob(ctx context.Context, params *FindAwaitingJobParams) (*adminv1.Job, error)
 
@@ -36,16 +42,26 @@ type Store interface {
 	// recently created jobs first.
 	ListJobs(ctx context.Context, pkgID uuid.UUID) ([]*adminv1.Job, error)
 
-	// CreateTasks creates a group of Tasks in bulk.
+	// ListPackageJobs returns the jobs of all the packages of a type that are
+	// not hidden, grouped by package identifier and showing the most recently
+	// created jobs first. Unlike ListJobs, it reads the jobs of many packages
+	// in a single query.
+	ListPackageJobs(ctx context.Context, packageType adminv1.PackageType) (map[uuid.UUID][]*adminv1.Job, error)
+
+	// CreateTasks creates a group of Tasks in bulk. The tasks are written in
+	// chunks bounded by taskChunkSize and taskChunkBytes using multi-row
+	// statements.
 	CreateTasks(ctx context.Context, tasks []*Task) error
 
+	// UpdateTasks records the results of a group of Tasks in bulk. The results
+	// are written in chunks bounded by taskChunkSize and taskChunkBytes and
+	// unknown tasks are ignored.
+	UpdateTasks(ctx context.Context, results []*TaskResult) error
+
 	// ReadPackagesWithCreationTimestamps returns a list of packages along with
 	// their creation timestamps. It excludes hidden packages.
 	ReadPackagesWithCreationTimestamps(ctx context.Context, packageType adminv1.PackageType) ([]*adminv1.Package, error)
 
-	// UpdatePackageStatus modifies the status of a Transfer, DIP or SIP.
-	UpdatePackageStatus(ctx context.Context, id uuid.UUID, packageType enums.PackageType, status enums.PackageStatus) error
-
 	// ReadTransferLocation returns the current path of a Transfer.
 	ReadTransferLocation(ctx context.Context, id uuid.UUID) (loc string, err error)
 
@@ -64,6 +80,11 @@ type Store interface {
 	// a new Transfer with a new UUID otherwise.
 	EnsureTransfer(ctx context.Context, path string) (id uuid.UUID, created bool, err error)
 
+	// ReadTransferWithIdempotencyKey returns the identifier of the Transfer
+	// created with the given idempotency key along with the digest of the
+	// request recorded with it.
+	ReadTransferWithIdempotencyKey(ctx context.Context, key string) (id uuid.UUID, digest string, err error)
+
 	// UpdateTransferLocation updates the current location of a given transfer.
 	UpdateTransferLocation(ctx context.Context, id uuid.UUID, path string) error
 
@@ -98,12 +119,15 @@ type Store interface {
 	// ReadUnitLinkID reads a workflow link ID stored as a package variable.
 	ReadUnitLinkID(ctx context.Context, id uuid.UUID, packageType enums.PackageType, name string) (uuid.UUID, error)
 
-	// CreateUnitVar creates a new variable.
-	CreateUnitVar(ctx context.Context, id uuid.UUID, packageType enums.PackageType, name, value string, linkID uuid.UUID, update bool) error
+	// Files returns an iterator over the files of a package sorted by
+	// identifier. They are read in pages of filesPageSize as the iteration
+	// advances, and the iteration stops after the first error.
+	Files(ctx context.Context, id uuid.UUID, packageType enums.PackageType, filterFilenameEnd, filterSubdir, replacementPath string) iter.Seq2[File, error]
 
-	// Files returns a list of files. This could return some kind of iterator
-	// interface; rangefunc did work but it's not supported by linters yet.
-	Files(ctx context.Context, id uuid.UUID, packageType enums.PackageType, filterFilenameEnd, filterSubdir, replacementPath string) ([]File, error)
+	// KnownFileLocations returns the given current locations that belong to
+	// files of the package. Callers bound the number of locations, e.g. to
+	// tell which files found in the package directory are already recorded.
+	KnownFileLocations(ctx context.Context, id uuid.UUID, packageType enums.PackageType, locations []string) (map[string]struct{}, error)
 
 	// ReadPipelineID reads the identifier of this pipeline.
 	ReadPipelineID(ctx context.Context) (uuid.UUID, error)
@@ -117,12 +141,78 @@ type Store interface {
 	// doesn't exist; check the error for details.
 	ValidateUserAPIKey(ctx context.Context, username, key string) (*User, error)
 
+	// ReadUserWithUsername returns the User with the given username, including
+	// inactive users.
+	ReadUserWithUsername(ctx context.Context, username string) (*User, error)
+
+	// ValidateUserPassword returns the active User with the given username and
+	// password, or nil when the credentials are not valid.
+	ValidateUserPassword(ctx context.Context, username, password string) (*User, error)
+
+	// ReadUserAPIKey returns the API key of the user.
+	ReadUserAPIKey(ctx context.Context, userID int) (string, error)
+
+	// ReadUserGroups returns the names of the groups (auth_group) the user
+	// belongs to.
+	ReadUserGroups(ctx context.Context, userID int) ([]string, error)
+
+	// CreateAuditEvent appends the event to the audit trail. Audit events are
+	// never updated or deleted.
+	CreateAuditEvent(ctx context.Context, event *AuditEvent) error
+
+	// ListAuditEvents returns the audit events matching the params, most
+	// recent first.
+	ListAuditEvents(ctx context.Context, params *ListAuditEventsParams) ([]*AuditEvent, error)
+
+	// CreateQueueEntry adds the package to the persisted processing queue, or
+	// replaces its entry if the package is already queued.
+	CreateQueueEntry(ctx context.Context, entry *QueueEntry) error
+
+	// UpdateQueueEntryTag updates the position of the package in the queue.
+	UpdateQueueEntryTag(ctx context.Context, pkgID uuid.UUID, tag float64) error
+
+	// DeleteQueueEntry removes the package from the persisted queue. It is
+	// not an error if the package is not queued.
+	DeleteQueueEntry(ctx context.Context, pkgID uuid.UUID) error
+
+	// ListQueueEntries returns the persisted queue, in order.
+	ListQueueEntries(ctx context.Context) ([]*QueueEntry, error)
+
+	// AcquireLease acquires the named lease for the holder, or renews it if
+	// the holder already has it. The lease expires after ttl unless renewed.
+	// It reports whether the holder has the lease, which is not the case when
+	// it is held by someone else.
+	AcquireLease(ctx context.Context, name, holder string, ttl time.Duration) (bool, error)
+
+	// ReadLeaseHolder returns the holder of the named lease. It returns
+	// ErrNotFound if the lease is not held by anyone.
+	ReadLeaseHolder(ctx context.Context, name string) (string, error)
+
+	// ReleaseLease releases the named lease if it is held by the holder.
+	ReleaseLease(ctx context.Context, name, holder string) error
+
+	// Ping verifies that the database is still reachable.
+	Ping(ctx context.Context) error
+
 	Running() bool
 	Close() error
 }
 
+// Tx is the part of the Store that can be used in a transaction, see
+// Store.RunInTx, e.g. to transition a job and its package atomically.
+type Tx interface {
+	// UpdateJobStatus modifies the status of a Job.
+	UpdateJobStatus(ctx context.Context, id uuid.UUID, status string) error
+
+	// UpdatePackageStatus modifies the status of a Transfer, DIP or SIP.
+	UpdatePackageStatus(ctx context.Context, id uuid.UUID, packageType enums.PackageType, status enums.PackageStatus) error
+
+	// CreateUnitVar creates a new variable.
+	CreateUnitVar(ctx context.Context, id uuid.UUID, packageType enums.PackageType, name, value string, linkID uuid.UUID, update bool) error
+}
+
 func New(logger logr.Logger, driver, dsn string) (Store, error) {
-	var store *mysqlStoreImpl
+	var store Store
 
 	switch strings.ToLower(driver) {
 	case "mysql":
@@ -132,11 +222,57 @@ func New(logger logr.Logger, driver, dsn string) (Store, error) {
 			if err != nil {
 				return nil, fmt.Errorf("connect to MySQL: %v", err)
 			}
+			if err := checkSchema(logger, "mysql", pool); err != nil {
+				return nil, errors.Join(err, pool.Close())
+			}
 			store, err = newMySQLStore(logger, pool)
 			if err != nil {
 				return nil, fmt.Errorf("new MySQL store: %v", err)
 			}
 		}
+	case "postgres", "postgresql", "pgx":
+		{
+			logger = logger.WithName("postgres")
+			pool, err := connectToPostgres(logger, dsn)
+			if err != nil {
+				return nil, fmt.Errorf("connect to PostgreSQL: %v", err)
+			}
+			if err := checkSchema(logger, "postgres", pool); err != nil {
+				return nil, errors.Join(err, pool.Close())
+			}
+			store, err = newPostgresStore(logger, pool)
+			if err != nil {
+				return nil, fmt.Errorf("new PostgreSQL store: %v", err)
+			}
+		}
+	case "sqlite", "sqlite3":
+		{
+			logger = logger.WithName("sqlite")
+			writer, reader, err := connectToSQLite(logger, dsn)
+			if err != nil {
+				return nil, fmt.Errorf("connect to SQLite: %v", err)
+			}
+			// The database is usually a file created by the first run of a
+			// single binary deployment, its schema is kept up to date.
+			if err := upgradeSchema(logger, "sqlite", writer); err != nil {
+				return nil, errors.Join(err, reader.Close(), writer.Close())
+			}
+			store, err = newSQLiteStore(logger, writer, reader)
+			if err != nil {
+				return nil, fmt.Errorf("new SQLite store: %v", err)
+			}
+		}
+	case "memory":
+		{
+			// The DSN is optional, it points to the JSON document loaded
+			// into the store, e.g. to create a user for demos.
+			logger = logger.WithName("memory")
+			var err error
+			store, err = newMemoryStore(logger, dsn)
+			if err != nil {
+				return nil, fmt.Errorf("new memory store: %v", err)
+			}
+		}
 	default:
 		return nil, fmt.Errorf("unsupported db driver: %q", driver)
 	}
@@ -188,6 +324,34 @@ type File struct {
 	FileGrpUse       string    `db:"fileGrpUse"`
 }
 
+// filesPageSize is the number of files read at once by Store.Files.
+const filesPageSize = 250
+
+// listFiles returns an iterator over the files returned by list, which reads a
+// page of files sorted by identifier that come after the given identifier
+// (keyset pagination). The first page comes after uuid.Nil.
+func listFiles(list func(after uuid.UUID) ([]File, error)) iter.Seq2[File, error] {
+	return func(yield func(File, error) bool) {
+		after := uuid.Nil
+		for {
+			files, err := list(after)
+			if err != nil {
+				yield(File{}, err)
+				return
+			}
+			for _, f := range files {
+				if !yield(f, nil) {
+					return
+				}
+			}
+			if len(files) < filesPageSize {
+				return
+			}
+			after = files[len(files)-1].ID
+		}
+	}
+}
+
 type Task struct {
 	ID        uuid.UUID     `db:"taskUUID"`
 	CreatedAt time.Time     `db:"createdTime"`
@@ -204,6 +368,60 @@ type Task struct {
 	JobID     uuid.UUID     `db:"jobuuid"`
 }
 
+// TaskResult is the outcome of a Task reported by the worker.
+type TaskResult struct {
+	ID       uuid.UUID
+	EndedAt  time.Time
+	ExitCode int16
+	Stdout   string
+	Stderr   string
+}
+
+// taskChunkSize is the maximum number of tasks written by a single statement
+// in CreateTasks and UpdateTasks. It keeps the statements under the limit of
+// placeholders of the database servers, see taskChunkBytes for the size.
+const taskChunkSize = 1000
+
+// taskChunkBytes is the maximum number of bytes of task output written by a
+// single statement in CreateTasks and UpdateTasks. The standard output and
+// error of a task are unbounded, so a chunk is also closed when their sum
+// reaches this limit. It is well under the default max_allowed_packet of
+// MySQL (64MiB) to leave room for the rest of the statement.
+const taskChunkBytes = 4 << 20
+
+// chunkTasks splits items in chunks of up to taskChunkSize items whose sizes,
+// as reported by size, add up to taskChunkBytes at most. An item bigger than
+// taskChunkBytes is returned in a chunk of its own.
+func chunkTasks[T any](items []T, size func(T) int) iter.Seq[[]T] {
+	return func(yield func([]T) bool) {
+		start, n := 0, 0
+		for i, item := range items {
+			sz := size(item)
+			if i > start && (i-start == taskChunkSize || n+sz > taskChunkBytes) {
+				if !yield(items[start:i:i]) {
+					return
+				}
+				start, n = i, 0
+			}
+			n += sz
+		}
+		if start < len(items) {
+			yield(items[start:len(items):len(items)])
+		}
+	}
+}
+
+// taskSize returns the size of the output of a Task used by chunkTasks.
+func taskSize(t *Task) int {
+	return len(t.Arguments) + len(t.Stdout) + len(t.Stderr)
+}
+
+// taskResultSize returns the size of the output of a TaskResult used by
+// chunkTasks.
+func taskResultSize(r *TaskResult) int {
+	return len(r.Stdout) + len(r.Stderr)
+}
+
 type FindAwaitingJobParams struct {
 	Directory *string
 	PackageID *uuid.UUID
@@ -216,4 +434,116 @@ type User struct {
 	Email    string
 	Active   bool
 	AgentID  *int
+
+	// Groups is the list of groups the user belongs to as asserted by the
+	// identity provider, if any.
+	Groups []string
+}
+
+// AuditEvent records a call to a mutating procedure of the Admin API.
+type AuditEvent struct {
+	ID         int64         `db:"pk" goqu:"skipinsert"`
+	CreatedAt  time.Time     `db:"createdTime"`
+	UserID     sql.NullInt32 `db:"userID"`
+	Username   string        `db:"username"`
+	Procedure  string        `db:"procedureName"`
+	Summary    string        `db:"summary"`
+	Outcome    string        `db:"outcome"`
+	Message    string        `db:"message"`
+	PackageID  uuid.NullUUID `db:"packageUUID"`
+	DecisionID uuid.NullUUID `db:"decisionUUID"`
+}
+
+// QueueEntry is a package waiting in the processing queue.
+type QueueEntry struct {
+	ID           int64             `db:"pk" goqu:"skipinsert"`
+	PackageID    uuid.UUID         `db:"packageUUID"`
+	PackageType  enums.PackageType `db:"packageType"`
+	Path         string            `db:"path"`
+	StartChainID uuid.NullUUID     `db:"startChainUUID"`
+	StartLinkID  uuid.NullUUID     `db:"startLinkUUID"`
+	Priority     int32             `db:"priority"`
+	Size         uint64            `db:"size"`
+	Tag          float64           `db:"tag"` // Position assigned by the scheduler.
+	QueuedAt     time.Time         `db:"queuedTime"`
+}
+
+type ListAuditEventsParams struct {
+	Username   *string
+	Procedure  *string
+	PackageID  *uuid.UUID
+	DecisionID *uuid.UUID
+	Outcome    *string
+	Since      *time.Time // Inclusive.
+	Until      *time.Time // Exclusive.
+	BeforeID   *int64     // Keyset pagination, exclusive.
+	Limit      uint
+}
+
+// jobStep returns the value of Jobs.currentStep for the given job status.
+func jobStep(status string) (int32, error) {
+	switch status {
+	case "Unknown", "STATUS_UNKNOWN", "":
+		return 0, nil
+	case "Awaiting decision", "STATUS_AWAITING_DECISION":
+		return 1, nil
+	case "Completed successfully", "STATUS_COMPLETED_SUCCESSFULLY":
+		return 2, nil
+	case "Executing command(s)", "STATUS_EXECUTING_COMMANDS":
+		return 3, nil
+	case "Failed", "STATUS_FAILED":
+		return 4, nil
+	default:
+		return 0, fmt.Errorf("unknown status: %q", status)
+	}
+}
+
+// jobPackageType returns the package type given the value of Jobs.unitType.
+func jobPackageType(unitType string) adminv1.PackageType {
+	switch unitType {
+	case "unitDIP":
+		return adminv1.PackageType_PACKAGE_TYPE_DIP
+	case "unitSIP":
+		return adminv1.PackageType_PACKAGE_TYPE_SIP
+	case "unitTransfer":
+		return adminv1.PackageType_PACKAGE_TYPE_TRANSFER
+	default:
+		return adminv1.PackageType_PACKAGE_TYPE_UNSPECIFIED
+	}
+}
+
+// transferStatus returns the package status given the value of
+// Transfers.status.
+func transferStatus(status int) adminv1.PackageStatus {
+	switch enums.PackageStatus(status) {
+	case enums.PackageStatusProcessing:
+		return adminv1.PackageStatus_PACKAGE_STATUS_PROCESSING
+	case enums.PackageStatusDone:
+		return adminv1.PackageStatus_PACKAGE_STATUS_DONE
+	case enums.PackageStatusCompletedSuccessfully:
+		return adminv1.PackageStatus_PACKAGE_STATUS_COMPLETED_SUCCESSFULLY
+	case enums.PackageStatusFailed:
+		return adminv1.PackageStatus_PACKAGE_STATUS_FAILED
+	default:
+		return adminv1.PackageStatus_PACKAGE_STATUS_UNSPECIFIED
+	}
+}
+
+// unitVarValue returns the columns stored for a unit variable, which holds
+// either a value or a workflow link ID.
+func unitVarValue(value string, linkID uuid.UUID) (sql.NullString, uuid.NullUUID, error) {
+	switch {
+	case value == "" && linkID == uuid.Nil:
+		return sql.NullString{}, uuid.NullUUID{}, errors.New("both value and linkID are zero")
+	case value != "" && linkID != uuid.Nil:
+		return sql.NullString{}, uuid.NullUUID{}, errors.New("both value and linkID are non-zero")
+	case value != "":
+		// MCPServer sets "link_id" to NULL when a "value" is given, e.g.:
+		// 	name="processingConfiguration", value="automated", link_id=NULL
+		return sql.NullString{String: value, Valid: true}, uuid.NullUUID{}, nil
+	default:
+		// MCPServer sets "value" to empty string when a "link_id" is given, e.g.:
+		//	name="reNormalize", value="", link_id="8ba83807-2832-4e41-843c-2e55ad10ea0b"/
+		return sql.NullString{Valid: true}, uuid.NullUUID{UUID: linkID, Valid: true}, nil
+	}
 }
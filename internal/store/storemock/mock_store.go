@@ -11,7 +11,9 @@ package storemock
 
 import (
 	context "context"
+	iter "iter"
 	reflect "reflect"
+	time "time"
 
 	adminv1beta1 "github.com/artefactual-labs/ccp/internal/api/gen/archivematica/ccp/admin/v1beta1"
 	store "github.com/artefactual-labs/ccp/internal/store"
@@ -45,6 +47,45 @@ func (m *MockStore) EXPECT() *MockStoreMockRecorder {
 	return m.recorder
 }
 
+// AcquireLease mocks base method.
+func (m *MockStore) AcquireLease(ctx context.Context, name, holder string, ttl time.Duration) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcquireLease", ctx, name, holder, ttl)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcquireLease indicates an expected call of AcquireLease.
+func (mr *MockStoreMockRecorder) AcquireLease(ctx, name, holder, ttl any) *MockStoreAcquireLeaseCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcquireLease", reflect.TypeOf((*MockStore)(nil).AcquireLease), ctx, name, holder, ttl)
+	return &MockStoreAcquireLeaseCall{Call: call}
+}
+
+// MockStoreAcquireLeaseCall wrap *gomock.Call
+type MockStoreAcquireLeaseCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStoreAcquireLeaseCall) Return(arg0 bool, arg1 error) *MockStoreAcquireLeaseCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStoreAcquireLeaseCall) Do(f func(context.Context, string, string, time.Duration) (bool, error)) *MockStoreAcquireLeaseCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStoreAcquireLeaseCall) DoAndReturn(f func(context.Context, string, string, time.Duration) (bool, error)) *MockStoreAcquireLeaseCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Close mocks base method.
 func (m *MockStore) Close() error {
 	m.ctrl.T.Helper()
@@ -83,6 +124,44 @@ func (c *MockStoreCloseCall) DoAndReturn(f func() error) *MockStoreCloseCall {
 	return c
 }
 
+// CreateAuditEvent mocks base method.
+func (m *MockStore) CreateAuditEvent(ctx context.Context, event *store.AuditEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAuditEvent", ctx, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAuditEvent indicates an expected call of CreateAuditEvent.
+func (mr *MockStoreMockRecorder) CreateAuditEvent(ctx, event any) *MockStoreCreateAuditEventCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAuditEvent", reflect.TypeOf((*MockStore)(nil).CreateAuditEvent), ctx, event)
+	return &MockStoreCreateAuditEventCall{Call: call}
+}
+
+// MockStoreCreateAuditEventCall wrap *gomock.Call
+type MockStoreCreateAuditEventCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStoreCreateAuditEventCall) Return(arg0 error) *MockStoreCreateAuditEventCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStoreCreateAuditEventCall) Do(f func(context.Context, *store.AuditEvent) error) *MockStoreCreateAuditEventCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStoreCreateAuditEventCall) DoAndReturn(f func(context.Context, *store.AuditEvent) error) *MockStoreCreateAuditEventCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // CreateJob mocks base method.
 func (m *MockStore) CreateJob(ctx context.Context, params *sqlcmysql.CreateJobParams) error {
 	m.ctrl.T.Helper()
@@ -121,6 +200,44 @@ func (c *MockStoreCreateJobCall) DoAndReturn(f func(context.Context, *sqlcmysql.
 	return c
 }
 
+// CreateQueueEntry mocks base method.
+func (m *MockStore) CreateQueueEntry(ctx context.Context, entry *store.QueueEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateQueueEntry", ctx, entry)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateQueueEntry indicates an expected call of CreateQueueEntry.
+func (mr *MockStoreMockRecorder) CreateQueueEntry(ctx, entry any) *MockStoreCreateQueueEntryCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateQueueEntry", reflect.TypeOf((*MockStore)(nil).CreateQueueEntry), ctx, entry)
+	return &MockStoreCreateQueueEntryCall{Call: call}
+}
+
+// MockStoreCreateQueueEntryCall wrap *gomock.Call
+type MockStoreCreateQueueEntryCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStoreCreateQueueEntryCall) Return(arg0 error) *MockStoreCreateQueueEntryCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStoreCreateQueueEntryCall) Do(f func(context.Context, *store.QueueEntry) error) *MockStoreCreateQueueEntryCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStoreCreateQueueEntryCall) DoAndReturn(f func(context.Context, *store.QueueEntry) error) *MockStoreCreateQueueEntryCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // CreateTasks mocks base method.
 func (m *MockStore) CreateTasks(ctx context.Context, tasks []*store.Task) error {
 	m.ctrl.T.Helper()
@@ -235,6 +352,44 @@ func (c *MockStoreCreateUnitVarCall) DoAndReturn(f func(context.Context, uuid.UU
 	return c
 }
 
+// DeleteQueueEntry mocks base method.
+func (m *MockStore) DeleteQueueEntry(ctx context.Context, pkgID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteQueueEntry", ctx, pkgID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteQueueEntry indicates an expected call of DeleteQueueEntry.
+func (mr *MockStoreMockRecorder) DeleteQueueEntry(ctx, pkgID any) *MockStoreDeleteQueueEntryCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteQueueEntry", reflect.TypeOf((*MockStore)(nil).DeleteQueueEntry), ctx, pkgID)
+	return &MockStoreDeleteQueueEntryCall{Call: call}
+}
+
+// MockStoreDeleteQueueEntryCall wrap *gomock.Call
+type MockStoreDeleteQueueEntryCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStoreDeleteQueueEntryCall) Return(arg0 error) *MockStoreDeleteQueueEntryCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStoreDeleteQueueEntryCall) Do(f func(context.Context, uuid.UUID) error) *MockStoreDeleteQueueEntryCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStoreDeleteQueueEntryCall) DoAndReturn(f func(context.Context, uuid.UUID) error) *MockStoreDeleteQueueEntryCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // EnsureDIP mocks base method.
 func (m *MockStore) EnsureDIP(ctx context.Context, path string) (uuid.UUID, bool, error) {
 	m.ctrl.T.Helper()
@@ -356,12 +511,11 @@ func (c *MockStoreEnsureTransferCall) DoAndReturn(f func(context.Context, string
 }
 
 // Files mocks base method.
-func (m *MockStore) Files(ctx context.Context, id uuid.UUID, packageType enums.PackageType, filterFilenameEnd, filterSubdir, replacementPath string) ([]store.File, error) {
+func (m *MockStore) Files(ctx context.Context, id uuid.UUID, packageType enums.PackageType, filterFilenameEnd, filterSubdir, replacementPath string) iter.Seq2[store.File, error] {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Files", ctx, id, packageType, filterFilenameEnd, filterSubdir, replacementPath)
-	ret0, _ := ret[0].([]store.File)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret0, _ := ret[0].(iter.Seq2[store.File, error])
+	return ret0
 }
 
 // Files indicates an expected call of Files.
@@ -377,19 +531,19 @@ type MockStoreFilesCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockStoreFilesCall) Return(arg0 []store.File, arg1 error) *MockStoreFilesCall {
-	c.Call = c.Call.Return(arg0, arg1)
+func (c *MockStoreFilesCall) Return(arg0 iter.Seq2[store.File, error]) *MockStoreFilesCall {
+	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockStoreFilesCall) Do(f func(context.Context, uuid.UUID, enums.PackageType, string, string, string) ([]store.File, error)) *MockStoreFilesCall {
+func (c *MockStoreFilesCall) Do(f func(context.Context, uuid.UUID, enums.PackageType, string, string, string) iter.Seq2[store.File, error]) *MockStoreFilesCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockStoreFilesCall) DoAndReturn(f func(context.Context, uuid.UUID, enums.PackageType, string, string, string) ([]store.File, error)) *MockStoreFilesCall {
+func (c *MockStoreFilesCall) DoAndReturn(f func(context.Context, uuid.UUID, enums.PackageType, string, string, string) iter.Seq2[store.File, error]) *MockStoreFilesCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -433,431 +587,859 @@ func (c *MockStoreFindAwaitingJobCall) DoAndReturn(f func(context.Context, *stor
 	return c
 }
 
-// ListJobs mocks base method.
-func (m *MockStore) ListJobs(ctx context.Context, pkgID uuid.UUID) ([]*adminv1beta1.Job, error) {
+// KnownFileLocations mocks base method.
+func (m *MockStore) KnownFileLocations(ctx context.Context, id uuid.UUID, packageType enums.PackageType, locations []string) (map[string]struct{}, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListJobs", ctx, pkgID)
-	ret0, _ := ret[0].([]*adminv1beta1.Job)
+	ret := m.ctrl.Call(m, "KnownFileLocations", ctx, id, packageType, locations)
+	ret0, _ := ret[0].(map[string]struct{})
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListJobs indicates an expected call of ListJobs.
-func (mr *MockStoreMockRecorder) ListJobs(ctx, pkgID any) *MockStoreListJobsCall {
+// KnownFileLocations indicates an expected call of KnownFileLocations.
+func (mr *MockStoreMockRecorder) KnownFileLocations(ctx, id, packageType, locations any) *MockStoreKnownFileLocationsCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListJobs", reflect.TypeOf((*MockStore)(nil).ListJobs), ctx, pkgID)
-	return &MockStoreListJobsCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "KnownFileLocations", reflect.TypeOf((*MockStore)(nil).KnownFileLocations), ctx, id, packageType, locations)
+	return &MockStoreKnownFileLocationsCall{Call: call}
 }
 
-// MockStoreListJobsCall wrap *gomock.Call
-type MockStoreListJobsCall struct {
+// MockStoreKnownFileLocationsCall wrap *gomock.Call
+type MockStoreKnownFileLocationsCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockStoreListJobsCall) Return(arg0 []*adminv1beta1.Job, arg1 error) *MockStoreListJobsCall {
+func (c *MockStoreKnownFileLocationsCall) Return(arg0 map[string]struct{}, arg1 error) *MockStoreKnownFileLocationsCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockStoreListJobsCall) Do(f func(context.Context, uuid.UUID) ([]*adminv1beta1.Job, error)) *MockStoreListJobsCall {
+func (c *MockStoreKnownFileLocationsCall) Do(f func(context.Context, uuid.UUID, enums.PackageType, []string) (map[string]struct{}, error)) *MockStoreKnownFileLocationsCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockStoreListJobsCall) DoAndReturn(f func(context.Context, uuid.UUID) ([]*adminv1beta1.Job, error)) *MockStoreListJobsCall {
+func (c *MockStoreKnownFileLocationsCall) DoAndReturn(f func(context.Context, uuid.UUID, enums.PackageType, []string) (map[string]struct{}, error)) *MockStoreKnownFileLocationsCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// ReadDIP mocks base method.
-func (m *MockStore) ReadDIP(ctx context.Context, id uuid.UUID) (store.DIP, error) {
+// ListAuditEvents mocks base method.
+func (m *MockStore) ListAuditEvents(ctx context.Context, params *store.ListAuditEventsParams) ([]*store.AuditEvent, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ReadDIP", ctx, id)
-	ret0, _ := ret[0].(store.DIP)
+	ret := m.ctrl.Call(m, "ListAuditEvents", ctx, params)
+	ret0, _ := ret[0].([]*store.AuditEvent)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ReadDIP indicates an expected call of ReadDIP.
-func (mr *MockStoreMockRecorder) ReadDIP(ctx, id any) *MockStoreReadDIPCall {
+// ListAuditEvents indicates an expected call of ListAuditEvents.
+func (mr *MockStoreMockRecorder) ListAuditEvents(ctx, params any) *MockStoreListAuditEventsCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadDIP", reflect.TypeOf((*MockStore)(nil).ReadDIP), ctx, id)
-	return &MockStoreReadDIPCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAuditEvents", reflect.TypeOf((*MockStore)(nil).ListAuditEvents), ctx, params)
+	return &MockStoreListAuditEventsCall{Call: call}
 }
 
-// MockStoreReadDIPCall wrap *gomock.Call
-type MockStoreReadDIPCall struct {
+// MockStoreListAuditEventsCall wrap *gomock.Call
+type MockStoreListAuditEventsCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockStoreReadDIPCall) Return(dip store.DIP, err error) *MockStoreReadDIPCall {
-	c.Call = c.Call.Return(dip, err)
+func (c *MockStoreListAuditEventsCall) Return(arg0 []*store.AuditEvent, arg1 error) *MockStoreListAuditEventsCall {
+	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockStoreReadDIPCall) Do(f func(context.Context, uuid.UUID) (store.DIP, error)) *MockStoreReadDIPCall {
+func (c *MockStoreListAuditEventsCall) Do(f func(context.Context, *store.ListAuditEventsParams) ([]*store.AuditEvent, error)) *MockStoreListAuditEventsCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockStoreReadDIPCall) DoAndReturn(f func(context.Context, uuid.UUID) (store.DIP, error)) *MockStoreReadDIPCall {
+func (c *MockStoreListAuditEventsCall) DoAndReturn(f func(context.Context, *store.ListAuditEventsParams) ([]*store.AuditEvent, error)) *MockStoreListAuditEventsCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// ReadDict mocks base method.
-func (m *MockStore) ReadDict(ctx context.Context, name string) (map[string]string, error) {
+// ListJobs mocks base method.
+func (m *MockStore) ListJobs(ctx context.Context, pkgID uuid.UUID) ([]*adminv1beta1.Job, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ReadDict", ctx, name)
-	ret0, _ := ret[0].(map[string]string)
+	ret := m.ctrl.Call(m, "ListJobs", ctx, pkgID)
+	ret0, _ := ret[0].([]*adminv1beta1.Job)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ReadDict indicates an expected call of ReadDict.
-func (mr *MockStoreMockRecorder) ReadDict(ctx, name any) *MockStoreReadDictCall {
+// ListJobs indicates an expected call of ListJobs.
+func (mr *MockStoreMockRecorder) ListJobs(ctx, pkgID any) *MockStoreListJobsCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadDict", reflect.TypeOf((*MockStore)(nil).ReadDict), ctx, name)
-	return &MockStoreReadDictCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListJobs", reflect.TypeOf((*MockStore)(nil).ListJobs), ctx, pkgID)
+	return &MockStoreListJobsCall{Call: call}
 }
 
-// MockStoreReadDictCall wrap *gomock.Call
-type MockStoreReadDictCall struct {
+// MockStoreListJobsCall wrap *gomock.Call
+type MockStoreListJobsCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockStoreReadDictCall) Return(arg0 map[string]string, arg1 error) *MockStoreReadDictCall {
+func (c *MockStoreListJobsCall) Return(arg0 []*adminv1beta1.Job, arg1 error) *MockStoreListJobsCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockStoreReadDictCall) Do(f func(context.Context, string) (map[string]string, error)) *MockStoreReadDictCall {
+func (c *MockStoreListJobsCall) Do(f func(context.Context, uuid.UUID) ([]*adminv1beta1.Job, error)) *MockStoreListJobsCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockStoreReadDictCall) DoAndReturn(f func(context.Context, string) (map[string]string, error)) *MockStoreReadDictCall {
+func (c *MockStoreListJobsCall) DoAndReturn(f func(context.Context, uuid.UUID) ([]*adminv1beta1.Job, error)) *MockStoreListJobsCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// ReadPackagesWithCreationTimestamps mocks base method.
-func (m *MockStore) ReadPackagesWithCreationTimestamps(ctx context.Context, packageType adminv1beta1.PackageType) ([]*adminv1beta1.Package, error) {
+// ListPackageJobs mocks base method.
+func (m *MockStore) ListPackageJobs(ctx context.Context, packageType adminv1beta1.PackageType) (map[uuid.UUID][]*adminv1beta1.Job, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ReadPackagesWithCreationTimestamps", ctx, packageType)
-	ret0, _ := ret[0].([]*adminv1beta1.Package)
+	ret := m.ctrl.Call(m, "ListPackageJobs", ctx, packageType)
+	ret0, _ := ret[0].(map[uuid.UUID][]*adminv1beta1.Job)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ReadPackagesWithCreationTimestamps indicates an expected call of ReadPackagesWithCreationTimestamps.
-func (mr *MockStoreMockRecorder) ReadPackagesWithCreationTimestamps(ctx, packageType any) *MockStoreReadPackagesWithCreationTimestampsCall {
+// ListPackageJobs indicates an expected call of ListPackageJobs.
+func (mr *MockStoreMockRecorder) ListPackageJobs(ctx, packageType any) *MockStoreListPackageJobsCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadPackagesWithCreationTimestamps", reflect.TypeOf((*MockStore)(nil).ReadPackagesWithCreationTimestamps), ctx, packageType)
-	return &MockStoreReadPackagesWithCreationTimestampsCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPackageJobs", reflect.TypeOf((*MockStore)(nil).ListPackageJobs), ctx, packageType)
+	return &MockStoreListPackageJobsCall{Call: call}
 }
 
-// MockStoreReadPackagesWithCreationTimestampsCall wrap *gomock.Call
-type MockStoreReadPackagesWithCreationTimestampsCall struct {
+// MockStoreListPackageJobsCall wrap *gomock.Call
+type MockStoreListPackageJobsCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockStoreReadPackagesWithCreationTimestampsCall) Return(arg0 []*adminv1beta1.Package, arg1 error) *MockStoreReadPackagesWithCreationTimestampsCall {
+func (c *MockStoreListPackageJobsCall) Return(arg0 map[uuid.UUID][]*adminv1beta1.Job, arg1 error) *MockStoreListPackageJobsCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockStoreReadPackagesWithCreationTimestampsCall) Do(f func(context.Context, adminv1beta1.PackageType) ([]*adminv1beta1.Package, error)) *MockStoreReadPackagesWithCreationTimestampsCall {
+func (c *MockStoreListPackageJobsCall) Do(f func(context.Context, adminv1beta1.PackageType) (map[uuid.UUID][]*adminv1beta1.Job, error)) *MockStoreListPackageJobsCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockStoreReadPackagesWithCreationTimestampsCall) DoAndReturn(f func(context.Context, adminv1beta1.PackageType) ([]*adminv1beta1.Package, error)) *MockStoreReadPackagesWithCreationTimestampsCall {
+func (c *MockStoreListPackageJobsCall) DoAndReturn(f func(context.Context, adminv1beta1.PackageType) (map[uuid.UUID][]*adminv1beta1.Job, error)) *MockStoreListPackageJobsCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// ReadPipelineID mocks base method.
-func (m *MockStore) ReadPipelineID(ctx context.Context) (uuid.UUID, error) {
+// ListQueueEntries mocks base method.
+func (m *MockStore) ListQueueEntries(ctx context.Context) ([]*store.QueueEntry, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ReadPipelineID", ctx)
-	ret0, _ := ret[0].(uuid.UUID)
+	ret := m.ctrl.Call(m, "ListQueueEntries", ctx)
+	ret0, _ := ret[0].([]*store.QueueEntry)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ReadPipelineID indicates an expected call of ReadPipelineID.
-func (mr *MockStoreMockRecorder) ReadPipelineID(ctx any) *MockStoreReadPipelineIDCall {
+// ListQueueEntries indicates an expected call of ListQueueEntries.
+func (mr *MockStoreMockRecorder) ListQueueEntries(ctx any) *MockStoreListQueueEntriesCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadPipelineID", reflect.TypeOf((*MockStore)(nil).ReadPipelineID), ctx)
-	return &MockStoreReadPipelineIDCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListQueueEntries", reflect.TypeOf((*MockStore)(nil).ListQueueEntries), ctx)
+	return &MockStoreListQueueEntriesCall{Call: call}
 }
 
-// MockStoreReadPipelineIDCall wrap *gomock.Call
-type MockStoreReadPipelineIDCall struct {
+// MockStoreListQueueEntriesCall wrap *gomock.Call
+type MockStoreListQueueEntriesCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockStoreReadPipelineIDCall) Return(arg0 uuid.UUID, arg1 error) *MockStoreReadPipelineIDCall {
+func (c *MockStoreListQueueEntriesCall) Return(arg0 []*store.QueueEntry, arg1 error) *MockStoreListQueueEntriesCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockStoreReadPipelineIDCall) Do(f func(context.Context) (uuid.UUID, error)) *MockStoreReadPipelineIDCall {
+func (c *MockStoreListQueueEntriesCall) Do(f func(context.Context) ([]*store.QueueEntry, error)) *MockStoreListQueueEntriesCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockStoreReadPipelineIDCall) DoAndReturn(f func(context.Context) (uuid.UUID, error)) *MockStoreReadPipelineIDCall {
+func (c *MockStoreListQueueEntriesCall) DoAndReturn(f func(context.Context) ([]*store.QueueEntry, error)) *MockStoreListQueueEntriesCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// ReadSIP mocks base method.
-func (m *MockStore) ReadSIP(ctx context.Context, id uuid.UUID) (store.SIP, error) {
+// Ping mocks base method.
+func (m *MockStore) Ping(ctx context.Context) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ReadSIP", ctx, id)
-	ret0, _ := ret[0].(store.SIP)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "Ping", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// ReadSIP indicates an expected call of ReadSIP.
-func (mr *MockStoreMockRecorder) ReadSIP(ctx, id any) *MockStoreReadSIPCall {
+// Ping indicates an expected call of Ping.
+func (mr *MockStoreMockRecorder) Ping(ctx any) *MockStorePingCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadSIP", reflect.TypeOf((*MockStore)(nil).ReadSIP), ctx, id)
-	return &MockStoreReadSIPCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ping", reflect.TypeOf((*MockStore)(nil).Ping), ctx)
+	return &MockStorePingCall{Call: call}
 }
 
-// MockStoreReadSIPCall wrap *gomock.Call
-type MockStoreReadSIPCall struct {
+// MockStorePingCall wrap *gomock.Call
+type MockStorePingCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockStoreReadSIPCall) Return(sip store.SIP, err error) *MockStoreReadSIPCall {
-	c.Call = c.Call.Return(sip, err)
+func (c *MockStorePingCall) Return(arg0 error) *MockStorePingCall {
+	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockStoreReadSIPCall) Do(f func(context.Context, uuid.UUID) (store.SIP, error)) *MockStoreReadSIPCall {
+func (c *MockStorePingCall) Do(f func(context.Context) error) *MockStorePingCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockStoreReadSIPCall) DoAndReturn(f func(context.Context, uuid.UUID) (store.SIP, error)) *MockStoreReadSIPCall {
+func (c *MockStorePingCall) DoAndReturn(f func(context.Context) error) *MockStorePingCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// ReadTransfer mocks base method.
-func (m *MockStore) ReadTransfer(ctx context.Context, id uuid.UUID) (store.Transfer, error) {
+// ReadDIP mocks base method.
+func (m *MockStore) ReadDIP(ctx context.Context, id uuid.UUID) (store.DIP, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ReadTransfer", ctx, id)
-	ret0, _ := ret[0].(store.Transfer)
+	ret := m.ctrl.Call(m, "ReadDIP", ctx, id)
+	ret0, _ := ret[0].(store.DIP)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ReadTransfer indicates an expected call of ReadTransfer.
-func (mr *MockStoreMockRecorder) ReadTransfer(ctx, id any) *MockStoreReadTransferCall {
+// ReadDIP indicates an expected call of ReadDIP.
+func (mr *MockStoreMockRecorder) ReadDIP(ctx, id any) *MockStoreReadDIPCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadTransfer", reflect.TypeOf((*MockStore)(nil).ReadTransfer), ctx, id)
-	return &MockStoreReadTransferCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadDIP", reflect.TypeOf((*MockStore)(nil).ReadDIP), ctx, id)
+	return &MockStoreReadDIPCall{Call: call}
 }
 
-// MockStoreReadTransferCall wrap *gomock.Call
-type MockStoreReadTransferCall struct {
+// MockStoreReadDIPCall wrap *gomock.Call
+type MockStoreReadDIPCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockStoreReadTransferCall) Return(transfer store.Transfer, err error) *MockStoreReadTransferCall {
-	c.Call = c.Call.Return(transfer, err)
+func (c *MockStoreReadDIPCall) Return(dip store.DIP, err error) *MockStoreReadDIPCall {
+	c.Call = c.Call.Return(dip, err)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockStoreReadTransferCall) Do(f func(context.Context, uuid.UUID) (store.Transfer, error)) *MockStoreReadTransferCall {
+func (c *MockStoreReadDIPCall) Do(f func(context.Context, uuid.UUID) (store.DIP, error)) *MockStoreReadDIPCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockStoreReadTransferCall) DoAndReturn(f func(context.Context, uuid.UUID) (store.Transfer, error)) *MockStoreReadTransferCall {
+func (c *MockStoreReadDIPCall) DoAndReturn(f func(context.Context, uuid.UUID) (store.DIP, error)) *MockStoreReadDIPCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// ReadTransferLocation mocks base method.
-func (m *MockStore) ReadTransferLocation(ctx context.Context, id uuid.UUID) (string, error) {
+// ReadDict mocks base method.
+func (m *MockStore) ReadDict(ctx context.Context, name string) (map[string]string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ReadTransferLocation", ctx, id)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "ReadDict", ctx, name)
+	ret0, _ := ret[0].(map[string]string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ReadTransferLocation indicates an expected call of ReadTransferLocation.
-func (mr *MockStoreMockRecorder) ReadTransferLocation(ctx, id any) *MockStoreReadTransferLocationCall {
+// ReadDict indicates an expected call of ReadDict.
+func (mr *MockStoreMockRecorder) ReadDict(ctx, name any) *MockStoreReadDictCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadTransferLocation", reflect.TypeOf((*MockStore)(nil).ReadTransferLocation), ctx, id)
-	return &MockStoreReadTransferLocationCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadDict", reflect.TypeOf((*MockStore)(nil).ReadDict), ctx, name)
+	return &MockStoreReadDictCall{Call: call}
 }
 
-// MockStoreReadTransferLocationCall wrap *gomock.Call
-type MockStoreReadTransferLocationCall struct {
-	*gomock.Call
+// MockStoreReadDictCall wrap *gomock.Call
+type MockStoreReadDictCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStoreReadDictCall) Return(arg0 map[string]string, arg1 error) *MockStoreReadDictCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStoreReadDictCall) Do(f func(context.Context, string) (map[string]string, error)) *MockStoreReadDictCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStoreReadDictCall) DoAndReturn(f func(context.Context, string) (map[string]string, error)) *MockStoreReadDictCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReadLeaseHolder mocks base method.
+func (m *MockStore) ReadLeaseHolder(ctx context.Context, name string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadLeaseHolder", ctx, name)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadLeaseHolder indicates an expected call of ReadLeaseHolder.
+func (mr *MockStoreMockRecorder) ReadLeaseHolder(ctx, name any) *MockStoreReadLeaseHolderCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadLeaseHolder", reflect.TypeOf((*MockStore)(nil).ReadLeaseHolder), ctx, name)
+	return &MockStoreReadLeaseHolderCall{Call: call}
+}
+
+// MockStoreReadLeaseHolderCall wrap *gomock.Call
+type MockStoreReadLeaseHolderCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStoreReadLeaseHolderCall) Return(arg0 string, arg1 error) *MockStoreReadLeaseHolderCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStoreReadLeaseHolderCall) Do(f func(context.Context, string) (string, error)) *MockStoreReadLeaseHolderCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStoreReadLeaseHolderCall) DoAndReturn(f func(context.Context, string) (string, error)) *MockStoreReadLeaseHolderCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReadPackagesWithCreationTimestamps mocks base method.
+func (m *MockStore) ReadPackagesWithCreationTimestamps(ctx context.Context, packageType adminv1beta1.PackageType) ([]*adminv1beta1.Package, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadPackagesWithCreationTimestamps", ctx, packageType)
+	ret0, _ := ret[0].([]*adminv1beta1.Package)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadPackagesWithCreationTimestamps indicates an expected call of ReadPackagesWithCreationTimestamps.
+func (mr *MockStoreMockRecorder) ReadPackagesWithCreationTimestamps(ctx, packageType any) *MockStoreReadPackagesWithCreationTimestampsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadPackagesWithCreationTimestamps", reflect.TypeOf((*MockStore)(nil).ReadPackagesWithCreationTimestamps), ctx, packageType)
+	return &MockStoreReadPackagesWithCreationTimestampsCall{Call: call}
+}
+
+// MockStoreReadPackagesWithCreationTimestampsCall wrap *gomock.Call
+type MockStoreReadPackagesWithCreationTimestampsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStoreReadPackagesWithCreationTimestampsCall) Return(arg0 []*adminv1beta1.Package, arg1 error) *MockStoreReadPackagesWithCreationTimestampsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStoreReadPackagesWithCreationTimestampsCall) Do(f func(context.Context, adminv1beta1.PackageType) ([]*adminv1beta1.Package, error)) *MockStoreReadPackagesWithCreationTimestampsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStoreReadPackagesWithCreationTimestampsCall) DoAndReturn(f func(context.Context, adminv1beta1.PackageType) ([]*adminv1beta1.Package, error)) *MockStoreReadPackagesWithCreationTimestampsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReadPipelineID mocks base method.
+func (m *MockStore) ReadPipelineID(ctx context.Context) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadPipelineID", ctx)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadPipelineID indicates an expected call of ReadPipelineID.
+func (mr *MockStoreMockRecorder) ReadPipelineID(ctx any) *MockStoreReadPipelineIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadPipelineID", reflect.TypeOf((*MockStore)(nil).ReadPipelineID), ctx)
+	return &MockStoreReadPipelineIDCall{Call: call}
+}
+
+// MockStoreReadPipelineIDCall wrap *gomock.Call
+type MockStoreReadPipelineIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStoreReadPipelineIDCall) Return(arg0 uuid.UUID, arg1 error) *MockStoreReadPipelineIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStoreReadPipelineIDCall) Do(f func(context.Context) (uuid.UUID, error)) *MockStoreReadPipelineIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStoreReadPipelineIDCall) DoAndReturn(f func(context.Context) (uuid.UUID, error)) *MockStoreReadPipelineIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReadSIP mocks base method.
+func (m *MockStore) ReadSIP(ctx context.Context, id uuid.UUID) (store.SIP, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadSIP", ctx, id)
+	ret0, _ := ret[0].(store.SIP)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadSIP indicates an expected call of ReadSIP.
+func (mr *MockStoreMockRecorder) ReadSIP(ctx, id any) *MockStoreReadSIPCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadSIP", reflect.TypeOf((*MockStore)(nil).ReadSIP), ctx, id)
+	return &MockStoreReadSIPCall{Call: call}
+}
+
+// MockStoreReadSIPCall wrap *gomock.Call
+type MockStoreReadSIPCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStoreReadSIPCall) Return(sip store.SIP, err error) *MockStoreReadSIPCall {
+	c.Call = c.Call.Return(sip, err)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStoreReadSIPCall) Do(f func(context.Context, uuid.UUID) (store.SIP, error)) *MockStoreReadSIPCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStoreReadSIPCall) DoAndReturn(f func(context.Context, uuid.UUID) (store.SIP, error)) *MockStoreReadSIPCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReadTransfer mocks base method.
+func (m *MockStore) ReadTransfer(ctx context.Context, id uuid.UUID) (store.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadTransfer", ctx, id)
+	ret0, _ := ret[0].(store.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadTransfer indicates an expected call of ReadTransfer.
+func (mr *MockStoreMockRecorder) ReadTransfer(ctx, id any) *MockStoreReadTransferCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadTransfer", reflect.TypeOf((*MockStore)(nil).ReadTransfer), ctx, id)
+	return &MockStoreReadTransferCall{Call: call}
+}
+
+// MockStoreReadTransferCall wrap *gomock.Call
+type MockStoreReadTransferCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStoreReadTransferCall) Return(transfer store.Transfer, err error) *MockStoreReadTransferCall {
+	c.Call = c.Call.Return(transfer, err)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStoreReadTransferCall) Do(f func(context.Context, uuid.UUID) (store.Transfer, error)) *MockStoreReadTransferCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStoreReadTransferCall) DoAndReturn(f func(context.Context, uuid.UUID) (store.Transfer, error)) *MockStoreReadTransferCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReadTransferLocation mocks base method.
+func (m *MockStore) ReadTransferLocation(ctx context.Context, id uuid.UUID) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadTransferLocation", ctx, id)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadTransferLocation indicates an expected call of ReadTransferLocation.
+func (mr *MockStoreMockRecorder) ReadTransferLocation(ctx, id any) *MockStoreReadTransferLocationCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadTransferLocation", reflect.TypeOf((*MockStore)(nil).ReadTransferLocation), ctx, id)
+	return &MockStoreReadTransferLocationCall{Call: call}
+}
+
+// MockStoreReadTransferLocationCall wrap *gomock.Call
+type MockStoreReadTransferLocationCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStoreReadTransferLocationCall) Return(loc string, err error) *MockStoreReadTransferLocationCall {
+	c.Call = c.Call.Return(loc, err)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStoreReadTransferLocationCall) Do(f func(context.Context, uuid.UUID) (string, error)) *MockStoreReadTransferLocationCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStoreReadTransferLocationCall) DoAndReturn(f func(context.Context, uuid.UUID) (string, error)) *MockStoreReadTransferLocationCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReadTransferWithIdempotencyKey mocks base method.
+func (m *MockStore) ReadTransferWithIdempotencyKey(ctx context.Context, key string) (uuid.UUID, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadTransferWithIdempotencyKey", ctx, key)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ReadTransferWithIdempotencyKey indicates an expected call of ReadTransferWithIdempotencyKey.
+func (mr *MockStoreMockRecorder) ReadTransferWithIdempotencyKey(ctx, key any) *MockStoreReadTransferWithIdempotencyKeyCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadTransferWithIdempotencyKey", reflect.TypeOf((*MockStore)(nil).ReadTransferWithIdempotencyKey), ctx, key)
+	return &MockStoreReadTransferWithIdempotencyKeyCall{Call: call}
+}
+
+// MockStoreReadTransferWithIdempotencyKeyCall wrap *gomock.Call
+type MockStoreReadTransferWithIdempotencyKeyCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStoreReadTransferWithIdempotencyKeyCall) Return(id uuid.UUID, digest string, err error) *MockStoreReadTransferWithIdempotencyKeyCall {
+	c.Call = c.Call.Return(id, digest, err)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStoreReadTransferWithIdempotencyKeyCall) Do(f func(context.Context, string) (uuid.UUID, string, error)) *MockStoreReadTransferWithIdempotencyKeyCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStoreReadTransferWithIdempotencyKeyCall) DoAndReturn(f func(context.Context, string) (uuid.UUID, string, error)) *MockStoreReadTransferWithIdempotencyKeyCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReadUnitLinkID mocks base method.
+func (m *MockStore) ReadUnitLinkID(ctx context.Context, id uuid.UUID, packageType enums.PackageType, name string) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadUnitLinkID", ctx, id, packageType, name)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadUnitLinkID indicates an expected call of ReadUnitLinkID.
+func (mr *MockStoreMockRecorder) ReadUnitLinkID(ctx, id, packageType, name any) *MockStoreReadUnitLinkIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadUnitLinkID", reflect.TypeOf((*MockStore)(nil).ReadUnitLinkID), ctx, id, packageType, name)
+	return &MockStoreReadUnitLinkIDCall{Call: call}
+}
+
+// MockStoreReadUnitLinkIDCall wrap *gomock.Call
+type MockStoreReadUnitLinkIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStoreReadUnitLinkIDCall) Return(arg0 uuid.UUID, arg1 error) *MockStoreReadUnitLinkIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStoreReadUnitLinkIDCall) Do(f func(context.Context, uuid.UUID, enums.PackageType, string) (uuid.UUID, error)) *MockStoreReadUnitLinkIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStoreReadUnitLinkIDCall) DoAndReturn(f func(context.Context, uuid.UUID, enums.PackageType, string) (uuid.UUID, error)) *MockStoreReadUnitLinkIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReadUnitVar mocks base method.
+func (m *MockStore) ReadUnitVar(ctx context.Context, id uuid.UUID, packageType enums.PackageType, name string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadUnitVar", ctx, id, packageType, name)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadUnitVar indicates an expected call of ReadUnitVar.
+func (mr *MockStoreMockRecorder) ReadUnitVar(ctx, id, packageType, name any) *MockStoreReadUnitVarCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadUnitVar", reflect.TypeOf((*MockStore)(nil).ReadUnitVar), ctx, id, packageType, name)
+	return &MockStoreReadUnitVarCall{Call: call}
+}
+
+// MockStoreReadUnitVarCall wrap *gomock.Call
+type MockStoreReadUnitVarCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStoreReadUnitVarCall) Return(arg0 string, arg1 error) *MockStoreReadUnitVarCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStoreReadUnitVarCall) Do(f func(context.Context, uuid.UUID, enums.PackageType, string) (string, error)) *MockStoreReadUnitVarCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStoreReadUnitVarCall) DoAndReturn(f func(context.Context, uuid.UUID, enums.PackageType, string) (string, error)) *MockStoreReadUnitVarCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReadUnitVars mocks base method.
+func (m *MockStore) ReadUnitVars(ctx context.Context, id uuid.UUID, packageType enums.PackageType, name string) ([]store.UnitVar, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadUnitVars", ctx, id, packageType, name)
+	ret0, _ := ret[0].([]store.UnitVar)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadUnitVars indicates an expected call of ReadUnitVars.
+func (mr *MockStoreMockRecorder) ReadUnitVars(ctx, id, packageType, name any) *MockStoreReadUnitVarsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadUnitVars", reflect.TypeOf((*MockStore)(nil).ReadUnitVars), ctx, id, packageType, name)
+	return &MockStoreReadUnitVarsCall{Call: call}
+}
+
+// MockStoreReadUnitVarsCall wrap *gomock.Call
+type MockStoreReadUnitVarsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStoreReadUnitVarsCall) Return(arg0 []store.UnitVar, arg1 error) *MockStoreReadUnitVarsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStoreReadUnitVarsCall) Do(f func(context.Context, uuid.UUID, enums.PackageType, string) ([]store.UnitVar, error)) *MockStoreReadUnitVarsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStoreReadUnitVarsCall) DoAndReturn(f func(context.Context, uuid.UUID, enums.PackageType, string) ([]store.UnitVar, error)) *MockStoreReadUnitVarsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReadUserAPIKey mocks base method.
+func (m *MockStore) ReadUserAPIKey(ctx context.Context, userID int) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadUserAPIKey", ctx, userID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadUserAPIKey indicates an expected call of ReadUserAPIKey.
+func (mr *MockStoreMockRecorder) ReadUserAPIKey(ctx, userID any) *MockStoreReadUserAPIKeyCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadUserAPIKey", reflect.TypeOf((*MockStore)(nil).ReadUserAPIKey), ctx, userID)
+	return &MockStoreReadUserAPIKeyCall{Call: call}
+}
+
+// MockStoreReadUserAPIKeyCall wrap *gomock.Call
+type MockStoreReadUserAPIKeyCall struct {
+	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockStoreReadTransferLocationCall) Return(loc string, err error) *MockStoreReadTransferLocationCall {
-	c.Call = c.Call.Return(loc, err)
+func (c *MockStoreReadUserAPIKeyCall) Return(arg0 string, arg1 error) *MockStoreReadUserAPIKeyCall {
+	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockStoreReadTransferLocationCall) Do(f func(context.Context, uuid.UUID) (string, error)) *MockStoreReadTransferLocationCall {
+func (c *MockStoreReadUserAPIKeyCall) Do(f func(context.Context, int) (string, error)) *MockStoreReadUserAPIKeyCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockStoreReadTransferLocationCall) DoAndReturn(f func(context.Context, uuid.UUID) (string, error)) *MockStoreReadTransferLocationCall {
+func (c *MockStoreReadUserAPIKeyCall) DoAndReturn(f func(context.Context, int) (string, error)) *MockStoreReadUserAPIKeyCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// ReadUnitLinkID mocks base method.
-func (m *MockStore) ReadUnitLinkID(ctx context.Context, id uuid.UUID, packageType enums.PackageType, name string) (uuid.UUID, error) {
+// ReadUserGroups mocks base method.
+func (m *MockStore) ReadUserGroups(ctx context.Context, userID int) ([]string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ReadUnitLinkID", ctx, id, packageType, name)
-	ret0, _ := ret[0].(uuid.UUID)
+	ret := m.ctrl.Call(m, "ReadUserGroups", ctx, userID)
+	ret0, _ := ret[0].([]string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ReadUnitLinkID indicates an expected call of ReadUnitLinkID.
-func (mr *MockStoreMockRecorder) ReadUnitLinkID(ctx, id, packageType, name any) *MockStoreReadUnitLinkIDCall {
+// ReadUserGroups indicates an expected call of ReadUserGroups.
+func (mr *MockStoreMockRecorder) ReadUserGroups(ctx, userID any) *MockStoreReadUserGroupsCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadUnitLinkID", reflect.TypeOf((*MockStore)(nil).ReadUnitLinkID), ctx, id, packageType, name)
-	return &MockStoreReadUnitLinkIDCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadUserGroups", reflect.TypeOf((*MockStore)(nil).ReadUserGroups), ctx, userID)
+	return &MockStoreReadUserGroupsCall{Call: call}
 }
 
-// MockStoreReadUnitLinkIDCall wrap *gomock.Call
-type MockStoreReadUnitLinkIDCall struct {
+// MockStoreReadUserGroupsCall wrap *gomock.Call
+type MockStoreReadUserGroupsCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockStoreReadUnitLinkIDCall) Return(arg0 uuid.UUID, arg1 error) *MockStoreReadUnitLinkIDCall {
+func (c *MockStoreReadUserGroupsCall) Return(arg0 []string, arg1 error) *MockStoreReadUserGroupsCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockStoreReadUnitLinkIDCall) Do(f func(context.Context, uuid.UUID, enums.PackageType, string) (uuid.UUID, error)) *MockStoreReadUnitLinkIDCall {
+func (c *MockStoreReadUserGroupsCall) Do(f func(context.Context, int) ([]string, error)) *MockStoreReadUserGroupsCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockStoreReadUnitLinkIDCall) DoAndReturn(f func(context.Context, uuid.UUID, enums.PackageType, string) (uuid.UUID, error)) *MockStoreReadUnitLinkIDCall {
+func (c *MockStoreReadUserGroupsCall) DoAndReturn(f func(context.Context, int) ([]string, error)) *MockStoreReadUserGroupsCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// ReadUnitVar mocks base method.
-func (m *MockStore) ReadUnitVar(ctx context.Context, id uuid.UUID, packageType enums.PackageType, name string) (string, error) {
+// ReadUserWithUsername mocks base method.
+func (m *MockStore) ReadUserWithUsername(ctx context.Context, username string) (*store.User, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ReadUnitVar", ctx, id, packageType, name)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "ReadUserWithUsername", ctx, username)
+	ret0, _ := ret[0].(*store.User)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ReadUnitVar indicates an expected call of ReadUnitVar.
-func (mr *MockStoreMockRecorder) ReadUnitVar(ctx, id, packageType, name any) *MockStoreReadUnitVarCall {
+// ReadUserWithUsername indicates an expected call of ReadUserWithUsername.
+func (mr *MockStoreMockRecorder) ReadUserWithUsername(ctx, username any) *MockStoreReadUserWithUsernameCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadUnitVar", reflect.TypeOf((*MockStore)(nil).ReadUnitVar), ctx, id, packageType, name)
-	return &MockStoreReadUnitVarCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadUserWithUsername", reflect.TypeOf((*MockStore)(nil).ReadUserWithUsername), ctx, username)
+	return &MockStoreReadUserWithUsernameCall{Call: call}
 }
 
-// MockStoreReadUnitVarCall wrap *gomock.Call
-type MockStoreReadUnitVarCall struct {
+// MockStoreReadUserWithUsernameCall wrap *gomock.Call
+type MockStoreReadUserWithUsernameCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockStoreReadUnitVarCall) Return(arg0 string, arg1 error) *MockStoreReadUnitVarCall {
+func (c *MockStoreReadUserWithUsernameCall) Return(arg0 *store.User, arg1 error) *MockStoreReadUserWithUsernameCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockStoreReadUnitVarCall) Do(f func(context.Context, uuid.UUID, enums.PackageType, string) (string, error)) *MockStoreReadUnitVarCall {
+func (c *MockStoreReadUserWithUsernameCall) Do(f func(context.Context, string) (*store.User, error)) *MockStoreReadUserWithUsernameCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockStoreReadUnitVarCall) DoAndReturn(f func(context.Context, uuid.UUID, enums.PackageType, string) (string, error)) *MockStoreReadUnitVarCall {
+func (c *MockStoreReadUserWithUsernameCall) DoAndReturn(f func(context.Context, string) (*store.User, error)) *MockStoreReadUserWithUsernameCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// ReadUnitVars mocks base method.
-func (m *MockStore) ReadUnitVars(ctx context.Context, id uuid.UUID, packageType enums.PackageType, name string) ([]store.UnitVar, error) {
+// ReleaseLease mocks base method.
+func (m *MockStore) ReleaseLease(ctx context.Context, name, holder string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ReadUnitVars", ctx, id, packageType, name)
-	ret0, _ := ret[0].([]store.UnitVar)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "ReleaseLease", ctx, name, holder)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// ReadUnitVars indicates an expected call of ReadUnitVars.
-func (mr *MockStoreMockRecorder) ReadUnitVars(ctx, id, packageType, name any) *MockStoreReadUnitVarsCall {
+// ReleaseLease indicates an expected call of ReleaseLease.
+func (mr *MockStoreMockRecorder) ReleaseLease(ctx, name, holder any) *MockStoreReleaseLeaseCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadUnitVars", reflect.TypeOf((*MockStore)(nil).ReadUnitVars), ctx, id, packageType, name)
-	return &MockStoreReadUnitVarsCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseLease", reflect.TypeOf((*MockStore)(nil).ReleaseLease), ctx, name, holder)
+	return &MockStoreReleaseLeaseCall{Call: call}
 }
 
-// MockStoreReadUnitVarsCall wrap *gomock.Call
-type MockStoreReadUnitVarsCall struct {
+// MockStoreReleaseLeaseCall wrap *gomock.Call
+type MockStoreReleaseLeaseCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockStoreReadUnitVarsCall) Return(arg0 []store.UnitVar, arg1 error) *MockStoreReadUnitVarsCall {
-	c.Call = c.Call.Return(arg0, arg1)
+func (c *MockStoreReleaseLeaseCall) Return(arg0 error) *MockStoreReleaseLeaseCall {
+	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockStoreReadUnitVarsCall) Do(f func(context.Context, uuid.UUID, enums.PackageType, string) ([]store.UnitVar, error)) *MockStoreReadUnitVarsCall {
+func (c *MockStoreReleaseLeaseCall) Do(f func(context.Context, string, string) error) *MockStoreReleaseLeaseCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockStoreReadUnitVarsCall) DoAndReturn(f func(context.Context, uuid.UUID, enums.PackageType, string) ([]store.UnitVar, error)) *MockStoreReadUnitVarsCall {
+func (c *MockStoreReleaseLeaseCall) DoAndReturn(f func(context.Context, string, string) error) *MockStoreReleaseLeaseCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -900,6 +1482,44 @@ func (c *MockStoreRemoveTransientDataCall) DoAndReturn(f func(context.Context) e
 	return c
 }
 
+// RunInTx mocks base method.
+func (m *MockStore) RunInTx(ctx context.Context, fn func(store.Tx) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunInTx", ctx, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RunInTx indicates an expected call of RunInTx.
+func (mr *MockStoreMockRecorder) RunInTx(ctx, fn any) *MockStoreRunInTxCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunInTx", reflect.TypeOf((*MockStore)(nil).RunInTx), ctx, fn)
+	return &MockStoreRunInTxCall{Call: call}
+}
+
+// MockStoreRunInTxCall wrap *gomock.Call
+type MockStoreRunInTxCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStoreRunInTxCall) Return(arg0 error) *MockStoreRunInTxCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStoreRunInTxCall) Do(f func(context.Context, func(store.Tx) error) error) *MockStoreRunInTxCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStoreRunInTxCall) DoAndReturn(f func(context.Context, func(store.Tx) error) error) *MockStoreRunInTxCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Running mocks base method.
 func (m *MockStore) Running() bool {
 	m.ctrl.T.Helper()
@@ -1014,6 +1634,82 @@ func (c *MockStoreUpdatePackageStatusCall) DoAndReturn(f func(context.Context, u
 	return c
 }
 
+// UpdateQueueEntryTag mocks base method.
+func (m *MockStore) UpdateQueueEntryTag(ctx context.Context, pkgID uuid.UUID, tag float64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateQueueEntryTag", ctx, pkgID, tag)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateQueueEntryTag indicates an expected call of UpdateQueueEntryTag.
+func (mr *MockStoreMockRecorder) UpdateQueueEntryTag(ctx, pkgID, tag any) *MockStoreUpdateQueueEntryTagCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateQueueEntryTag", reflect.TypeOf((*MockStore)(nil).UpdateQueueEntryTag), ctx, pkgID, tag)
+	return &MockStoreUpdateQueueEntryTagCall{Call: call}
+}
+
+// MockStoreUpdateQueueEntryTagCall wrap *gomock.Call
+type MockStoreUpdateQueueEntryTagCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStoreUpdateQueueEntryTagCall) Return(arg0 error) *MockStoreUpdateQueueEntryTagCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStoreUpdateQueueEntryTagCall) Do(f func(context.Context, uuid.UUID, float64) error) *MockStoreUpdateQueueEntryTagCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStoreUpdateQueueEntryTagCall) DoAndReturn(f func(context.Context, uuid.UUID, float64) error) *MockStoreUpdateQueueEntryTagCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpdateTasks mocks base method.
+func (m *MockStore) UpdateTasks(ctx context.Context, results []*store.TaskResult) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTasks", ctx, results)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTasks indicates an expected call of UpdateTasks.
+func (mr *MockStoreMockRecorder) UpdateTasks(ctx, results any) *MockStoreUpdateTasksCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTasks", reflect.TypeOf((*MockStore)(nil).UpdateTasks), ctx, results)
+	return &MockStoreUpdateTasksCall{Call: call}
+}
+
+// MockStoreUpdateTasksCall wrap *gomock.Call
+type MockStoreUpdateTasksCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStoreUpdateTasksCall) Return(arg0 error) *MockStoreUpdateTasksCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStoreUpdateTasksCall) Do(f func(context.Context, []*store.TaskResult) error) *MockStoreUpdateTasksCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStoreUpdateTasksCall) DoAndReturn(f func(context.Context, []*store.TaskResult) error) *MockStoreUpdateTasksCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // UpdateTransferLocation mocks base method.
 func (m *MockStore) UpdateTransferLocation(ctx context.Context, id uuid.UUID, path string) error {
 	m.ctrl.T.Helper()
@@ -1207,3 +1903,180 @@ func (c *MockStoreValidateUserAPIKeyCall) DoAndReturn(f func(context.Context, st
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// ValidateUserPassword mocks base method.
+func (m *MockStore) ValidateUserPassword(ctx context.Context, username, password string) (*store.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateUserPassword", ctx, username, password)
+	ret0, _ := ret[0].(*store.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ValidateUserPassword indicates an expected call of ValidateUserPassword.
+func (mr *MockStoreMockRecorder) ValidateUserPassword(ctx, username, password any) *MockStoreValidateUserPasswordCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateUserPassword", reflect.TypeOf((*MockStore)(nil).ValidateUserPassword), ctx, username, password)
+	return &MockStoreValidateUserPasswordCall{Call: call}
+}
+
+// MockStoreValidateUserPasswordCall wrap *gomock.Call
+type MockStoreValidateUserPasswordCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStoreValidateUserPasswordCall) Return(arg0 *store.User, arg1 error) *MockStoreValidateUserPasswordCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStoreValidateUserPasswordCall) Do(f func(context.Context, string, string) (*store.User, error)) *MockStoreValidateUserPasswordCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStoreValidateUserPasswordCall) DoAndReturn(f func(context.Context, string, string) (*store.User, error)) *MockStoreValidateUserPasswordCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockTx is a mock of Tx interface.
+type MockTx struct {
+	ctrl     *gomock.Controller
+	recorder *MockTxMockRecorder
+	isgomock struct{}
+}
+
+// MockTxMockRecorder is the mock recorder for MockTx.
+type MockTxMockRecorder struct {
+	mock *MockTx
+}
+
+// NewMockTx creates a new mock instance.
+func NewMockTx(ctrl *gomock.Controller) *MockTx {
+	mock := &MockTx{ctrl: ctrl}
+	mock.recorder = &MockTxMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTx) EXPECT() *MockTxMockRecorder {
+	return m.recorder
+}
+
+// CreateUnitVar mocks base method.
+func (m *MockTx) CreateUnitVar(ctx context.Context, id uuid.UUID, packageType enums.PackageType, name, value string, linkID uuid.UUID, update bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUnitVar", ctx, id, packageType, name, value, linkID, update)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateUnitVar indicates an expected call of CreateUnitVar.
+func (mr *MockTxMockRecorder) CreateUnitVar(ctx, id, packageType, name, value, linkID, update any) *MockTxCreateUnitVarCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUnitVar", reflect.TypeOf((*MockTx)(nil).CreateUnitVar), ctx, id, packageType, name, value, linkID, update)
+	return &MockTxCreateUnitVarCall{Call: call}
+}
+
+// MockTxCreateUnitVarCall wrap *gomock.Call
+type MockTxCreateUnitVarCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTxCreateUnitVarCall) Return(arg0 error) *MockTxCreateUnitVarCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTxCreateUnitVarCall) Do(f func(context.Context, uuid.UUID, enums.PackageType, string, string, uuid.UUID, bool) error) *MockTxCreateUnitVarCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTxCreateUnitVarCall) DoAndReturn(f func(context.Context, uuid.UUID, enums.PackageType, string, string, uuid.UUID, bool) error) *MockTxCreateUnitVarCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpdateJobStatus mocks base method.
+func (m *MockTx) UpdateJobStatus(ctx context.Context, id uuid.UUID, status string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateJobStatus", ctx, id, status)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateJobStatus indicates an expected call of UpdateJobStatus.
+func (mr *MockTxMockRecorder) UpdateJobStatus(ctx, id, status any) *MockTxUpdateJobStatusCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateJobStatus", reflect.TypeOf((*MockTx)(nil).UpdateJobStatus), ctx, id, status)
+	return &MockTxUpdateJobStatusCall{Call: call}
+}
+
+// MockTxUpdateJobStatusCall wrap *gomock.Call
+type MockTxUpdateJobStatusCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTxUpdateJobStatusCall) Return(arg0 error) *MockTxUpdateJobStatusCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTxUpdateJobStatusCall) Do(f func(context.Context, uuid.UUID, string) error) *MockTxUpdateJobStatusCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTxUpdateJobStatusCall) DoAndReturn(f func(context.Context, uuid.UUID, string) error) *MockTxUpdateJobStatusCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpdatePackageStatus mocks base method.
+func (m *MockTx) UpdatePackageStatus(ctx context.Context, id uuid.UUID, packageType enums.PackageType, status enums.PackageStatus) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePackageStatus", ctx, id, packageType, status)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePackageStatus indicates an expected call of UpdatePackageStatus.
+func (mr *MockTxMockRecorder) UpdatePackageStatus(ctx, id, packageType, status any) *MockTxUpdatePackageStatusCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePackageStatus", reflect.TypeOf((*MockTx)(nil).UpdatePackageStatus), ctx, id, packageType, status)
+	return &MockTxUpdatePackageStatusCall{Call: call}
+}
+
+// MockTxUpdatePackageStatusCall wrap *gomock.Call
+type MockTxUpdatePackageStatusCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTxUpdatePackageStatusCall) Return(arg0 error) *MockTxUpdatePackageStatusCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTxUpdatePackageStatusCall) Do(f func(context.Context, uuid.UUID, enums.PackageType, enums.PackageStatus) error) *MockTxUpdatePackageStatusCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTxUpdatePackageStatusCall) DoAndReturn(f func(context.Context, uuid.UUID, enums.PackageType, enums.PackageStatus) error) *MockTxUpdatePackageStatusCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
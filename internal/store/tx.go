@@ -0,0 +1,114 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"math/rand/v2"
+	"time"
+
+	"github.com/go-logr/logr"
+	mysqldriver "github.com/go-sql-driver/mysql"
+	"github.com/jackc/pgx/v5/pgconn"
+	"modernc.org/sqlite"
+	sqlite3 "modernc.org/sqlite/lib"
+)
+
+const (
+	// txMaxAttempts is the number of times a transaction is attempted before
+	// its transient error is returned.
+	txMaxAttempts = 5
+
+	// txBackoff is the wait before the second attempt, it doubles after each
+	// attempt.
+	txBackoff = 10 * time.Millisecond
+)
+
+// runInTx runs fn in a transaction of db, see Store.RunInTx. The errors
+// returned by fn are not wrapped so the caller can inspect them.
+func runInTx(ctx context.Context, logger logr.Logger, db *sql.DB, fn func(tx *sql.Tx) error) error {
+	return retryTx(ctx, logger, func() (err error) {
+		tx, err := db.BeginTx(ctx, &sql.TxOptions{})
+		if err != nil {
+			wrap(&err, "RunInTx: begin")
+			return err
+		}
+		defer func() { _ = tx.Rollback() }()
+
+		if err := fn(tx); err != nil {
+			return err
+		}
+
+		if err := tx.Commit(); err != nil {
+			wrap(&err, "RunInTx: commit")
+			return err
+		}
+
+		return nil
+	})
+}
+
+// retryTx runs fn, which runs a transaction, until it succeeds or fails with
+// an error that is not transient. It gives up after txMaxAttempts or when ctx
+// is canceled. The wait between attempts has some jitter so the transactions
+// involved in a deadlock are not retried in lockstep.
+func retryTx(ctx context.Context, logger logr.Logger, fn func() error) error {
+	backoff := txBackoff
+	for attempt := 1; ; attempt++ {
+		err := fn()
+		if err == nil || attempt == txMaxAttempts || !isTransient(err) {
+			return err
+		}
+
+		wait := backoff + rand.N(backoff) //nolint:gosec
+		logger.V(1).Info("Retrying transaction.", "attempt", attempt, "wait", wait, "err", err)
+
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(wait):
+		}
+		backoff *= 2
+	}
+}
+
+// isTransient reports whether err is a database error after which the
+// transaction can be retried, e.g. it was chosen as the victim of a deadlock.
+// The errors are preserved by wrap so they can be recognized.
+func isTransient(err error) bool {
+	if errors.Is(err, driver.ErrBadConn) || errors.Is(err, mysqldriver.ErrInvalidConn) {
+		return true
+	}
+
+	var myErr *mysqldriver.MySQLError
+	if errors.As(err, &myErr) {
+		switch myErr.Number {
+		case 1205, // ER_LOCK_WAIT_TIMEOUT
+			1213: // ER_LOCK_DEADLOCK
+			return true
+		}
+		return false
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.Code {
+		case "40001", // serialization_failure
+			"40P01": // deadlock_detected
+			return true
+		}
+		return false
+	}
+
+	var sqliteErr *sqlite.Error
+	if errors.As(err, &sqliteErr) {
+		switch sqliteErr.Code() & 0xff { // Primary result code.
+		case sqlite3.SQLITE_BUSY, sqlite3.SQLITE_LOCKED:
+			return true
+		}
+		return false
+	}
+
+	return false
+}
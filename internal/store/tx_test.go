@@ -0,0 +1,97 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/go-logr/logr"
+	mysqldriver "github.com/go-sql-driver/mysql"
+	"github.com/jackc/pgx/v5/pgconn"
+	"gotest.tools/v3/assert"
+)
+
+func TestRetryTx(t *testing.T) {
+	t.Parallel()
+
+	deadlock := &mysqldriver.MySQLError{Number: 1213, Message: "Deadlock found when trying to get lock"}
+
+	t.Run("Retries transient errors", func(t *testing.T) {
+		t.Parallel()
+
+		var attempts int
+		err := retryTx(context.Background(), logr.Discard(), func() error {
+			attempts++
+			if attempts < 3 {
+				var err error = deadlock
+				wrap(&err, "UpdateJobStatus")
+				return err
+			}
+			return nil
+		})
+		assert.NilError(t, err)
+		assert.Equal(t, attempts, 3)
+	})
+
+	t.Run("Gives up after the maximum attempts", func(t *testing.T) {
+		t.Parallel()
+
+		var attempts int
+		err := retryTx(context.Background(), logr.Discard(), func() error {
+			attempts++
+			return deadlock
+		})
+		assert.ErrorIs(t, err, deadlock)
+		assert.Equal(t, attempts, txMaxAttempts)
+	})
+
+	t.Run("Does not retry other errors", func(t *testing.T) {
+		t.Parallel()
+
+		var attempts int
+		err := retryTx(context.Background(), logr.Discard(), func() error {
+			attempts++
+			return &mysqldriver.MySQLError{Number: 1062, Message: "Duplicate entry"}
+		})
+		assert.ErrorContains(t, err, "Duplicate entry")
+		assert.Equal(t, attempts, 1)
+	})
+
+	t.Run("Stops when the context is canceled", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		var attempts int
+		err := retryTx(ctx, logr.Discard(), func() error {
+			attempts++
+			return deadlock
+		})
+		assert.ErrorIs(t, err, deadlock)
+		assert.Equal(t, attempts, 1)
+	})
+}
+
+func TestIsTransient(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		err  error
+		want bool
+	}{
+		{err: &mysqldriver.MySQLError{Number: 1213}, want: true},
+		{err: &mysqldriver.MySQLError{Number: 1205}, want: true},
+		{err: &mysqldriver.MySQLError{Number: 1146}, want: false},
+		{err: mysqldriver.ErrInvalidConn, want: true},
+		{err: &pgconn.PgError{Code: "40P01"}, want: true},
+		{err: &pgconn.PgError{Code: "40001"}, want: true},
+		{err: &pgconn.PgError{Code: "23505"}, want: false},
+		{err: fmt.Errorf("update: %w", &mysqldriver.MySQLError{Number: 1213}), want: true},
+		{err: ErrNotFound, want: false},
+		{err: errors.New("deadlock"), want: false},
+	} {
+		assert.Equal(t, isTransient(tc.err), tc.want, "%v", tc.err)
+	}
+}
@@ -0,0 +1,174 @@
+package tlsutil
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/go-logr/logr"
+	"github.com/gohugoio/hugo/watcher"
+)
+
+// reloader keeps the key pair and the CA bundle loaded from files, reloading
+// them when the files change. A failed reload is logged and the previous
+// values are kept.
+type reloader struct {
+	logger   logr.Logger
+	certFile string
+	keyFile  string
+	caFile   string
+
+	mu   sync.RWMutex
+	cert *tls.Certificate
+	pool *x509.CertPool
+
+	watcher   *watcher.Batcher
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+func newReloader(logger logr.Logger, certFile, keyFile, caFile string) (*reloader, error) {
+	r := &reloader{
+		logger:   logger,
+		certFile: certFile,
+		keyFile:  keyFile,
+		caFile:   caFile,
+		done:     make(chan struct{}),
+	}
+
+	if err := r.load(); err != nil {
+		return nil, err
+	}
+
+	if err := r.watch(); err != nil {
+		return nil, fmt.Errorf("watch: %v", err)
+	}
+
+	return r, nil
+}
+
+func (r *reloader) files() []string {
+	files := []string{}
+	for _, f := range []string{r.certFile, r.keyFile, r.caFile} {
+		if f != "" {
+			files = append(files, filepath.Clean(f))
+		}
+	}
+	return files
+}
+
+func (r *reloader) load() error {
+	var (
+		cert *tls.Certificate
+		pool *x509.CertPool
+	)
+
+	if r.certFile != "" {
+		c, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+		if err != nil {
+			return fmt.Errorf("load key pair: %v", err)
+		}
+		cert = &c
+	}
+
+	if r.caFile != "" {
+		blob, err := os.ReadFile(r.caFile)
+		if err != nil {
+			return fmt.Errorf("load CA bundle: %v", err)
+		}
+		pool = x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(blob) {
+			return fmt.Errorf("load CA bundle: no certificates found in %q", r.caFile)
+		}
+	}
+
+	r.mu.Lock()
+	r.cert, r.pool = cert, pool
+	r.mu.Unlock()
+
+	return nil
+}
+
+func (r *reloader) current() (*tls.Certificate, *x509.CertPool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.cert, r.pool
+}
+
+// watch reloads the files after changes are seen in their directories. The
+// directories are watched instead of the files because files are often
+// replaced rather than modified, e.g. Kubernetes updates mounted secrets by
+// swapping a symlink.
+func (r *reloader) watch() error {
+	w, err := watcher.New(500*time.Millisecond, 700*time.Millisecond, false)
+	if err != nil {
+		return err
+	}
+
+	dirs := []string{}
+	for _, f := range r.files() {
+		if dir := filepath.Dir(f); !slices.Contains(dirs, dir) {
+			dirs = append(dirs, dir)
+		}
+	}
+	for _, dir := range dirs {
+		if err := w.Add(dir); err != nil {
+			w.Close()
+			return err
+		}
+	}
+	r.watcher = w
+
+	go func() {
+		for {
+			select {
+			case evs := <-w.Events:
+				if r.relevant(evs) {
+					r.reload()
+				}
+			case err := <-w.Errors():
+				if err != nil {
+					r.logger.V(1).Info("Error while watching.", "err", err)
+				}
+			case <-r.done:
+				return
+			}
+		}
+	}()
+
+	return nil
+}
+
+func (r *reloader) relevant(evs []fsnotify.Event) bool {
+	files := r.files()
+	for _, ev := range evs {
+		name := filepath.Clean(ev.Name)
+		if slices.Contains(files, name) || filepath.Base(name) == "..data" {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *reloader) reload() {
+	if err := r.load(); err != nil {
+		r.logger.Error(err, "Failed to reload TLS files, previous files are still in use.", "files", r.files())
+		return
+	}
+	r.logger.Info("Reloaded TLS files.", "files", r.files())
+}
+
+func (r *reloader) Close() error {
+	r.closeOnce.Do(func() {
+		close(r.done)
+		r.watcher.Close()
+	})
+	return nil
+}
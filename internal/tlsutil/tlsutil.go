@@ -0,0 +1,179 @@
+// Package tlsutil provides TLS configurations for the listeners and clients
+// of the server. Certificates, keys and CA bundles are reloaded when their
+// files change so they can be rotated without a restart.
+package tlsutil
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+
+	"github.com/go-logr/logr"
+)
+
+// Config configures TLS for a listener.
+type Config struct {
+	// CertFile is the path to the PEM-encoded certificate (chain).
+	CertFile string
+
+	// KeyFile is the path to the PEM-encoded private key.
+	KeyFile string
+
+	// ClientCAFile is the path to the PEM-encoded CA bundle used to verify
+	// client certificates. When set, clients must present a valid certificate
+	// (mTLS).
+	ClientCAFile string
+}
+
+// Enabled reports whether TLS is configured.
+func (c Config) Enabled() bool {
+	return c.CertFile != "" || c.KeyFile != ""
+}
+
+func (c Config) validate() error {
+	if c.CertFile == "" || c.KeyFile == "" {
+		return errors.New("both certificate and key files are required")
+	}
+	return nil
+}
+
+// ClientConfig configures TLS for a client.
+type ClientConfig struct {
+	// Enabled reports whether the client should use TLS.
+	Enabled bool
+
+	// CAFile is the path to the PEM-encoded CA bundle used to verify the server
+	// certificate. The system roots are used when empty.
+	CAFile string
+
+	// CertFile and KeyFile are the paths to the PEM-encoded certificate and
+	// private key presented to servers that require client certificates.
+	CertFile string
+	KeyFile  string
+
+	// ServerName is used to verify the hostname of the server certificate.
+	ServerName string
+}
+
+func (c ClientConfig) validate() error {
+	if (c.CertFile == "") != (c.KeyFile == "") {
+		return errors.New("both certificate and key files are required")
+	}
+	return nil
+}
+
+// NewListener returns a listener that accepts TLS connections from ln. The
+// files in the configuration are watched until the listener is closed.
+func NewListener(logger logr.Logger, ln net.Listener, config Config, nextProtos ...string) (net.Listener, error) {
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+
+	r, err := newReloader(logger, config.CertFile, config.KeyFile, config.ClientCAFile)
+	if err != nil {
+		return nil, err
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		NextProtos: nextProtos,
+	}
+	if config.ClientCAFile != "" {
+		tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+	tlsConfig.GetConfigForClient = func(*tls.ClientHelloInfo) (*tls.Config, error) {
+		cert, pool := r.current()
+		c := tlsConfig.Clone()
+		c.GetConfigForClient = nil
+		c.Certificates = []tls.Certificate{*cert}
+		c.ClientCAs = pool
+		return c, nil
+	}
+
+	return &listener{Listener: tls.NewListener(ln, tlsConfig), reloader: r}, nil
+}
+
+type listener struct {
+	net.Listener
+	reloader *reloader
+}
+
+func (l *listener) Close() error {
+	return errors.Join(l.Listener.Close(), l.reloader.Close())
+}
+
+// Client is a TLS client configuration backed by watched files.
+type Client struct {
+	*tls.Config
+	reloader *reloader
+}
+
+// NewClient returns the TLS configuration of a client. Close stops watching
+// the files in the configuration.
+func NewClient(logger logr.Logger, config ClientConfig) (*Client, error) {
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+
+	r, err := newReloader(logger, config.CertFile, config.KeyFile, config.CAFile)
+	if err != nil {
+		return nil, err
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ServerName: config.ServerName,
+	}
+
+	if config.CertFile != "" {
+		tlsConfig.GetClientCertificate = func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			cert, _ := r.current()
+			return cert, nil
+		}
+	}
+
+	if config.CAFile != "" {
+		// The server certificate is verified in VerifyConnection instead so
+		// the CA bundle in use can be replaced.
+		tlsConfig.InsecureSkipVerify = true
+		tlsConfig.VerifyConnection = func(cs tls.ConnectionState) error {
+			_, pool := r.current()
+			return verifyServer(cs, pool, config.ServerName)
+		}
+	}
+
+	return &Client{Config: tlsConfig, reloader: r}, nil
+}
+
+func (c *Client) Close() error {
+	return c.reloader.Close()
+}
+
+// verifyServer performs the verification of the server certificate that the
+// TLS client does by default, using the given roots.
+func verifyServer(cs tls.ConnectionState, roots *x509.CertPool, serverName string) error {
+	if len(cs.PeerCertificates) == 0 {
+		return errors.New("tls: server did not provide a certificate")
+	}
+
+	if serverName == "" {
+		serverName = cs.ServerName
+	}
+
+	opts := x509.VerifyOptions{
+		Roots:         roots,
+		DNSName:       serverName,
+		Intermediates: x509.NewCertPool(),
+	}
+	for _, cert := range cs.PeerCertificates[1:] {
+		opts.Intermediates.AddCert(cert)
+	}
+
+	if _, err := cs.PeerCertificates[0].Verify(opts); err != nil {
+		return fmt.Errorf("tls: failed to verify server certificate: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,281 @@
+package tlsutil_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"gotest.tools/v3/assert"
+	"gotest.tools/v3/poll"
+
+	"github.com/artefactual-labs/ccp/internal/tlsutil"
+)
+
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pem  []byte
+}
+
+func newTestCA(t *testing.T) *testCA {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NilError(t, err)
+
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Test CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	assert.NilError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	assert.NilError(t, err)
+
+	return &testCA{
+		cert: cert,
+		key:  key,
+		pem:  pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+	}
+}
+
+// issue writes a certificate for name signed by the CA and its key into dir.
+func (ca *testCA) issue(t *testing.T, dir, name string, serial int64) (certFile, keyFile string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NilError(t, err)
+
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: name},
+		DNSNames:     []string{name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca.cert, &key.PublicKey, ca.key)
+	assert.NilError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	assert.NilError(t, err)
+
+	certFile = filepath.Join(dir, name+".crt")
+	keyFile = filepath.Join(dir, name+".key")
+	writeFile(t, certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+	writeFile(t, keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}))
+
+	return certFile, keyFile
+}
+
+func (ca *testCA) write(t *testing.T, dir string) string {
+	t.Helper()
+
+	path := filepath.Join(dir, "ca.crt")
+	writeFile(t, path, ca.pem)
+
+	return path
+}
+
+// writeFile replaces the file atomically.
+func writeFile(t *testing.T, path string, blob []byte) {
+	t.Helper()
+
+	tmp := path + ".tmp"
+	assert.NilError(t, os.WriteFile(tmp, blob, 0o600))
+	assert.NilError(t, os.Rename(tmp, path))
+}
+
+// serve accepts connections and completes their handshakes.
+func serve(t *testing.T, config tlsutil.Config) net.Listener {
+	t.Helper()
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NilError(t, err)
+
+	ln, err = tlsutil.NewListener(logr.Discard(), ln, config)
+	assert.NilError(t, err)
+	t.Cleanup(func() { ln.Close() })
+
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				_ = conn.(*tls.Conn).Handshake()
+				_, _ = io.WriteString(conn, "hello")
+			}()
+		}
+	}()
+
+	return ln
+}
+
+func dial(ln net.Listener, client *tlsutil.Client) (*x509.Certificate, error) {
+	conn, err := tls.Dial("tcp", ln.Addr().String(), client.Config)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	// With TLS 1.3, client certificate errors are only seen after reading.
+	if _, err := io.ReadAll(conn); err != nil {
+		return nil, err
+	}
+
+	return conn.ConnectionState().PeerCertificates[0], nil
+}
+
+func TestConfig(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, tlsutil.Config{}.Enabled(), false)
+	assert.Equal(t, tlsutil.Config{CertFile: "tls.crt", KeyFile: "tls.key"}.Enabled(), true)
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NilError(t, err)
+	defer ln.Close()
+
+	_, err = tlsutil.NewListener(logr.Discard(), ln, tlsutil.Config{CertFile: "tls.crt"})
+	assert.Error(t, err, "both certificate and key files are required")
+
+	_, err = tlsutil.NewListener(logr.Discard(), ln, tlsutil.Config{CertFile: "missing.crt", KeyFile: "missing.key"})
+	assert.ErrorContains(t, err, "load key pair")
+}
+
+func TestListener(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Serves TLS", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		ca := newTestCA(t)
+		certFile, keyFile := ca.issue(t, dir, "ccp.example.com", 2)
+
+		ln := serve(t, tlsutil.Config{CertFile: certFile, KeyFile: keyFile})
+
+		client, err := tlsutil.NewClient(logr.Discard(), tlsutil.ClientConfig{
+			Enabled:    true,
+			CAFile:     ca.write(t, dir),
+			ServerName: "ccp.example.com",
+		})
+		assert.NilError(t, err)
+		defer client.Close()
+
+		cert, err := dial(ln, client)
+		assert.NilError(t, err)
+		assert.Equal(t, cert.Subject.CommonName, "ccp.example.com")
+	})
+
+	t.Run("Rejects servers with unknown certificates", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		certFile, keyFile := newTestCA(t).issue(t, dir, "ccp.example.com", 2)
+
+		ln := serve(t, tlsutil.Config{CertFile: certFile, KeyFile: keyFile})
+
+		client, err := tlsutil.NewClient(logr.Discard(), tlsutil.ClientConfig{
+			Enabled:    true,
+			CAFile:     newTestCA(t).write(t, t.TempDir()),
+			ServerName: "ccp.example.com",
+		})
+		assert.NilError(t, err)
+		defer client.Close()
+
+		_, err = dial(ln, client)
+		assert.ErrorContains(t, err, "failed to verify server certificate")
+	})
+
+	t.Run("Requires client certificates", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		ca := newTestCA(t)
+		caFile := ca.write(t, dir)
+		certFile, keyFile := ca.issue(t, dir, "ccp.example.com", 2)
+		clientCertFile, clientKeyFile := ca.issue(t, dir, "worker", 3)
+
+		ln := serve(t, tlsutil.Config{CertFile: certFile, KeyFile: keyFile, ClientCAFile: caFile})
+
+		anonymous, err := tlsutil.NewClient(logr.Discard(), tlsutil.ClientConfig{
+			Enabled:    true,
+			CAFile:     caFile,
+			ServerName: "ccp.example.com",
+		})
+		assert.NilError(t, err)
+		defer anonymous.Close()
+
+		_, err = dial(ln, anonymous)
+		assert.ErrorContains(t, err, "certificate required")
+
+		client, err := tlsutil.NewClient(logr.Discard(), tlsutil.ClientConfig{
+			Enabled:    true,
+			CAFile:     caFile,
+			CertFile:   clientCertFile,
+			KeyFile:    clientKeyFile,
+			ServerName: "ccp.example.com",
+		})
+		assert.NilError(t, err)
+		defer client.Close()
+
+		_, err = dial(ln, client)
+		assert.NilError(t, err)
+	})
+
+	t.Run("Reloads the certificate when it changes", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		ca := newTestCA(t)
+		certFile, keyFile := ca.issue(t, dir, "ccp.example.com", 2)
+
+		ln := serve(t, tlsutil.Config{CertFile: certFile, KeyFile: keyFile})
+
+		client, err := tlsutil.NewClient(logr.Discard(), tlsutil.ClientConfig{
+			Enabled:    true,
+			CAFile:     ca.write(t, dir),
+			ServerName: "ccp.example.com",
+		})
+		assert.NilError(t, err)
+		defer client.Close()
+
+		cert, err := dial(ln, client)
+		assert.NilError(t, err)
+		assert.Equal(t, cert.SerialNumber.Int64(), int64(2))
+
+		ca.issue(t, dir, "ccp.example.com", 4)
+
+		poll.WaitOn(t, func(t poll.LogT) poll.Result {
+			cert, err := dial(ln, client)
+			if err != nil {
+				return poll.Error(err)
+			}
+			if cert.SerialNumber.Int64() != 4 {
+				return poll.Continue("serial number is %d", cert.SerialNumber.Int64())
+			}
+			return poll.Success()
+		}, poll.WithTimeout(10*time.Second), poll.WithDelay(100*time.Millisecond))
+	})
+}
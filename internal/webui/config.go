@@ -1,6 +1,25 @@
 package webui
 
+import (
+	"time"
+
+	"github.com/artefactual-labs/ccp/internal/tlsutil"
+)
+
 type Config struct {
 	Addr           string
 	AllowedOrigins []string
+	TLS            tlsutil.Config
+
+	// AdminTLS configures the connection of the proxy to the admin API.
+	AdminTLS tlsutil.ClientConfig
+
+	// CSP is the Content-Security-Policy header sent with every response.
+	CSP string
+
+	// SessionTTL is the time after which idle sessions expire.
+	SessionTTL time.Duration
 }
+
+// DefaultCSP only allows resources from the same origin.
+const DefaultCSP = "default-src 'self'; object-src 'none'; base-uri 'self'; frame-ancestors 'none'; form-action 'self'"
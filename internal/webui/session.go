@@ -0,0 +1,239 @@
+package webui
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"mime"
+	"net/http"
+	"time"
+
+	"github.com/jellydator/ttlcache/v3"
+
+	"github.com/artefactual-labs/ccp/internal/store"
+)
+
+const (
+	sessionCookieName = "ccp_session"
+	csrfHeaderName    = "X-CSRF-Token"
+	defaultSessionTTL = 12 * time.Hour
+)
+
+// session is the server-side state of a logged-in user. The API key of the
+// user never leaves the server, it is used to authenticate the requests that
+// the proxy sends to the admin API on behalf of the user.
+type session struct {
+	user      *store.User
+	apiKey    string
+	csrfToken string
+}
+
+// sessions is an in-memory session store. Sessions expire after being idle for
+// the configured TTL.
+type sessions struct {
+	cache *ttlcache.Cache[string, *session]
+}
+
+func newSessions(ttl time.Duration) *sessions {
+	if ttl <= 0 {
+		ttl = defaultSessionTTL
+	}
+
+	return &sessions{
+		cache: ttlcache.New(ttlcache.WithTTL[string, *session](ttl)),
+	}
+}
+
+func (s *sessions) create(user *store.User, apiKey string) (string, *session, error) {
+	id, err := randomToken()
+	if err != nil {
+		return "", nil, err
+	}
+	csrfToken, err := randomToken()
+	if err != nil {
+		return "", nil, err
+	}
+
+	sess := &session{user: user, apiKey: apiKey, csrfToken: csrfToken}
+	s.cache.Set(id, sess, ttlcache.DefaultTTL)
+
+	return id, sess, nil
+}
+
+// get returns the session and extends its expiration.
+func (s *sessions) get(id string) (*session, bool) {
+	item := s.cache.Get(id)
+	if item == nil {
+		return nil, false
+	}
+	return item.Value(), true
+}
+
+func (s *sessions) delete(id string) {
+	s.cache.Delete(id)
+}
+
+func randomToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+type loginRequest struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+type sessionResponse struct {
+	Username  string `json:"username"`
+	CSRFToken string `json:"csrfToken"`
+}
+
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+// login validates the credentials of the user and starts a session. Only JSON
+// requests are accepted so the endpoint cannot be the target of cross-site
+// form submissions.
+func (s *Server) login(w http.ResponseWriter, r *http.Request) {
+	if mt, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type")); mt != "application/json" {
+		s.json(w, http.StatusUnsupportedMediaType, errorResponse{"expected application/json"})
+		return
+	}
+
+	var req loginRequest
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, 4096)).Decode(&req); err != nil {
+		s.json(w, http.StatusBadRequest, errorResponse{"invalid request"})
+		return
+	}
+
+	user, err := s.store.ValidateUserPassword(r.Context(), req.Username, req.Password)
+	if err != nil {
+		s.logger.Error(err, "Cannot validate user credentials.")
+		s.json(w, http.StatusInternalServerError, errorResponse{"internal error"})
+		return
+	}
+	if user == nil {
+		s.json(w, http.StatusUnauthorized, errorResponse{"invalid credentials"})
+		return
+	}
+
+	apiKey, err := s.store.ReadUserAPIKey(r.Context(), user.ID)
+	if errors.Is(err, store.ErrNotFound) {
+		s.json(w, http.StatusForbidden, errorResponse{"user has no API key"})
+		return
+	} else if err != nil {
+		s.logger.Error(err, "Cannot look up user API key.")
+		s.json(w, http.StatusInternalServerError, errorResponse{"internal error"})
+		return
+	}
+
+	id, sess, err := s.sessions.create(user, apiKey)
+	if err != nil {
+		s.logger.Error(err, "Cannot create session.")
+		s.json(w, http.StatusInternalServerError, errorResponse{"internal error"})
+		return
+	}
+
+	http.SetCookie(w, s.sessionCookie(id, 0))
+	s.json(w, http.StatusOK, sessionResponse{Username: user.Username, CSRFToken: sess.csrfToken})
+}
+
+// logout ends the session of the user.
+func (s *Server) logout(w http.ResponseWriter, r *http.Request) {
+	id, sess, ok := s.currentSession(r)
+	if ok {
+		if !validCSRFToken(r, sess) {
+			s.json(w, http.StatusForbidden, errorResponse{"invalid CSRF token"})
+			return
+		}
+		s.sessions.delete(id)
+	}
+
+	http.SetCookie(w, s.sessionCookie("", -1))
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// session describes the session of the user, including the CSRF token that
+// the client must send with mutating requests.
+func (s *Server) session(w http.ResponseWriter, r *http.Request) {
+	_, sess, ok := s.currentSession(r)
+	if !ok {
+		s.json(w, http.StatusUnauthorized, errorResponse{"not logged in"})
+		return
+	}
+
+	s.json(w, http.StatusOK, sessionResponse{Username: sess.user.Username, CSRFToken: sess.csrfToken})
+}
+
+func (s *Server) currentSession(r *http.Request) (string, *session, bool) {
+	cookie, err := r.Cookie(sessionCookieName)
+	if err != nil || cookie.Value == "" {
+		return "", nil, false
+	}
+	sess, ok := s.sessions.get(cookie.Value)
+	if !ok {
+		return "", nil, false
+	}
+	return cookie.Value, sess, true
+}
+
+func (s *Server) sessionCookie(value string, maxAge int) *http.Cookie {
+	return &http.Cookie{
+		Name:     sessionCookieName,
+		Value:    value,
+		Path:     "/",
+		MaxAge:   maxAge,
+		HttpOnly: true,
+		Secure:   s.config.TLS.Enabled(),
+		SameSite: http.SameSiteLaxMode,
+	}
+}
+
+// authenticateProxy is middleware that exchanges the session cookie for the
+// Authorization header expected by the admin API. Requests without a session
+// are only forwarded when they carry their own credentials, e.g. API clients.
+func (s *Server) authenticateProxy(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, sess, ok := s.currentSession(r)
+		if !ok {
+			if _, err := r.Cookie(sessionCookieName); err == nil || r.Header.Get("Authorization") == "" {
+				s.json(w, http.StatusUnauthorized, errorResponse{"not logged in"})
+				return
+			}
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		if !safeMethod(r.Method) && !validCSRFToken(r, sess) {
+			s.json(w, http.StatusForbidden, errorResponse{"invalid CSRF token"})
+			return
+		}
+
+		r = r.Clone(r.Context())
+		r.Header.Del("Cookie")
+		r.Header.Del(csrfHeaderName)
+		r.Header.Set("Authorization", "ApiKey "+sess.user.Username+":"+sess.apiKey)
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+func safeMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	default:
+		return false
+	}
+}
+
+func validCSRFToken(r *http.Request, sess *session) bool {
+	token := r.Header.Get(csrfHeaderName)
+	return token != "" && subtle.ConstantTimeCompare([]byte(token), []byte(sess.csrfToken)) == 1
+}
@@ -0,0 +1,269 @@
+package webui
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"go.artefactual.dev/tools/mockutil"
+	"go.uber.org/mock/gomock"
+	"gotest.tools/v3/assert"
+
+	"github.com/artefactual-labs/ccp/internal/health"
+	"github.com/artefactual-labs/ccp/internal/store"
+	"github.com/artefactual-labs/ccp/internal/store/storemock"
+)
+
+func newSessionTestServer(t *testing.T, s store.Store, upstream http.HandlerFunc) *Server {
+	t.Helper()
+
+	if upstream == nil {
+		upstream = func(w http.ResponseWriter, r *http.Request) {}
+	}
+	admin := httptest.NewServer(upstream)
+	t.Cleanup(admin.Close)
+
+	srv := New(logr.Discard(), Config{}, strings.TrimPrefix(admin.URL, "http://"), s, health.NewChecker(0))
+	t.Cleanup(srv.sessions.cache.Stop)
+	assert.NilError(t, srv.configureRouter())
+
+	return srv
+}
+
+func login(t *testing.T, srv *Server) (*http.Cookie, sessionResponse) {
+	t.Helper()
+
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/auth/login", strings.NewReader(`{"username":"test","password":"secret"}`))
+	req.Header.Set("Content-Type", "application/json")
+	srv.router.ServeHTTP(rec, req)
+	assert.Equal(t, rec.Code, http.StatusOK)
+
+	cookies := rec.Result().Cookies()
+	assert.Equal(t, len(cookies), 1)
+	assert.Equal(t, cookies[0].Name, sessionCookieName)
+	assert.Equal(t, cookies[0].HttpOnly, true)
+	assert.Equal(t, cookies[0].SameSite, http.SameSiteLaxMode)
+
+	var resp sessionResponse
+	assert.NilError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	assert.Equal(t, resp.Username, "test")
+	assert.Assert(t, resp.CSRFToken != "")
+
+	return cookies[0], resp
+}
+
+func expectLogin(s *storemock.MockStore) {
+	s.EXPECT().ValidateUserPassword(mockutil.Context(), "test", "secret").Return(&store.User{ID: 1, Username: "test", Active: true}, nil)
+	s.EXPECT().ReadUserAPIKey(mockutil.Context(), 1).Return("key", nil)
+}
+
+func TestSessions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Exchanges the session cookie for the Authorization header", func(t *testing.T) {
+		t.Parallel()
+
+		s := storemock.NewMockStore(gomock.NewController(t))
+		expectLogin(s)
+
+		srv := newSessionTestServer(t, s, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, r.URL.Path, "/archivematica.ccp.admin.v1beta1.AdminService/CreatePackage")
+			assert.Equal(t, r.Header.Get("Authorization"), "ApiKey test:key")
+			assert.Equal(t, r.Header.Get("Cookie"), "")
+			assert.Equal(t, r.Header.Get(csrfHeaderName), "")
+		})
+		cookie, sess := login(t, srv)
+
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPost, "/api/archivematica.ccp.admin.v1beta1.AdminService/CreatePackage", nil)
+		req.AddCookie(cookie)
+		req.Header.Set(csrfHeaderName, sess.CSRFToken)
+		srv.router.ServeHTTP(rec, req)
+
+		assert.Equal(t, rec.Code, http.StatusOK)
+	})
+
+	t.Run("Rejects mutating requests without a valid CSRF token", func(t *testing.T) {
+		t.Parallel()
+
+		s := storemock.NewMockStore(gomock.NewController(t))
+		expectLogin(s)
+
+		srv := newSessionTestServer(t, s, func(w http.ResponseWriter, r *http.Request) {
+			t.Error("request was proxied")
+		})
+		cookie, _ := login(t, srv)
+
+		for _, token := range []string{"", "invalid"} {
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, "/api/archivematica.ccp.admin.v1beta1.AdminService/CreatePackage", nil)
+			req.AddCookie(cookie)
+			if token != "" {
+				req.Header.Set(csrfHeaderName, token)
+			}
+			srv.router.ServeHTTP(rec, req)
+
+			assert.Equal(t, rec.Code, http.StatusForbidden)
+		}
+	})
+
+	t.Run("Rejects requests without credentials", func(t *testing.T) {
+		t.Parallel()
+
+		srv := newSessionTestServer(t, nil, func(w http.ResponseWriter, r *http.Request) {
+			t.Error("request was proxied")
+		})
+
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPost, "/api/archivematica.ccp.admin.v1beta1.AdminService/ListPackages", nil)
+		srv.router.ServeHTTP(rec, req)
+		assert.Equal(t, rec.Code, http.StatusUnauthorized)
+
+		rec = httptest.NewRecorder()
+		req = httptest.NewRequest(http.MethodPost, "/api/archivematica.ccp.admin.v1beta1.AdminService/ListPackages", nil)
+		req.AddCookie(&http.Cookie{Name: sessionCookieName, Value: "expired"})
+		req.Header.Set("Authorization", "ApiKey test:key")
+		srv.router.ServeHTTP(rec, req)
+		assert.Equal(t, rec.Code, http.StatusUnauthorized)
+	})
+
+	t.Run("Forwards requests with their own credentials", func(t *testing.T) {
+		t.Parallel()
+
+		srv := newSessionTestServer(t, nil, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, r.Header.Get("Authorization"), "ApiKey test:key")
+		})
+
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPost, "/api/archivematica.ccp.admin.v1beta1.AdminService/ListPackages", nil)
+		req.Header.Set("Authorization", "ApiKey test:key")
+		srv.router.ServeHTTP(rec, req)
+
+		assert.Equal(t, rec.Code, http.StatusOK)
+	})
+
+	t.Run("Rejects invalid credentials", func(t *testing.T) {
+		t.Parallel()
+
+		s := storemock.NewMockStore(gomock.NewController(t))
+		s.EXPECT().ValidateUserPassword(mockutil.Context(), "test", "wrong").Return(nil, nil)
+
+		srv := newSessionTestServer(t, s, nil)
+
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPost, "/auth/login", strings.NewReader(`{"username":"test","password":"wrong"}`))
+		req.Header.Set("Content-Type", "application/json")
+		srv.router.ServeHTTP(rec, req)
+
+		assert.Equal(t, rec.Code, http.StatusUnauthorized)
+		assert.Equal(t, len(rec.Result().Cookies()), 0)
+	})
+
+	t.Run("Rejects form submissions", func(t *testing.T) {
+		t.Parallel()
+
+		srv := newSessionTestServer(t, nil, nil)
+
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPost, "/auth/login", strings.NewReader("username=test&password=secret"))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		srv.router.ServeHTTP(rec, req)
+
+		assert.Equal(t, rec.Code, http.StatusUnsupportedMediaType)
+	})
+
+	t.Run("Rejects users without API key", func(t *testing.T) {
+		t.Parallel()
+
+		s := storemock.NewMockStore(gomock.NewController(t))
+		s.EXPECT().ValidateUserPassword(mockutil.Context(), "test", "secret").Return(&store.User{ID: 1, Username: "test", Active: true}, nil)
+		s.EXPECT().ReadUserAPIKey(mockutil.Context(), 1).Return("", store.ErrNotFound)
+
+		srv := newSessionTestServer(t, s, nil)
+
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPost, "/auth/login", strings.NewReader(`{"username":"test","password":"secret"}`))
+		req.Header.Set("Content-Type", "application/json")
+		srv.router.ServeHTTP(rec, req)
+
+		assert.Equal(t, rec.Code, http.StatusForbidden)
+	})
+
+	t.Run("Reports store errors", func(t *testing.T) {
+		t.Parallel()
+
+		s := storemock.NewMockStore(gomock.NewController(t))
+		s.EXPECT().ValidateUserPassword(mockutil.Context(), "test", "secret").Return(nil, errors.New("database is gone"))
+
+		srv := newSessionTestServer(t, s, nil)
+
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPost, "/auth/login", strings.NewReader(`{"username":"test","password":"secret"}`))
+		req.Header.Set("Content-Type", "application/json")
+		srv.router.ServeHTTP(rec, req)
+
+		assert.Equal(t, rec.Code, http.StatusInternalServerError)
+	})
+
+	t.Run("Describes and ends the session", func(t *testing.T) {
+		t.Parallel()
+
+		s := storemock.NewMockStore(gomock.NewController(t))
+		expectLogin(s)
+
+		srv := newSessionTestServer(t, s, nil)
+		cookie, sess := login(t, srv)
+
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/auth/session", nil)
+		req.AddCookie(cookie)
+		srv.router.ServeHTTP(rec, req)
+		assert.Equal(t, rec.Code, http.StatusOK)
+		var resp sessionResponse
+		assert.NilError(t, json.NewDecoder(rec.Body).Decode(&resp))
+		assert.DeepEqual(t, resp, sess)
+
+		rec = httptest.NewRecorder()
+		req = httptest.NewRequest(http.MethodPost, "/auth/logout", nil)
+		req.AddCookie(cookie)
+		srv.router.ServeHTTP(rec, req)
+		assert.Equal(t, rec.Code, http.StatusForbidden)
+
+		rec = httptest.NewRecorder()
+		req = httptest.NewRequest(http.MethodPost, "/auth/logout", nil)
+		req.AddCookie(cookie)
+		req.Header.Set(csrfHeaderName, sess.CSRFToken)
+		srv.router.ServeHTTP(rec, req)
+		assert.Equal(t, rec.Code, http.StatusNoContent)
+		assert.Equal(t, rec.Result().Cookies()[0].MaxAge, -1)
+
+		rec = httptest.NewRecorder()
+		req = httptest.NewRequest(http.MethodGet, "/auth/session", nil)
+		req.AddCookie(cookie)
+		srv.router.ServeHTTP(rec, req)
+		assert.Equal(t, rec.Code, http.StatusUnauthorized)
+	})
+}
+
+func TestSecurityHeaders(t *testing.T) {
+	t.Parallel()
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+
+	rec := httptest.NewRecorder()
+	securityHeaders("", true)(handler).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	assert.Equal(t, rec.Header().Get("Content-Security-Policy"), DefaultCSP)
+	assert.Equal(t, rec.Header().Get("X-Content-Type-Options"), "nosniff")
+	assert.Equal(t, rec.Header().Get("X-Frame-Options"), "DENY")
+	assert.Equal(t, rec.Header().Get("Strict-Transport-Security"), "max-age=63072000; includeSubDomains")
+
+	rec = httptest.NewRecorder()
+	securityHeaders("default-src 'none'", false)(handler).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	assert.Equal(t, rec.Header().Get("Content-Security-Policy"), "default-src 'none'")
+	assert.Equal(t, rec.Header().Get("Strict-Transport-Security"), "")
+}
@@ -3,6 +3,8 @@ package webui
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"net"
 	"net/http"
 	"net/http/httputil"
@@ -14,6 +16,9 @@ import (
 	"github.com/gorilla/mux"
 
 	"github.com/artefactual-labs/ccp/internal/api/corsutil"
+	"github.com/artefactual-labs/ccp/internal/health"
+	"github.com/artefactual-labs/ccp/internal/store"
+	"github.com/artefactual-labs/ccp/internal/tlsutil"
 )
 
 type Server struct {
@@ -22,17 +27,26 @@ type Server struct {
 	server    *http.Server
 	router    *mux.Router
 	adminAddr string
+	adminTLS  *tlsutil.Client
 	ln        net.Listener
+	store     store.Store
+	sessions  *sessions
+	health    *health.Checker
 }
 
-func New(logger logr.Logger, config Config, adminAddr string) *Server {
+func New(logger logr.Logger, config Config, adminAddr string, store store.Store, checker *health.Checker) *Server {
 	s := &Server{
 		logger:    logger,
 		config:    config,
 		router:    mux.NewRouter(),
 		adminAddr: adminAddr,
+		store:     store,
+		sessions:  newSessions(config.SessionTTL),
+		health:    checker,
 	}
 
+	go s.sessions.cache.Start()
+
 	return s
 }
 
@@ -52,6 +66,11 @@ func (s *Server) Run() error {
 	if s.ln, err = net.Listen("tcp", s.config.Addr); err != nil {
 		return err
 	}
+	if s.config.TLS.Enabled() {
+		if s.ln, err = tlsutil.NewListener(s.logger.WithName("tls"), s.ln, s.config.TLS, "h2", "http/1.1"); err != nil {
+			return fmt.Errorf("tls: %v", err)
+		}
+	}
 
 	go func() {
 		s.logger.Info("Listening...", "addr", s.ln.Addr())
@@ -68,23 +87,56 @@ func (s *Server) configureRouter() error {
 	s.router.Use(reportPanic(s.logger))
 	s.router.Use(handlers.CompressHandler)
 	s.router.Use(corsutil.New(s.config.AllowedOrigins).Handler)
-	// TODO: auth, csrf, csp, hsts, xframe, nosniff, xss...
+	s.router.Use(securityHeaders(s.config.CSP, s.config.TLS.Enabled()))
 
-	s.router.HandleFunc("/healthz", s.health)
+	// Probes used by Kubernetes, /healthz is kept for compatibility.
+	s.router.Handle("/livez", s.health.LiveHandler())
+	s.router.Handle("/readyz", s.health.ReadyHandler())
+	s.router.Handle("/healthz", s.health.ReadyHandler())
 
-	target, err := url.Parse("http://" + s.adminAddr)
+	s.router.HandleFunc("/auth/login", s.login).Methods(http.MethodPost)
+	s.router.HandleFunc("/auth/logout", s.logout).Methods(http.MethodPost)
+	s.router.HandleFunc("/auth/session", s.session).Methods(http.MethodGet)
+
+	proxy, err := s.adminProxy()
 	if err != nil {
 		return err
 	}
-	proxy := httputil.NewSingleHostReverseProxy(target)
 	proxyHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { proxy.ServeHTTP(w, r) })
-	s.router.PathPrefix("/api").Handler(http.StripPrefix("/api", proxyHandler))
+	s.router.PathPrefix("/api").Handler(http.StripPrefix("/api", s.authenticateProxy(proxyHandler)))
 
 	s.router.PathPrefix("/").Handler(spaHandler(assets))
 
 	return nil
 }
 
+// adminProxy returns a reverse proxy to the admin API, using TLS when the
+// admin API is configured to use it.
+func (s *Server) adminProxy() (*httputil.ReverseProxy, error) {
+	scheme := "http"
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	if s.config.AdminTLS.Enabled {
+		client, err := tlsutil.NewClient(s.logger.WithName("tls"), s.config.AdminTLS)
+		if err != nil {
+			return nil, fmt.Errorf("admin API tls: %v", err)
+		}
+		s.adminTLS = client
+		scheme = "https"
+		transport.TLSClientConfig = client.Config
+	}
+
+	target, err := url.Parse(scheme + "://" + s.adminAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	proxy := httputil.NewSingleHostReverseProxy(target)
+	proxy.Transport = transport
+
+	return proxy, nil
+}
+
 func (s *Server) json(w http.ResponseWriter, code int, i interface{}) {
 	w.Header().Add("Content-Type", "application/json")
 	w.WriteHeader(code)
@@ -95,10 +147,14 @@ func (s *Server) json(w http.ResponseWriter, code int, i interface{}) {
 	}
 }
 
-func (s *Server) health(w http.ResponseWriter, r *http.Request) {
-	s.json(w, http.StatusOK, map[string]string{"status": "OK"})
-}
-
 func (s *Server) Close(ctx context.Context) error {
-	return s.server.Shutdown(ctx)
+	err := s.server.Shutdown(ctx)
+
+	s.sessions.cache.Stop()
+
+	if s.adminTLS != nil {
+		err = errors.Join(err, s.adminTLS.Close())
+	}
+
+	return err
 }
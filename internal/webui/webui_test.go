@@ -0,0 +1,76 @@
+package webui
+
+import (
+	"encoding/pem"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"gotest.tools/v3/assert"
+
+	"github.com/artefactual-labs/ccp/internal/health"
+	"github.com/artefactual-labs/ccp/internal/tlsutil"
+)
+
+func TestAdminProxy(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Proxies to the admin API over TLS", func(t *testing.T) {
+		t.Parallel()
+
+		admin := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, r.URL.Path, "/archivematica.ccp.admin.v1beta1.AdminService/ListDecisions")
+			_, _ = io.WriteString(w, "{}")
+		}))
+		defer admin.Close()
+
+		caFile := filepath.Join(t.TempDir(), "ca.crt")
+		blob := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: admin.Certificate().Raw})
+		assert.NilError(t, os.WriteFile(caFile, blob, 0o600))
+
+		s := New(logr.Discard(), Config{
+			AdminTLS: tlsutil.ClientConfig{
+				Enabled:    true,
+				CAFile:     caFile,
+				ServerName: "example.com",
+			},
+		}, strings.TrimPrefix(admin.URL, "https://"), nil, health.NewChecker(0))
+		defer s.sessions.cache.Stop()
+		proxy, err := s.adminProxy()
+		assert.NilError(t, err)
+		defer s.adminTLS.Close()
+
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPost, "/archivematica.ccp.admin.v1beta1.AdminService/ListDecisions", nil)
+		proxy.ServeHTTP(rec, req)
+
+		assert.Equal(t, rec.Code, http.StatusOK)
+		assert.Equal(t, rec.Body.String(), "{}")
+	})
+
+	t.Run("Rejects admin API certificates that cannot be verified", func(t *testing.T) {
+		t.Parallel()
+
+		admin := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+		defer admin.Close()
+
+		s := New(logr.Discard(), Config{
+			AdminTLS: tlsutil.ClientConfig{Enabled: true},
+		}, strings.TrimPrefix(admin.URL, "https://"), nil, health.NewChecker(0))
+		defer s.sessions.cache.Stop()
+		proxy, err := s.adminProxy()
+		assert.NilError(t, err)
+		defer s.adminTLS.Close()
+
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPost, "/", nil)
+		proxy.ServeHTTP(rec, req)
+
+		assert.Equal(t, rec.Code, http.StatusBadGateway)
+	})
+}
@@ -34,11 +34,13 @@ type Choices []Choice
 func (c Choices) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	indent := xml.CharData(fmt.Sprintf("\n%s%s", xmlIndent, xmlIndent))
 	for _, item := range c {
-		if err := e.EncodeToken(indent); err != nil {
-			return err
-		}
-		if err := e.EncodeToken(xml.Comment(fmt.Sprintf(" %s ", item.Comment))); err != nil {
-			return err
+		if item.Comment != "" {
+			if err := e.EncodeToken(indent); err != nil {
+				return err
+			}
+			if err := e.EncodeToken(xml.Comment(fmt.Sprintf(" %s ", item.Comment))); err != nil {
+				return err
+			}
 		}
 		if err := e.Encode(item); err != nil {
 			return err
@@ -72,13 +74,15 @@ func (c Choice) Value() string {
 	return c.GoToChain
 }
 
+// ParseConfigFile parses the processing configuration file. The format is
+// determined by the file extension, see ConfigFormatFromPath.
 func ParseConfigFile(path string) ([]Choice, error) {
 	blob, err := os.ReadFile(path)
 	if err != nil {
 		return nil, err
 	}
 
-	return ParseConfig(bytes.NewReader(blob))
+	return ParseConfigFormat(bytes.NewReader(blob), ConfigFormatFromPath(path))
 }
 
 func ParseConfig(reader io.Reader) ([]Choice, error) {
@@ -96,7 +100,8 @@ func ParseConfig(reader io.Reader) ([]Choice, error) {
 	return config.Choices, nil
 }
 
-func SaveConfigFile(path string, choices []Choice) error {
+// WriteConfig encodes the choices using the processingMCP.xml format.
+func WriteConfig(w io.Writer, choices []Choice) error {
 	config := ProcessingConfig{
 		Choices: choices,
 	}
@@ -106,13 +111,24 @@ func SaveConfigFile(path string, choices []Choice) error {
 		return err
 	}
 
+	_, err = w.Write(blob)
+
+	return err
+}
+
+// SaveConfigFile writes the processing configuration to path after validating
+// the choices against the workflow. The format is determined by the file
+// extension, see ConfigFormatFromPath.
+func SaveConfigFile(wf *Document, path string, choices []Choice) error {
+	if err := ValidateConfig(wf, choices); err != nil {
+		return fmt.Errorf("invalid processing configuration: %w", err)
+	}
+
 	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o644)
 	if err != nil {
 		return err
 	}
 	defer f.Close()
 
-	_, err = f.Write(blob)
-
-	return err
+	return WriteConfigFormat(f, choices, ConfigFormatFromPath(path))
 }
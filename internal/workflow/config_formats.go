@@ -0,0 +1,218 @@
+package workflow
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/google/uuid"
+	"gopkg.in/yaml.v3"
+)
+
+// ConfigFormat is the encoding of a processing configuration.
+type ConfigFormat string
+
+const (
+	// ConfigFormatXML is the legacy processingMCP.xml format.
+	ConfigFormatXML ConfigFormat = "xml"
+
+	// ConfigFormatJSON is a JSON object keyed by the stable names of the
+	// processing configuration fields, e.g.:
+	//
+	//	{
+	//	  "virus_scanning": "63767e4b-9ce8-4fe2-8724-65cc1f763de0",
+	//	  "store_dip": "8d29eb3d-a8a8-4347-806e-3d8227ed44a1"
+	//	}
+	//
+	// Choices that apply to links without a stable name, e.g. the links that
+	// share the choice of a field, are keyed by the link identifier.
+	ConfigFormatJSON ConfigFormat = "json"
+
+	// ConfigFormatYAML is the YAML equivalent of ConfigFormatJSON.
+	ConfigFormatYAML ConfigFormat = "yaml"
+)
+
+// ConfigFormatFromPath returns the format of a processing configuration file
+// based on its extension. It defaults to ConfigFormatXML.
+func ConfigFormatFromPath(path string) ConfigFormat {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		return ConfigFormatJSON
+	case ".yaml", ".yml":
+		return ConfigFormatYAML
+	default:
+		return ConfigFormatXML
+	}
+}
+
+// ParseConfigFormat decodes the preconfigured choices using the given format.
+// The order of the choices is preserved.
+func ParseConfigFormat(reader io.Reader, format ConfigFormat) ([]Choice, error) {
+	switch format {
+	case ConfigFormatXML:
+		return ParseConfig(reader)
+	case ConfigFormatJSON:
+		return parseConfigJSON(reader)
+	case ConfigFormatYAML:
+		return parseConfigYAML(reader)
+	default:
+		return nil, fmt.Errorf("unknown format: %q", format)
+	}
+}
+
+// WriteConfigFormat encodes the preconfigured choices using the given format.
+// The order of the choices is preserved.
+func WriteConfigFormat(w io.Writer, choices []Choice, format ConfigFormat) error {
+	switch format {
+	case ConfigFormatXML:
+		return WriteConfig(w, choices)
+	case ConfigFormatJSON:
+		return writeConfigJSON(w, choices)
+	case ConfigFormatYAML:
+		return writeConfigYAML(w, choices)
+	default:
+		return fmt.Errorf("unknown format: %q", format)
+	}
+}
+
+// configKey returns the key used to encode a choice, i.e. the name of the
+// field when the choice applies to its link or the link identifier otherwise.
+func configKey(appliesTo string) string {
+	for _, f := range processingConfigFields {
+		if f.linkID.String() == appliesTo {
+			return f.name
+		}
+	}
+	return appliesTo
+}
+
+// configAppliesTo returns the link identifier for a given key.
+func configAppliesTo(key string) (string, error) {
+	for _, f := range processingConfigFields {
+		if f.name == key {
+			return f.linkID.String(), nil
+		}
+	}
+	if _, err := uuid.Parse(key); err != nil {
+		return "", fmt.Errorf("unknown field %q", key)
+	}
+	return key, nil
+}
+
+func parseConfigJSON(reader io.Reader) ([]Choice, error) {
+	dec := json.NewDecoder(reader)
+
+	if t, err := dec.Token(); err != nil {
+		return nil, err
+	} else if t != json.Delim('{') {
+		return nil, errors.New("expected JSON object")
+	}
+
+	choices := []Choice{}
+	for dec.More() {
+		t, err := dec.Token()
+		if err != nil {
+			return nil, err
+		}
+		key := t.(string) // Object keys are always strings.
+
+		var value string
+		if err := dec.Decode(&value); err != nil {
+			return nil, fmt.Errorf("field %q: %v", key, err)
+		}
+
+		appliesTo, err := configAppliesTo(key)
+		if err != nil {
+			return nil, err
+		}
+		choices = append(choices, Choice{AppliesTo: appliesTo, GoToChain: value})
+	}
+
+	if _, err := dec.Token(); err != nil {
+		return nil, err
+	}
+
+	return choices, nil
+}
+
+func writeConfigJSON(w io.Writer, choices []Choice) error {
+	var buf bytes.Buffer
+
+	buf.WriteString("{")
+	for i, choice := range choices {
+		if i > 0 {
+			buf.WriteString(",")
+		}
+		key, err := json.Marshal(configKey(choice.AppliesTo))
+		if err != nil {
+			return err
+		}
+		value, err := json.Marshal(choice.GoToChain)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(&buf, "\n%s%s: %s", xmlIndent, key, value)
+	}
+	if len(choices) > 0 {
+		buf.WriteString("\n")
+	}
+	buf.WriteString("}\n")
+
+	_, err := w.Write(buf.Bytes())
+
+	return err
+}
+
+func parseConfigYAML(reader io.Reader) ([]Choice, error) {
+	var doc yaml.Node
+	if err := yaml.NewDecoder(reader).Decode(&doc); err == io.EOF {
+		return []Choice{}, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	if len(doc.Content) == 0 {
+		return []Choice{}, nil
+	}
+	node := doc.Content[0]
+	if node.Kind != yaml.MappingNode {
+		return nil, errors.New("expected YAML mapping")
+	}
+
+	choices := make([]Choice, 0, len(node.Content)/2)
+	for i := 0; i < len(node.Content); i += 2 {
+		key, value := node.Content[i], node.Content[i+1]
+		if value.Kind != yaml.ScalarNode {
+			return nil, fmt.Errorf("field %q: expected string value (line %d)", key.Value, value.Line)
+		}
+		appliesTo, err := configAppliesTo(key.Value)
+		if err != nil {
+			return nil, err
+		}
+		choices = append(choices, Choice{AppliesTo: appliesTo, GoToChain: value.Value})
+	}
+
+	return choices, nil
+}
+
+func writeConfigYAML(w io.Writer, choices []Choice) error {
+	node := &yaml.Node{Kind: yaml.MappingNode}
+	for _, choice := range choices {
+		node.Content = append(node.Content,
+			&yaml.Node{Kind: yaml.ScalarNode, Value: configKey(choice.AppliesTo)},
+			&yaml.Node{Kind: yaml.ScalarNode, Value: choice.GoToChain},
+		)
+	}
+
+	enc := yaml.NewEncoder(w)
+	enc.SetIndent(len(xmlIndent))
+	if err := enc.Encode(node); err != nil {
+		return err
+	}
+
+	return enc.Close()
+}
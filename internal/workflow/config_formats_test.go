@@ -0,0 +1,130 @@
+package workflow_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"gotest.tools/v3/assert"
+	"gotest.tools/v3/fs"
+
+	"github.com/artefactual-labs/ccp/internal/workflow"
+)
+
+func TestConfigFormats(t *testing.T) {
+	t.Parallel()
+
+	choices, err := workflow.ParseConfigFile("../../hack/helpers/processingMCP.xml")
+	assert.NilError(t, err)
+
+	t.Run("Converts losslessly", func(t *testing.T) {
+		t.Parallel()
+
+		for _, format := range []workflow.ConfigFormat{
+			workflow.ConfigFormatXML,
+			workflow.ConfigFormatJSON,
+			workflow.ConfigFormatYAML,
+		} {
+			var buf bytes.Buffer
+			err := workflow.WriteConfigFormat(&buf, choices, format)
+			assert.NilError(t, err, format)
+
+			got, err := workflow.ParseConfigFormat(&buf, format)
+			assert.NilError(t, err, format)
+			assert.Equal(t, len(got), len(choices), format)
+			for i := range choices {
+				assert.Equal(t, got[i].AppliesTo, choices[i].AppliesTo, format)
+				assert.Equal(t, got[i].GoToChain, choices[i].GoToChain, format)
+			}
+		}
+	})
+
+	t.Run("Encodes JSON keyed by field names", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+		err := workflow.WriteConfigFormat(&buf, []workflow.Choice{
+			{AppliesTo: "856d2d65-cd25-49fa-8da9-cabb78292894", GoToChain: "63767e4b-9ce8-4fe2-8724-65cc1f763de0"},
+			{AppliesTo: "1dad74a2-95df-4825-bbba-dca8b91d2371", GoToChain: "697c0883-798d-4af7-b8d6-101c7390261f"},
+			{AppliesTo: "5e58066d-e113-4383-b20b-f301ed4d751c", GoToChain: "8d29eb3d-a8a8-4347-806e-3d8227ed44a1"},
+		}, workflow.ConfigFormatJSON)
+		assert.NilError(t, err)
+		assert.Equal(t, buf.String(), `{
+  "virus_scanning": "63767e4b-9ce8-4fe2-8724-65cc1f763de0",
+  "1dad74a2-95df-4825-bbba-dca8b91d2371": "697c0883-798d-4af7-b8d6-101c7390261f",
+  "store_dip": "8d29eb3d-a8a8-4347-806e-3d8227ed44a1"
+}
+`)
+	})
+
+	t.Run("Encodes YAML keyed by field names", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+		err := workflow.WriteConfigFormat(&buf, []workflow.Choice{
+			{AppliesTo: "856d2d65-cd25-49fa-8da9-cabb78292894", GoToChain: "63767e4b-9ce8-4fe2-8724-65cc1f763de0"},
+			{AppliesTo: "b320ce81-9982-408a-9502-097d0daa48fa", GoToChain: "/api/v2/location/default/AS/"},
+		}, workflow.ConfigFormatYAML)
+		assert.NilError(t, err)
+		assert.Equal(t, buf.String(), `virus_scanning: 63767e4b-9ce8-4fe2-8724-65cc1f763de0
+b320ce81-9982-408a-9502-097d0daa48fa: /api/v2/location/default/AS/
+`)
+	})
+
+	t.Run("Parses an empty configuration", func(t *testing.T) {
+		t.Parallel()
+
+		for _, tc := range []struct {
+			format workflow.ConfigFormat
+			input  string
+		}{
+			{workflow.ConfigFormatJSON, "{}"},
+			{workflow.ConfigFormatYAML, ""},
+			{workflow.ConfigFormatYAML, "{}"},
+		} {
+			got, err := workflow.ParseConfigFormat(strings.NewReader(tc.input), tc.format)
+			assert.NilError(t, err, tc.format)
+			assert.Equal(t, len(got), 0, tc.format)
+		}
+	})
+
+	t.Run("Rejects unknown fields", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := workflow.ParseConfigFormat(strings.NewReader(`{"virus_scan": "yes"}`), workflow.ConfigFormatJSON)
+		assert.Error(t, err, `unknown field "virus_scan"`)
+
+		_, err = workflow.ParseConfigFormat(strings.NewReader("virus_scan: yes\n"), workflow.ConfigFormatYAML)
+		assert.Error(t, err, `unknown field "virus_scan"`)
+	})
+
+	t.Run("Rejects unexpected values", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := workflow.ParseConfigFormat(strings.NewReader(`["virus_scanning"]`), workflow.ConfigFormatJSON)
+		assert.Error(t, err, "expected JSON object")
+
+		_, err = workflow.ParseConfigFormat(strings.NewReader(`{"virus_scanning": 1}`), workflow.ConfigFormatJSON)
+		assert.ErrorContains(t, err, `field "virus_scanning"`)
+
+		_, err = workflow.ParseConfigFormat(strings.NewReader("virus_scanning: [yes]\n"), workflow.ConfigFormatYAML)
+		assert.Error(t, err, `field "virus_scanning": expected string value (line 1)`)
+	})
+}
+
+func TestParseConfigFileFormats(t *testing.T) {
+	t.Parallel()
+
+	dir := fs.NewDir(t, "",
+		fs.WithFile("processingMCP.json", `{"store_dip": "8d29eb3d-a8a8-4347-806e-3d8227ed44a1"}`),
+		fs.WithFile("processingMCP.yml", `store_dip: 8d29eb3d-a8a8-4347-806e-3d8227ed44a1`),
+	)
+
+	for _, name := range []string{"processingMCP.json", "processingMCP.yml"} {
+		choices, err := workflow.ParseConfigFile(dir.Join(name))
+		assert.NilError(t, err)
+		assert.Equal(t, len(choices), 1)
+		assert.Equal(t, choices[0].AppliesTo, "5e58066d-e113-4383-b20b-f301ed4d751c")
+		assert.Equal(t, choices[0].GoToChain, "8d29eb3d-a8a8-4347-806e-3d8227ed44a1")
+	}
+}
@@ -25,7 +25,10 @@ func TestParseConfigFile(t *testing.T) {
 func TestSaveConfigFile(t *testing.T) {
 	dir := fs.NewDir(t, "")
 
-	err := workflow.SaveConfigFile(dir.Join("processingMCP.xml"), []workflow.Choice{
+	wf, err := workflow.Default()
+	assert.NilError(t, err)
+
+	err = workflow.SaveConfigFile(wf, dir.Join("processingMCP.xml"), []workflow.Choice{
 		{
 			Comment:   "Store DIP",
 			AppliesTo: "5e58066d-e113-4383-b20b-f301ed4d751c",
@@ -47,3 +50,20 @@ func TestSaveConfigFile(t *testing.T) {
 	)
 	assert.Assert(t, fs.Equal(dir.Path(), expected))
 }
+
+func TestSaveConfigFileRejectsInvalidChoices(t *testing.T) {
+	dir := fs.NewDir(t, "")
+
+	wf, err := workflow.Default()
+	assert.NilError(t, err)
+
+	err = workflow.SaveConfigFile(wf, dir.Join("processingMCP.xml"), []workflow.Choice{
+		{
+			Comment:   "Store DIP",
+			AppliesTo: "5e58066d-e113-4383-b20b-f301ed4d751c",
+			GoToChain: "8d29eb3d-a8a8-4347-806e-3d8227ed44a0",
+		},
+	})
+	assert.ErrorContains(t, err, "invalid processing configuration")
+	assert.Assert(t, fs.Equal(dir.Path(), fs.Expected(t)))
+}
@@ -0,0 +1,100 @@
+package workflow
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/google/uuid"
+)
+
+// legacyLocationChoices are decision points that no longer exist in the
+// workflow but are still found in processing configurations, e.g. the built-in
+// automated configuration. Their values are Storage Service location URIs.
+var legacyLocationChoices = []uuid.UUID{
+	uuid.MustParse("b320ce81-9982-408a-9502-097d0daa48fa"), // Store AIP location.
+	uuid.MustParse("cd844b6e-ab3c-4bc6-b34f-7103f88715de"), // Store DIP location.
+}
+
+// ConfigError describes a problem found in a preconfigured choice.
+type ConfigError struct {
+	// Index is the position of the choice in the processing configuration.
+	Index  int
+	Choice Choice
+	Reason string
+}
+
+func (e *ConfigError) Error() string {
+	return fmt.Sprintf("choice %d (appliesTo=%q, goToChain=%q): %s", e.Index, e.Choice.AppliesTo, e.Choice.GoToChain, e.Reason)
+}
+
+// ValidateConfig checks the preconfigured choices against the workflow. Every
+// choice must apply to an existing decision link and point to one of the chain
+// choices or replacements available in that link. A link can only be
+// configured once.
+//
+// The returned error joins a *ConfigError for every problem found.
+func ValidateConfig(wf *Document, choices []Choice) error {
+	var errs []error
+
+	seen := make(map[string]int, len(choices))
+	for i, choice := range choices {
+		if reason := validateChoice(wf, choice); reason != "" {
+			errs = append(errs, &ConfigError{Index: i, Choice: choice, Reason: reason})
+		}
+
+		if prev, ok := seen[choice.AppliesTo]; ok {
+			reason := fmt.Sprintf("duplicate of choice %d", prev)
+			if choices[prev].GoToChain != choice.GoToChain {
+				reason = fmt.Sprintf("conflicts with choice %d", prev)
+			}
+			errs = append(errs, &ConfigError{Index: i, Choice: choice, Reason: reason})
+			continue
+		}
+		seen[choice.AppliesTo] = i
+	}
+
+	return errors.Join(errs...)
+}
+
+// validateChoice returns the reason why the choice is not valid or an empty
+// string when there are no issues.
+func validateChoice(wf *Document, choice Choice) string {
+	linkID, err := uuid.Parse(choice.AppliesTo)
+	if err != nil {
+		return "appliesTo is not a valid UUID"
+	}
+
+	link, ok := wf.Links[linkID]
+	if !ok {
+		if slices.Contains(legacyLocationChoices, linkID) && choice.GoToChain != "" {
+			return ""
+		}
+		return "link not found in workflow"
+	}
+
+	chainID, err := uuid.Parse(choice.GoToChain)
+	if err != nil {
+		return "goToChain is not a valid UUID"
+	}
+
+	switch config := link.Config.(type) {
+	case LinkMicroServiceChainChoice:
+		if !slices.Contains(config.Choices, chainID) {
+			return "goToChain is not one of the chain choices of the link"
+		}
+		if _, ok := wf.Chains[chainID]; !ok {
+			return "chain not found in workflow"
+		}
+	case LinkMicroServiceChoiceReplacementDic:
+		if !slices.ContainsFunc(config.Replacements, func(r ConfigReplacement) bool {
+			return r.ID == chainID
+		}) {
+			return "goToChain is not one of the replacements of the link"
+		}
+	default:
+		return "link is not a decision point"
+	}
+
+	return ""
+}
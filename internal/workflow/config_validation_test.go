@@ -0,0 +1,73 @@
+package workflow_test
+
+import (
+	"errors"
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/artefactual-labs/ccp/internal/workflow"
+)
+
+func TestValidateConfig(t *testing.T) {
+	t.Parallel()
+
+	wf, err := workflow.Default()
+	assert.NilError(t, err)
+
+	t.Run("Accepts the built-in configurations", func(t *testing.T) {
+		t.Parallel()
+
+		assert.NilError(t, workflow.ValidateConfig(wf, workflow.DefaultConfig.Choices))
+		assert.NilError(t, workflow.ValidateConfig(wf, workflow.AutomatedConfig.Choices))
+	})
+
+	t.Run("Accepts an empty configuration", func(t *testing.T) {
+		t.Parallel()
+
+		assert.NilError(t, workflow.ValidateConfig(wf, nil))
+	})
+
+	t.Run("Reports invalid choices", func(t *testing.T) {
+		t.Parallel()
+
+		err := workflow.ValidateConfig(wf, []workflow.Choice{
+			// Link does not exist.
+			{AppliesTo: "00000000-0000-0000-0000-000000000000", GoToChain: "8d29eb3d-a8a8-4347-806e-3d8227ed44a1"},
+			// Not a decision link ("Move to compressionAIPDecisions directory").
+			{AppliesTo: "002716a1-ae29-4f36-98ab-0d97192669c4", GoToChain: "8d29eb3d-a8a8-4347-806e-3d8227ed44a1"},
+			// Chain is not one of the choices of "Store DIP".
+			{AppliesTo: "5e58066d-e113-4383-b20b-f301ed4d751c", GoToChain: "7065d256-2f47-4b7d-baec-2c4699626121"},
+			// Unknown replacement in "Assign UUIDs to directories?".
+			{AppliesTo: "bd899573-694e-4d33-8c9b-df0af802437d", GoToChain: "8d29eb3d-a8a8-4347-806e-3d8227ed44a1"},
+			// Malformed values.
+			{AppliesTo: "store-dip", GoToChain: "8d29eb3d-a8a8-4347-806e-3d8227ed44a1"},
+			{AppliesTo: "5e58066d-e113-4383-b20b-f301ed4d751c", GoToChain: "yes"},
+		})
+
+		assert.Error(t, err, `choice 0 (appliesTo="00000000-0000-0000-0000-000000000000", goToChain="8d29eb3d-a8a8-4347-806e-3d8227ed44a1"): link not found in workflow
+choice 1 (appliesTo="002716a1-ae29-4f36-98ab-0d97192669c4", goToChain="8d29eb3d-a8a8-4347-806e-3d8227ed44a1"): link is not a decision point
+choice 2 (appliesTo="5e58066d-e113-4383-b20b-f301ed4d751c", goToChain="7065d256-2f47-4b7d-baec-2c4699626121"): goToChain is not one of the chain choices of the link
+choice 3 (appliesTo="bd899573-694e-4d33-8c9b-df0af802437d", goToChain="8d29eb3d-a8a8-4347-806e-3d8227ed44a1"): goToChain is not one of the replacements of the link
+choice 4 (appliesTo="store-dip", goToChain="8d29eb3d-a8a8-4347-806e-3d8227ed44a1"): appliesTo is not a valid UUID
+choice 5 (appliesTo="5e58066d-e113-4383-b20b-f301ed4d751c", goToChain="yes"): goToChain is not a valid UUID
+choice 5 (appliesTo="5e58066d-e113-4383-b20b-f301ed4d751c", goToChain="yes"): conflicts with choice 2`)
+
+		var cerr *workflow.ConfigError
+		assert.Assert(t, errors.As(err, &cerr))
+		assert.Equal(t, cerr.Index, 0)
+	})
+
+	t.Run("Reports duplicate and conflicting choices", func(t *testing.T) {
+		t.Parallel()
+
+		err := workflow.ValidateConfig(wf, []workflow.Choice{
+			{AppliesTo: "5e58066d-e113-4383-b20b-f301ed4d751c", GoToChain: "8d29eb3d-a8a8-4347-806e-3d8227ed44a1"},
+			{AppliesTo: "5e58066d-e113-4383-b20b-f301ed4d751c", GoToChain: "8d29eb3d-a8a8-4347-806e-3d8227ed44a1"},
+			{AppliesTo: "5e58066d-e113-4383-b20b-f301ed4d751c", GoToChain: "4500f34e-f004-4ccf-8720-5c38d0be2254"},
+		})
+
+		assert.Error(t, err, `choice 1 (appliesTo="5e58066d-e113-4383-b20b-f301ed4d751c", goToChain="8d29eb3d-a8a8-4347-806e-3d8227ed44a1"): duplicate of choice 0
+choice 2 (appliesTo="5e58066d-e113-4383-b20b-f301ed4d751c", goToChain="4500f34e-f004-4ccf-8720-5c38d0be2254"): conflicts with choice 0`)
+	})
+}
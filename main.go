@@ -8,6 +8,8 @@ import (
 
 	"github.com/peterbourgon/ff/v3/ffcli"
 
+	"github.com/artefactual-labs/ccp/internal/cmd/migratecmd"
+	"github.com/artefactual-labs/ccp/internal/cmd/processingconfigcmd"
 	"github.com/artefactual-labs/ccp/internal/cmd/rootcmd"
 	"github.com/artefactual-labs/ccp/internal/cmd/servercmd"
 	"github.com/artefactual-labs/ccp/internal/version"
@@ -26,6 +28,8 @@ func Run(ctx context.Context, out io.Writer, args []string) error {
 
 	rootCommand.Subcommands = []*ffcli.Command{
 		servercmd.New(rootConfig, out),
+		processingconfigcmd.New(rootConfig, out),
+		migratecmd.New(rootConfig, out),
 		version.New(out),
 	}
 
@@ -26,8 +26,58 @@ function useAdminServiceClient(): AdminServiceClient {
   return useInject<AdminServiceClient>(adminClientKey)
 }
 
+// Token sent with mutating requests, obtained from the session of the user.
+let csrfToken = ''
+
+type Session = {
+  username: string
+  csrfToken: string
+}
+
+// loadSession returns the session of the user, or null when not logged in.
+async function loadSession(): Promise<Session | null> {
+  const resp = await fetch('/auth/session', { credentials: 'same-origin' })
+  if (!resp.ok) {
+    csrfToken = ''
+    return null
+  }
+  const session: Session = await resp.json()
+  csrfToken = session.csrfToken
+  return session
+}
+
+async function login(username: string, password: string): Promise<Session> {
+  const resp = await fetch('/auth/login', {
+    method: 'POST',
+    credentials: 'same-origin',
+    headers: { 'Content-Type': 'application/json' },
+    body: JSON.stringify({ username, password })
+  })
+  if (!resp.ok) {
+    const { error } = await resp.json()
+    throw new Error(error)
+  }
+  const session: Session = await resp.json()
+  csrfToken = session.csrfToken
+  return session
+}
+
+async function logout(): Promise<void> {
+  await fetch('/auth/logout', {
+    method: 'POST',
+    credentials: 'same-origin',
+    headers: { 'X-CSRF-Token': csrfToken }
+  })
+  csrfToken = ''
+}
+
 const authInterceptor: Interceptor = (next) => async (req) => {
-  req.header.set('Authorization', 'ApiKey test:test') // TODO
+  if (import.meta.env.DEV) {
+    // The development server proxies to the Admin API directly.
+    req.header.set('Authorization', 'ApiKey test:test')
+  } else if (csrfToken) {
+    req.header.set('X-CSRF-Token', csrfToken)
+  }
   return await next(req)
 }
 
@@ -41,6 +91,6 @@ function client(app: App) {
   app.provide(adminClientKey, client)
 }
 
-export type { AdminServiceClient }
+export type { AdminServiceClient, Session }
 
-export { client, useTransport, useAdminServiceClient }
+export { client, useTransport, useAdminServiceClient, loadSession, login, logout }
@@ -4,7 +4,7 @@
 // @ts-nocheck
 
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
-import { Message, proto3, Timestamp } from "@bufbuild/protobuf";
+import { Message, proto3, protoInt64, Timestamp } from "@bufbuild/protobuf";
 import { I18n } from "./i18n_pb.js";
 
 /**
@@ -109,6 +109,48 @@ proto3.util.setEnumType(PackageType, "archivematica.ccp.admin.v1beta1.PackageTyp
   { no: 4, name: "PACKAGE_TYPE_DIP" },
 ]);
 
+/**
+ * Priority of a package in the processing queue. Packages are scheduled with
+ * weighted fair queuing: higher priorities get a larger share of the processing
+ * slots without starving the lower ones.
+ *
+ * @generated from enum archivematica.ccp.admin.v1beta1.PackagePriority
+ */
+export enum PackagePriority {
+  /**
+   * @generated from enum value: PACKAGE_PRIORITY_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: PACKAGE_PRIORITY_LOW = 1;
+   */
+  LOW = 1,
+
+  /**
+   * @generated from enum value: PACKAGE_PRIORITY_NORMAL = 2;
+   */
+  NORMAL = 2,
+
+  /**
+   * @generated from enum value: PACKAGE_PRIORITY_HIGH = 3;
+   */
+  HIGH = 3,
+
+  /**
+   * @generated from enum value: PACKAGE_PRIORITY_URGENT = 4;
+   */
+  URGENT = 4,
+}
+// Retrieve enum metadata with: proto3.getEnumType(PackagePriority)
+proto3.util.setEnumType(PackagePriority, "archivematica.ccp.admin.v1beta1.PackagePriority", [
+  { no: 0, name: "PACKAGE_PRIORITY_UNSPECIFIED" },
+  { no: 1, name: "PACKAGE_PRIORITY_LOW" },
+  { no: 2, name: "PACKAGE_PRIORITY_NORMAL" },
+  { no: 3, name: "PACKAGE_PRIORITY_HIGH" },
+  { no: 4, name: "PACKAGE_PRIORITY_URGENT" },
+]);
+
 /**
  * @generated from enum archivematica.ccp.admin.v1beta1.PackageStatus
  */
@@ -485,6 +527,257 @@ export class Decision extends Message<Decision> {
   }
 }
 
+/**
+ * Progress of the copy of the transfer sources into the shared directory.
+ *
+ * @generated from message archivematica.ccp.admin.v1beta1.CopyProgress
+ */
+export class CopyProgress extends Message<CopyProgress> {
+  /**
+   * Number of files to be copied.
+   *
+   * @generated from field: int64 total_files = 1;
+   */
+  totalFiles = protoInt64.zero;
+
+  /**
+   * Number of bytes to be copied.
+   *
+   * @generated from field: int64 total_bytes = 2;
+   */
+  totalBytes = protoInt64.zero;
+
+  /**
+   * Number of files copied and verified so far.
+   *
+   * @generated from field: int64 copied_files = 3;
+   */
+  copiedFiles = protoInt64.zero;
+
+  /**
+   * Number of bytes copied so far.
+   *
+   * @generated from field: int64 copied_bytes = 4;
+   */
+  copiedBytes = protoInt64.zero;
+
+  constructor(data?: PartialMessage<CopyProgress>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "archivematica.ccp.admin.v1beta1.CopyProgress";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "total_files", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "total_bytes", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "copied_files", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 4, name: "copied_bytes", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CopyProgress {
+    return new CopyProgress().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CopyProgress {
+    return new CopyProgress().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CopyProgress {
+    return new CopyProgress().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CopyProgress | PlainMessage<CopyProgress> | undefined, b: CopyProgress | PlainMessage<CopyProgress> | undefined): boolean {
+    return proto3.util.equals(CopyProgress, a, b);
+  }
+}
+
+/**
+ * Package waiting in the processing queue.
+ *
+ * @generated from message archivematica.ccp.admin.v1beta1.QueuedPackage
+ */
+export class QueuedPackage extends Message<QueuedPackage> {
+  /**
+   * Identifier of the package (UUIDv4).
+   *
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * Name of the package.
+   *
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * Type of the package.
+   *
+   * @generated from field: archivematica.ccp.admin.v1beta1.PackageType type = 3;
+   */
+  type = PackageType.UNSPECIFIED;
+
+  /**
+   * Priority of the package.
+   *
+   * @generated from field: archivematica.ccp.admin.v1beta1.PackagePriority priority = 4;
+   */
+  priority = PackagePriority.UNSPECIFIED;
+
+  /**
+   * Time when the package was queued.
+   *
+   * @generated from field: google.protobuf.Timestamp queued_at = 5;
+   */
+  queuedAt?: Timestamp;
+
+  /**
+   * Size of the package contents in bytes.
+   *
+   * @generated from field: int64 size = 6;
+   */
+  size = protoInt64.zero;
+
+  constructor(data?: PartialMessage<QueuedPackage>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "archivematica.ccp.admin.v1beta1.QueuedPackage";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "type", kind: "enum", T: proto3.getEnumType(PackageType) },
+    { no: 4, name: "priority", kind: "enum", T: proto3.getEnumType(PackagePriority) },
+    { no: 5, name: "queued_at", kind: "message", T: Timestamp },
+    { no: 6, name: "size", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueuedPackage {
+    return new QueuedPackage().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueuedPackage {
+    return new QueuedPackage().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueuedPackage {
+    return new QueuedPackage().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: QueuedPackage | PlainMessage<QueuedPackage> | undefined, b: QueuedPackage | PlainMessage<QueuedPackage> | undefined): boolean {
+    return proto3.util.equals(QueuedPackage, a, b);
+  }
+}
+
+/**
+ * Record of a call to a mutating procedure of the AdminService.
+ *
+ * @generated from message archivematica.ccp.admin.v1beta1.AuditEvent
+ */
+export class AuditEvent extends Message<AuditEvent> {
+  /**
+   * Identifier of the event.
+   *
+   * @generated from field: int64 id = 1;
+   */
+  id = protoInt64.zero;
+
+  /**
+   * Time when the call completed.
+   *
+   * @generated from field: google.protobuf.Timestamp created_at = 2;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * Username of the user that called the procedure.
+   *
+   * @generated from field: string username = 3;
+   */
+  username = "";
+
+  /**
+   * Name of the procedure, e.g. "CreatePackage".
+   *
+   * @generated from field: string procedure = 4;
+   */
+  procedure = "";
+
+  /**
+   * Summary of the request (JSON).
+   *
+   * @generated from field: string summary = 5;
+   */
+  summary = "";
+
+  /**
+   * Outcome of the call, i.e. "ok" or the error code, e.g. "not_found".
+   *
+   * @generated from field: string outcome = 6;
+   */
+  outcome = "";
+
+  /**
+   * Error message when the call failed.
+   *
+   * @generated from field: string message = 7;
+   */
+  message = "";
+
+  /**
+   * Identifier of the related package (UUIDv4), if any.
+   *
+   * @generated from field: string package_id = 8;
+   */
+  packageId = "";
+
+  /**
+   * Identifier of the related decision (UUIDv4), if any.
+   *
+   * @generated from field: string decision_id = 9;
+   */
+  decisionId = "";
+
+  constructor(data?: PartialMessage<AuditEvent>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "archivematica.ccp.admin.v1beta1.AuditEvent";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "created_at", kind: "message", T: Timestamp },
+    { no: 3, name: "username", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "procedure", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "summary", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "outcome", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "package_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 9, name: "decision_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AuditEvent {
+    return new AuditEvent().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AuditEvent {
+    return new AuditEvent().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AuditEvent {
+    return new AuditEvent().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AuditEvent | PlainMessage<AuditEvent> | undefined, b: AuditEvent | PlainMessage<AuditEvent> | undefined): boolean {
+    return proto3.util.equals(AuditEvent, a, b);
+  }
+}
+
 /**
  * @generated from message archivematica.ccp.admin.v1beta1.Choice
  */
@@ -3,7 +3,7 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { CreatePackageRequest, CreatePackageResponse, ListDecisionsRequest, ListDecisionsResponse, ListPackagesRequest, ListPackagesResponse, ListProcessingConfigurationFieldsRequest, ListProcessingConfigurationFieldsResponse, ReadPackageRequest, ReadPackageResponse, ResolveDecisionRequest, ResolveDecisionResponse } from "./service_pb.js";
+import { CreatePackageRequest, CreatePackageResponse, ListAuditEventsRequest, ListAuditEventsResponse, ListDecisionsRequest, ListDecisionsResponse, ListPackagesRequest, ListPackagesResponse, ListProcessingConfigurationFieldsRequest, ListProcessingConfigurationFieldsResponse, ListQueueRequest, ListQueueResponse, ReadPackageRequest, ReadPackageResponse, ReorderQueueRequest, ReorderQueueResponse, ResolveDecisionRequest, ResolveDecisionResponse } from "./service_pb.js";
 import { MethodKind } from "@bufbuild/protobuf";
 import { ApproveJobRequest, ApproveJobResponse, ApprovePartialReingestRequest, ApprovePartialReingestResponse, ApproveTransferByPathRequest, ApproveTransferByPathResponse } from "./deprecated_pb.js";
 
@@ -90,6 +90,41 @@ export const AdminService = {
       O: ListProcessingConfigurationFieldsResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * ListAuditEvents lists the audit trail of the mutating procedures, most
+     * recent first.
+     *
+     * @generated from rpc archivematica.ccp.admin.v1beta1.AdminService.ListAuditEvents
+     */
+    listAuditEvents: {
+      name: "ListAuditEvents",
+      I: ListAuditEventsRequest,
+      O: ListAuditEventsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * ListQueue lists the packages waiting to be processed, in the order they
+     * are expected to start.
+     *
+     * @generated from rpc archivematica.ccp.admin.v1beta1.AdminService.ListQueue
+     */
+    listQueue: {
+      name: "ListQueue",
+      I: ListQueueRequest,
+      O: ListQueueResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * ReorderQueue moves a queued package to a new position in the queue.
+     *
+     * @generated from rpc archivematica.ccp.admin.v1beta1.AdminService.ReorderQueue
+     */
+    reorderQueue: {
+      name: "ReorderQueue",
+      I: ReorderQueueRequest,
+      O: ReorderQueueResponse,
+      kind: MethodKind.Unary,
+    },
     /**
      * ApproveJob ...
      *
@@ -4,8 +4,8 @@
 // @ts-nocheck
 
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
-import { Message, proto3, StringValue } from "@bufbuild/protobuf";
-import { Choice, Decision, Package, PackageType, ProcessingConfigField, TransferType } from "./admin_pb.js";
+import { Message, proto3, StringValue, Timestamp } from "@bufbuild/protobuf";
+import { AuditEvent, Choice, CopyProgress, Decision, Package, PackagePriority, PackageType, ProcessingConfigField, QueuedPackage, TransferType } from "./admin_pb.js";
 
 /**
  * @generated from message archivematica.ccp.admin.v1beta1.CreatePackageRequest
@@ -63,6 +63,31 @@ export class CreatePackageRequest extends Message<CreatePackageRequest> {
    */
   processingConfig = "";
 
+  /**
+   * Preconfigured choices merged over the named processing configuration. The
+   * result is validated and included in the transfer as processingMCP.xml.
+   *
+   * @generated from field: archivematica.ccp.admin.v1beta1.ProcessingConfigOverride processing_config_override = 8;
+   */
+  processingConfigOverride?: ProcessingConfigOverride;
+
+  /**
+   * Optional key supplied by the client to make retries safe. A request
+   * repeated with the same key and payload returns the identifier of the
+   * package created originally, while a request with the same key and a
+   * different payload is rejected.
+   *
+   * @generated from field: string idempotency_key = 9;
+   */
+  idempotencyKey = "";
+
+  /**
+   * Priority of the package in the processing queue, defaults to "normal".
+   *
+   * @generated from field: archivematica.ccp.admin.v1beta1.PackagePriority priority = 10;
+   */
+  priority = PackagePriority.UNSPECIFIED;
+
   constructor(data?: PartialMessage<CreatePackageRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -78,6 +103,9 @@ export class CreatePackageRequest extends Message<CreatePackageRequest> {
     { no: 5, name: "path", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
     { no: 6, name: "metadata_set_id", kind: "message", T: StringValue },
     { no: 7, name: "processing_config", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "processing_config_override", kind: "message", T: ProcessingConfigOverride },
+    { no: 9, name: "idempotency_key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 10, name: "priority", kind: "enum", T: proto3.getEnumType(PackagePriority) },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreatePackageRequest {
@@ -97,6 +125,93 @@ export class CreatePackageRequest extends Message<CreatePackageRequest> {
   }
 }
 
+/**
+ * @generated from message archivematica.ccp.admin.v1beta1.ProcessingConfigOverride
+ */
+export class ProcessingConfigOverride extends Message<ProcessingConfigOverride> {
+  /**
+   * Choices replace those of the named processing configuration that apply to
+   * the same link.
+   *
+   * @generated from field: repeated archivematica.ccp.admin.v1beta1.ProcessingConfigChoice choice = 1;
+   */
+  choice: ProcessingConfigChoice[] = [];
+
+  constructor(data?: PartialMessage<ProcessingConfigOverride>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "archivematica.ccp.admin.v1beta1.ProcessingConfigOverride";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "choice", kind: "message", T: ProcessingConfigChoice, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ProcessingConfigOverride {
+    return new ProcessingConfigOverride().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ProcessingConfigOverride {
+    return new ProcessingConfigOverride().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ProcessingConfigOverride {
+    return new ProcessingConfigOverride().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ProcessingConfigOverride | PlainMessage<ProcessingConfigOverride> | undefined, b: ProcessingConfigOverride | PlainMessage<ProcessingConfigOverride> | undefined): boolean {
+    return proto3.util.equals(ProcessingConfigOverride, a, b);
+  }
+}
+
+/**
+ * @generated from message archivematica.ccp.admin.v1beta1.ProcessingConfigChoice
+ */
+export class ProcessingConfigChoice extends Message<ProcessingConfigChoice> {
+  /**
+   * Identifier of the decision link (UUIDv4).
+   *
+   * @generated from field: string applies_to = 1;
+   */
+  appliesTo = "";
+
+  /**
+   * Identifier of the chain or replacement (UUIDv4) chosen.
+   *
+   * @generated from field: string go_to_chain = 2;
+   */
+  goToChain = "";
+
+  constructor(data?: PartialMessage<ProcessingConfigChoice>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "archivematica.ccp.admin.v1beta1.ProcessingConfigChoice";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "applies_to", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "go_to_chain", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ProcessingConfigChoice {
+    return new ProcessingConfigChoice().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ProcessingConfigChoice {
+    return new ProcessingConfigChoice().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ProcessingConfigChoice {
+    return new ProcessingConfigChoice().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ProcessingConfigChoice | PlainMessage<ProcessingConfigChoice> | undefined, b: ProcessingConfigChoice | PlainMessage<ProcessingConfigChoice> | undefined): boolean {
+    return proto3.util.equals(ProcessingConfigChoice, a, b);
+  }
+}
+
 /**
  * @generated from message archivematica.ccp.admin.v1beta1.CreatePackageResponse
  */
@@ -192,6 +307,23 @@ export class ReadPackageResponse extends Message<ReadPackageResponse> {
    */
   decision: Decision[] = [];
 
+  /**
+   * Progress of the copy of the transfer sources.
+   * Only used while the transfer is being copied.
+   *
+   * @generated from field: archivematica.ccp.admin.v1beta1.CopyProgress copy_progress = 3;
+   */
+  copyProgress?: CopyProgress;
+
+  /**
+   * Reason why the package is waiting to be processed, e.g. there is not
+   * enough space in the shared directory.
+   * Only used while the package is queued and held back.
+   *
+   * @generated from field: string queue_message = 4;
+   */
+  queueMessage = "";
+
   constructor(data?: PartialMessage<ReadPackageResponse>) {
     super();
     proto3.util.initPartial(data, this);
@@ -202,6 +334,8 @@ export class ReadPackageResponse extends Message<ReadPackageResponse> {
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "pkg", kind: "message", T: Package },
     { no: 2, name: "decision", kind: "message", T: Decision, repeated: true },
+    { no: 3, name: "copy_progress", kind: "message", T: CopyProgress },
+    { no: 4, name: "queue_message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReadPackageResponse {
@@ -515,3 +649,317 @@ export class ListProcessingConfigurationFieldsResponse extends Message<ListProce
   }
 }
 
+/**
+ * @generated from message archivematica.ccp.admin.v1beta1.ListAuditEventsRequest
+ */
+export class ListAuditEventsRequest extends Message<ListAuditEventsRequest> {
+  /**
+   * Username of the user that called the procedure.
+   *
+   * @generated from field: string username = 1;
+   */
+  username = "";
+
+  /**
+   * Name of the procedure, e.g. "CreatePackage".
+   *
+   * @generated from field: string procedure = 2;
+   */
+  procedure = "";
+
+  /**
+   * Identifier of the related package (UUIDv4).
+   *
+   * @generated from field: string package_id = 3;
+   */
+  packageId = "";
+
+  /**
+   * Identifier of the related decision (UUIDv4).
+   *
+   * @generated from field: string decision_id = 4;
+   */
+  decisionId = "";
+
+  /**
+   * Outcome of the call, e.g. "ok" or "permission_denied".
+   *
+   * @generated from field: string outcome = 5;
+   */
+  outcome = "";
+
+  /**
+   * Only events recorded at or after this time.
+   *
+   * @generated from field: google.protobuf.Timestamp start_time = 6;
+   */
+  startTime?: Timestamp;
+
+  /**
+   * Only events recorded before this time.
+   *
+   * @generated from field: google.protobuf.Timestamp end_time = 7;
+   */
+  endTime?: Timestamp;
+
+  /**
+   * Maximum number of events returned, defaults to 100.
+   *
+   * @generated from field: int32 page_size = 8;
+   */
+  pageSize = 0;
+
+  /**
+   * Token received in a previous response to retrieve the next page.
+   *
+   * @generated from field: string page_token = 9;
+   */
+  pageToken = "";
+
+  constructor(data?: PartialMessage<ListAuditEventsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "archivematica.ccp.admin.v1beta1.ListAuditEventsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "username", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "procedure", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "package_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "decision_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "outcome", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "start_time", kind: "message", T: Timestamp },
+    { no: 7, name: "end_time", kind: "message", T: Timestamp },
+    { no: 8, name: "page_size", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 9, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListAuditEventsRequest {
+    return new ListAuditEventsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListAuditEventsRequest {
+    return new ListAuditEventsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListAuditEventsRequest {
+    return new ListAuditEventsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListAuditEventsRequest | PlainMessage<ListAuditEventsRequest> | undefined, b: ListAuditEventsRequest | PlainMessage<ListAuditEventsRequest> | undefined): boolean {
+    return proto3.util.equals(ListAuditEventsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message archivematica.ccp.admin.v1beta1.ListAuditEventsResponse
+ */
+export class ListAuditEventsResponse extends Message<ListAuditEventsResponse> {
+  /**
+   * @generated from field: repeated archivematica.ccp.admin.v1beta1.AuditEvent event = 1;
+   */
+  event: AuditEvent[] = [];
+
+  /**
+   * Token to retrieve the next page, empty when there are no more events.
+   *
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken = "";
+
+  constructor(data?: PartialMessage<ListAuditEventsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "archivematica.ccp.admin.v1beta1.ListAuditEventsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "event", kind: "message", T: AuditEvent, repeated: true },
+    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListAuditEventsResponse {
+    return new ListAuditEventsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListAuditEventsResponse {
+    return new ListAuditEventsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListAuditEventsResponse {
+    return new ListAuditEventsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListAuditEventsResponse | PlainMessage<ListAuditEventsResponse> | undefined, b: ListAuditEventsResponse | PlainMessage<ListAuditEventsResponse> | undefined): boolean {
+    return proto3.util.equals(ListAuditEventsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message archivematica.ccp.admin.v1beta1.ListQueueRequest
+ */
+export class ListQueueRequest extends Message<ListQueueRequest> {
+  constructor(data?: PartialMessage<ListQueueRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "archivematica.ccp.admin.v1beta1.ListQueueRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListQueueRequest {
+    return new ListQueueRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListQueueRequest {
+    return new ListQueueRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListQueueRequest {
+    return new ListQueueRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListQueueRequest | PlainMessage<ListQueueRequest> | undefined, b: ListQueueRequest | PlainMessage<ListQueueRequest> | undefined): boolean {
+    return proto3.util.equals(ListQueueRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message archivematica.ccp.admin.v1beta1.ListQueueResponse
+ */
+export class ListQueueResponse extends Message<ListQueueResponse> {
+  /**
+   * Queued packages, in the order they are expected to start.
+   *
+   * @generated from field: repeated archivematica.ccp.admin.v1beta1.QueuedPackage package = 1;
+   */
+  package: QueuedPackage[] = [];
+
+  /**
+   * Reason why the queued packages are waiting, e.g. the maximum number of
+   * active packages has been reached.
+   *
+   * @generated from field: string message = 2;
+   */
+  message = "";
+
+  constructor(data?: PartialMessage<ListQueueResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "archivematica.ccp.admin.v1beta1.ListQueueResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "package", kind: "message", T: QueuedPackage, repeated: true },
+    { no: 2, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListQueueResponse {
+    return new ListQueueResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListQueueResponse {
+    return new ListQueueResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListQueueResponse {
+    return new ListQueueResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListQueueResponse | PlainMessage<ListQueueResponse> | undefined, b: ListQueueResponse | PlainMessage<ListQueueResponse> | undefined): boolean {
+    return proto3.util.equals(ListQueueResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message archivematica.ccp.admin.v1beta1.ReorderQueueRequest
+ */
+export class ReorderQueueRequest extends Message<ReorderQueueRequest> {
+  /**
+   * Identifier of the queued package (UUIDv4).
+   *
+   * @generated from field: string package_id = 1;
+   */
+  packageId = "";
+
+  /**
+   * New zero-based position of the package in the queue. Positions beyond the
+   * end of the queue move the package to the end.
+   *
+   * @generated from field: uint32 position = 2;
+   */
+  position = 0;
+
+  constructor(data?: PartialMessage<ReorderQueueRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "archivematica.ccp.admin.v1beta1.ReorderQueueRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "package_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "position", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReorderQueueRequest {
+    return new ReorderQueueRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ReorderQueueRequest {
+    return new ReorderQueueRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ReorderQueueRequest {
+    return new ReorderQueueRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ReorderQueueRequest | PlainMessage<ReorderQueueRequest> | undefined, b: ReorderQueueRequest | PlainMessage<ReorderQueueRequest> | undefined): boolean {
+    return proto3.util.equals(ReorderQueueRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message archivematica.ccp.admin.v1beta1.ReorderQueueResponse
+ */
+export class ReorderQueueResponse extends Message<ReorderQueueResponse> {
+  /**
+   * Queued packages after the package has been moved.
+   *
+   * @generated from field: repeated archivematica.ccp.admin.v1beta1.QueuedPackage package = 1;
+   */
+  package: QueuedPackage[] = [];
+
+  constructor(data?: PartialMessage<ReorderQueueResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "archivematica.ccp.admin.v1beta1.ReorderQueueResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "package", kind: "message", T: QueuedPackage, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReorderQueueResponse {
+    return new ReorderQueueResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ReorderQueueResponse {
+    return new ReorderQueueResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ReorderQueueResponse {
+    return new ReorderQueueResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ReorderQueueResponse | PlainMessage<ReorderQueueResponse> | undefined, b: ReorderQueueResponse | PlainMessage<ReorderQueueResponse> | undefined): boolean {
+    return proto3.util.equals(ReorderQueueResponse, a, b);
+  }
+}
+
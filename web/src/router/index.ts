@@ -1,5 +1,6 @@
 import { createRouter, createWebHistory } from 'vue-router'
 import HomeView from '../views/HomeView.vue'
+import { loadSession } from '../client'
 
 const router = createRouter({
   history: createWebHistory(import.meta.env.BASE_URL),
@@ -16,8 +17,24 @@ const router = createRouter({
       // this generates a separate chunk (About.[hash].js) for this route
       // which is lazy-loaded when the route is visited.
       component: () => import('../views/TestView.vue')
+    },
+    {
+      path: '/login',
+      name: 'login',
+      component: () => import('../views/LoginView.vue'),
+      meta: { public: true }
     }
   ]
 })
 
+router.beforeEach(async (to) => {
+  if (to.meta.public || import.meta.env.DEV) {
+    return true
+  }
+  if (await loadSession()) {
+    return true
+  }
+  return { name: 'login', query: { next: to.fullPath } }
+})
+
 export default router
@@ -0,0 +1,38 @@
+from uuid import uuid4
+
+import pytest
+
+from worker.client.gearman import MCPGearmanWorker
+from worker.client.job import Job
+
+
+@pytest.mark.parametrize(
+    "capture_output,capture_client_script_output,sends_output",
+    [
+        (False, False, False),
+        (True, False, True),
+        (False, True, True),
+    ],
+)
+def test_format_job_results(
+    settings, capture_output, capture_client_script_output, sends_output
+):
+    settings.CAPTURE_CLIENT_SCRIPT_OUTPUT = capture_client_script_output
+    job = Job(
+        name="somejob", uuid=str(uuid4()), arguments=[], capture_output=capture_output
+    )
+    job.write_output("output")
+    job.write_error("error")
+    job.finish()
+
+    results = MCPGearmanWorker._format_job_results([job])
+
+    result = results[job.uuid]
+    assert result["exitCode"] == 0
+    assert result["finishedTimestamp"] == job.end_time
+    if sends_output:
+        assert result["stdout"] == "output"
+        assert result["stderror"] == "error"
+    else:
+        assert "stdout" not in result
+        assert "stderror" not in result
@@ -14,6 +14,7 @@ from typing import Union
 
 import gearman
 import orjson
+from django.conf import settings
 from gearman.job import GearmanJob
 
 from worker.client import metrics
@@ -106,12 +107,12 @@ class MCPGearmanWorker(gearman.GearmanWorker):  # type: ignore
                 "finishedTimestamp": job.end_time,
             }
 
-            if job.capture_output:
-                # Send back stdout/stderr so it can be written to files.
-                # Most cases don't require this (logging to the database is
-                # enough), but the ones that do are coordinated through the
-                # MCP Server so that multiple MCP Client instances don't try
-                # to write the same file at the same time.
+            if job.capture_output or settings.CAPTURE_CLIENT_SCRIPT_OUTPUT:
+                # Send back stdout/stderr so MCPServer can record them in the
+                # database and, when the task asks for it, write them to
+                # files. Writing files is coordinated through MCPServer so
+                # that multiple MCP Client instances don't try to write the
+                # same file at the same time.
                 results[job.uuid]["stdout"] = job.get_stdout()
                 results[job.uuid]["stderror"] = job.get_stderr()
 
@@ -12,16 +12,13 @@ import traceback
 from contextlib import contextmanager
 from logging.handlers import BufferingHandler
 from typing import Any
-from typing import Dict
 from typing import Generator
 from typing import Iterable
 from typing import List
 from typing import Mapping
 from typing import Optional
 from typing import TypeVar
-from typing import Union
 
-from django.conf import settings
 from django.utils import timezone
 
 from worker.main.models import Task
@@ -29,7 +26,6 @@ from worker.main.models import Task
 logger = logging.getLogger("archivematica.worker.job")
 
 SelfJob = TypeVar("SelfJob", bound="Job")
-TaskData = Dict[str, Union[int, Optional[datetime.datetime], str]]
 
 
 class Job:
@@ -99,27 +95,18 @@ class Job:
             self.get_stderr(),
         )
 
-    def update_task_status(self) -> None:
-        """Updates the Task model after a job has been completed."""
+    def finish(self) -> None:
+        """Sets the end time after a job has been completed.
+
+        The results are sent back to MCPServer, which records them in the
+        Task model in bulk.
+        """
         # Not all jobs set an exit code. They expect a default of 0,
         # so keep compatibility with that
         if self.int_code is None:
             self.set_status(0)
         self.end_time = timezone.now()
 
-        kwargs: TaskData = {
-            "exitcode": self.get_exit_code(),
-            "endtime": self.end_time,
-        }
-        if settings.CAPTURE_CLIENT_SCRIPT_OUTPUT:
-            kwargs.update(
-                {
-                    "stdout": self.get_stdout(),
-                    "stderror": self.get_stderr(),
-                }
-            )
-        Task.objects.filter(taskuuid=self.uuid).update(**kwargs)
-
     def set_status(self, int_code: int, status_code: str = "success") -> None:
         if int_code:
             self.int_code = int(int_code)
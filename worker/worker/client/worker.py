@@ -26,7 +26,7 @@ def run_task(task_name: str, job_module: ModuleType, jobs: List[Job]) -> None:
     else:
         for job in jobs:
             job.log_results()
-            job.update_task_status()
+            job.finish()
 
             exit_code = job.get_exit_code()
             if exit_code == 0: